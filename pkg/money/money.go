@@ -0,0 +1,145 @@
+// Package money keeps amounts as integers in the minor unit of their currency, satang for THB, so that
+// totals add up exactly. Every conversion that can lose a fraction of a minor unit says how it rounds.
+package money
+
+import (
+	"math"
+	"sort"
+
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
+)
+
+// DefaultCurrency is the currency of amounts stored before currencies were recorded.
+const DefaultCurrency = "THB"
+
+// Money is an amount in the minor unit of its currency.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func New(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// Rounding says which way a fraction of a minor unit goes.
+type Rounding int
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, halves away from zero.
+	RoundHalfUp Rounding = iota
+	// RoundDown drops the fraction, the result is never more than the exact amount.
+	RoundDown
+)
+
+func round(value float64, rounding Rounding) int64 {
+	if rounding == RoundDown {
+		return int64(math.Trunc(value))
+	}
+	return int64(math.Round(value))
+}
+
+// exponents are the currencies events can be sold in, with the number of digits after their decimal point.
+var exponents = map[string]int{
+	"THB": 2,
+	"SGD": 2,
+	"JPY": 0,
+}
+
+// Supported reports whether prices can be set in the currency, an ISO 4217 code in upper case.
+func Supported(currency string) bool {
+	_, ok := exponents[currency]
+	return ok
+}
+
+// Exponent is the number of digits after the decimal point of the currency, two for THB and zero for JPY,
+// whose minor unit is the yen itself.
+func Exponent(currency string) int {
+	if exponent, ok := exponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+func minorPerMajor(currency string) float64 {
+	return math.Pow10(Exponent(currency))
+}
+
+// FromMajor converts an amount in major units, baht for THB, rounding to the nearest minor unit.
+func FromMajor(major float64, currency string) Money {
+	return New(round(major*minorPerMajor(currency), RoundHalfUp), currency)
+}
+
+// Major is the amount in major units, for display only.
+func (m Money) Major() float64 {
+	return float64(m.Amount) / minorPerMajor(m.Currency)
+}
+
+// Percent is percent percent of amount, rounded as asked.
+func Percent(amount int64, percent float64, rounding Rounding) int64 {
+	return round(float64(amount)*percent/100, rounding)
+}
+
+// Split divides amount into n shares that add up to it exactly. Shares differ by at most one minor unit,
+// the first ones take the remainder.
+func Split(amount int64, n int) []int64 {
+	if n <= 0 {
+		return nil
+	}
+
+	shares := make([]int64, n)
+	for i := range shares {
+		shares[i] = amount / int64(n)
+		if int64(i) < amount%int64(n) {
+			shares[i]++
+		}
+	}
+	return shares
+}
+
+// Allocate divides amount into shares in proportion to weights that add up to it exactly. Shares are rounded
+// down and the minor units left over go one each to the largest fractions, the first ones on a tie. Equal
+// shares are taken when the weights add up to nothing.
+func Allocate(amount int64, weights []int64) []int64 {
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	if total <= 0 {
+		return Split(amount, len(weights))
+	}
+
+	shares := make([]int64, len(weights))
+	fractions := make([]int64, len(weights))
+	order := make([]int, len(weights))
+	left := amount
+	for i, weight := range weights {
+		shares[i] = amount * weight / total
+		fractions[i] = amount * weight % total
+		order[i] = i
+		left -= shares[i]
+	}
+	sort.SliceStable(order, func(i, j int) bool { return fractions[order[i]] > fractions[order[j]] })
+	for _, i := range order[:left] {
+		shares[i]++
+	}
+	return shares
+}
+
+func ToProto(m Money) *moneypb.Money {
+	return &moneypb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// FromProto reads a proto amount, a missing one is zero in the default currency.
+func FromProto(m *moneypb.Money) Money {
+	if m == nil {
+		return New(0, DefaultCurrency)
+	}
+	return New(m.GetAmount(), m.GetCurrency())
+}
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		name     string
+		major    float64
+		currency string
+		want     int64
+	}{
+		{name: "whole baht", major: 20, currency: "THB", want: 2000},
+		{name: "satang", major: 19.99, currency: "THB", want: 1999},
+		{name: "half satang rounds up", major: 0.125, currency: "THB", want: 13},
+		{name: "below half satang rounds down", major: 0.124, currency: "THB", want: 12},
+		{name: "negative half rounds away from zero", major: -0.125, currency: "THB", want: -13},
+		{name: "whole yen", major: 1500, currency: "JPY", want: 1500},
+		{name: "half yen rounds up", major: 2.5, currency: "JPY", want: 3},
+		{name: "below half yen rounds down", major: 2.49, currency: "JPY", want: 2},
+		{name: "yen are not scaled by a hundred", major: 12345678, currency: "JPY", want: 12345678},
+		{name: "negative half yen rounds away from zero", major: -2.5, currency: "JPY", want: -3},
+		{name: "unknown currency has two digits", major: 1.5, currency: "XXX", want: 150},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromMajor(tt.major, tt.currency)
+			if got.Amount != tt.want || got.Currency != tt.currency {
+				t.Errorf("FromMajor(%v, %s) = %+v, want %d %s", tt.major, tt.currency, got, tt.want, tt.currency)
+			}
+		})
+	}
+}
+
+func TestMajor(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  float64
+	}{
+		{name: "satang", money: New(1999, "THB"), want: 19.99},
+		{name: "whole baht", money: New(2000, "THB"), want: 20},
+		{name: "yen are whole", money: New(1500, "JPY"), want: 1500},
+		{name: "one yen", money: New(1, "JPY"), want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Major(); got != tt.want {
+				t.Errorf("Major() of %+v = %v, want %v", tt.money, got, tt.want)
+			}
+			if back := FromMajor(tt.money.Major(), tt.money.Currency); back != tt.money {
+				t.Errorf("FromMajor(Major()) = %+v, want %+v", back, tt.money)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		percent  float64
+		rounding Rounding
+		want     int64
+	}{
+		{name: "exact", amount: 10000, percent: 7, rounding: RoundHalfUp, want: 700},
+		{name: "half up rounds half away from zero", amount: 150, percent: 1, rounding: RoundHalfUp, want: 2},
+		{name: "half up rounds below half down", amount: 149, percent: 1, rounding: RoundHalfUp, want: 1},
+		{name: "down drops a half", amount: 150, percent: 1, rounding: RoundDown, want: 1},
+		{name: "down drops almost a whole unit", amount: 199, percent: 1, rounding: RoundDown, want: 1},
+		{name: "vat inside an inclusive price", amount: 10700, percent: 700.0 / 107, rounding: RoundHalfUp, want: 700},
+		{name: "zero percent", amount: 12345, percent: 0, rounding: RoundHalfUp, want: 0},
+		{name: "hundred percent", amount: 12345, percent: 100, rounding: RoundDown, want: 12345},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Percent(tt.amount, tt.percent, tt.rounding); got != tt.want {
+				t.Errorf("Percent(%d, %v, %v) = %d, want %d", tt.amount, tt.percent, tt.rounding, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		n      int
+		want   []int64
+	}{
+		{name: "even", amount: 900, n: 3, want: []int64{300, 300, 300}},
+		{name: "remainder goes to the first shares", amount: 1000, n: 3, want: []int64{334, 333, 333}},
+		{name: "remainder of two", amount: 11, n: 3, want: []int64{4, 4, 3}},
+		{name: "less than one unit each", amount: 2, n: 4, want: []int64{1, 1, 0, 0}},
+		{name: "one share", amount: 999, n: 1, want: []int64{999}},
+		{name: "no shares", amount: 999, n: 0, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.amount, tt.n)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Split(%d, %d) = %v, want %v", tt.amount, tt.n, got, tt.want)
+			}
+			var sum int64
+			for _, share := range got {
+				sum += share
+			}
+			if tt.n > 0 && sum != tt.amount {
+				t.Errorf("Split(%d, %d) adds up to %d", tt.amount, tt.n, sum)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		weights []int64
+		want    []int64
+	}{
+		{name: "in proportion", amount: 300, weights: []int64{1000, 2000}, want: []int64{100, 200}},
+		{name: "remainder goes to the largest fraction", amount: 100, weights: []int64{1000, 2000}, want: []int64{33, 67}},
+		{name: "tie goes to the first", amount: 100, weights: []int64{1000, 1000, 1000}, want: []int64{34, 33, 33}},
+		{name: "zero weight takes nothing", amount: 101, weights: []int64{0, 500, 500}, want: []int64{0, 51, 50}},
+		{name: "no weight splits evenly", amount: 10, weights: []int64{0, 0, 0}, want: []int64{4, 3, 3}},
+		{name: "nothing to allocate", amount: 0, weights: []int64{1000, 2000}, want: []int64{0, 0}},
+		{name: "no shares", amount: 100, weights: nil, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Allocate(tt.amount, tt.weights)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Allocate(%d, %v) = %v, want %v", tt.amount, tt.weights, got, tt.want)
+			}
+		})
+	}
+}
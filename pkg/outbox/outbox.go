@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultInterval  = time.Second
+	defaultBatchSize = 100
+	// claimLease is how long a claimed message is hidden from other relays.
+	// A relay that dies mid-batch leaves its messages to be published again after it.
+	claimLease = 30 * time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// Message is a domain message stored next to the state change that produced it.
+type Message struct {
+	ID       string
+	Queue    string
+	Type     string
+	Payload  json.RawMessage
+	Attempts int32
+}
+
+// Envelope is the body published to the queue. Consumers use ID to drop duplicates,
+// delivery is at-least-once.
+type Envelope struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+func NewMessage(queue, messageType string, data any) (Message, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to marshal outbox payload")
+	}
+
+	return Message{
+		ID:      uuid.New().String(),
+		Queue:   queue,
+		Type:    messageType,
+		Payload: payload,
+	}, nil
+}
+
+// Store is the outbox table of a service.
+type Store interface {
+	// ClaimOutboxMessages returns due messages and hides them from other relays until lockedUntil.
+	ClaimOutboxMessages(ctx context.Context, limit int32, lockedUntil time.Time) ([]Message, error)
+	MarkOutboxMessagePublished(ctx context.Context, id string) error
+	MarkOutboxMessageFailed(ctx context.Context, id string, nextAttemptAt time.Time, cause error) error
+}
+
+// PublishFunc sends a message body to a queue. It returns nil only once the broker confirmed the message,
+// a message is never marked published before that.
+type PublishFunc func(queue, messageID string, body []byte) error
+
+// Relay publishes pending outbox messages and retries failures with exponential backoff.
+type Relay struct {
+	store     Store
+	publish   PublishFunc
+	interval  time.Duration
+	batchSize int32
+}
+
+func NewRelay(store Store, publish PublishFunc) *Relay {
+	return &Relay{
+		store:     store,
+		publish:   publish,
+		interval:  defaultInterval,
+		batchSize: defaultBatchSize,
+	}
+}
+
+// Start relays messages until ctx is done.
+func (r *Relay) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				logger.ErrorContext(ctx, "outbox relay failed", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Flush publishes every due message.
+func (r *Relay) Flush(ctx context.Context) error {
+	for {
+		messages, err := r.store.ClaimOutboxMessages(ctx, r.batchSize, time.Now().Add(claimLease))
+		if err != nil {
+			return errors.Wrap(err, "failed to claim outbox messages")
+		}
+
+		for _, message := range messages {
+			r.relay(ctx, message)
+		}
+
+		if len(messages) < int(r.batchSize) {
+			return nil
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context, message Message) {
+	body, err := json.Marshal(Envelope{
+		ID:   message.ID,
+		Type: message.Type,
+		Data: message.Payload,
+	})
+	if err == nil {
+		err = r.publish(message.Queue, message.ID, body)
+	}
+
+	if err != nil {
+		delay := backoff(message.Attempts)
+		logger.WarnContext(ctx, "outbox message publish failed",
+			slog.String("messageID", message.ID),
+			slog.String("type", message.Type),
+			slog.Int("attempts", int(message.Attempts)+1),
+			slog.Duration("retryIn", delay),
+			slog.Any("error", err))
+
+		if err := r.store.MarkOutboxMessageFailed(ctx, message.ID, time.Now().Add(delay), err); err != nil {
+			logger.ErrorContext(ctx, "failed to record outbox failure", slog.String("messageID", message.ID), slog.Any("error", err))
+		}
+		return
+	}
+
+	// a failure here only means the message is published again once its claim lapses
+	if err := r.store.MarkOutboxMessagePublished(ctx, message.ID); err != nil {
+		logger.ErrorContext(ctx, "failed to mark outbox message published", slog.String("messageID", message.ID), slog.Any("error", err))
+	}
+}
+
+func backoff(attempts int32) time.Duration {
+	if attempts >= 9 {
+		return maxBackoff
+	}
+	return min(time.Second<<attempts, maxBackoff)
+}
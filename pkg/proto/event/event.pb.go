@@ -7,6 +7,7 @@
 package eventpb
 
 import (
+	money "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -23,18 +24,38 @@ const (
 
 // Event message
 type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	LocationId    string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	Artist        []string               `protobuf:"bytes,5,rep,name=artist,proto3" json:"artist,omitempty"`
-	EventDate     string                 `protobuf:"bytes,6,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
-	Thumbnail     string                 `protobuf:"bytes,7,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	Images        []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeletedAt     string                 `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LocationId  string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Artist      []string               `protobuf:"bytes,5,rep,name=artist,proto3" json:"artist,omitempty"`
+	EventDate   string                 `protobuf:"bytes,6,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	Thumbnail   string                 `protobuf:"bytes,7,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Images      []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt   string                 `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// 0 means no per-user limit
+	MaxTicketsPerUser int32 `protobuf:"varint,12,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	// how far a buyer may extend a pending hold once, 0 disables extensions
+	MaxHoldExtensionSeconds int32 `protobuf:"varint,13,opt,name=max_hold_extension_seconds,json=maxHoldExtensionSeconds,proto3" json:"max_hold_extension_seconds,omitempty"`
+	// confirmed bookings can be refunded until this many hours before the event
+	RefundDeadlineHours int32 `protobuf:"varint,14,opt,name=refund_deadline_hours,json=refundDeadlineHours,proto3" json:"refund_deadline_hours,omitempty"`
+	// share of the total paid back on a refund, 0 disables refunds
+	RefundPercentage int32 `protobuf:"varint,15,opt,name=refund_percentage,json=refundPercentage,proto3" json:"refund_percentage,omitempty"`
+	// tickets can be transferred until this many hours before the event
+	TransferCutoffHours int32 `protobuf:"varint,16,opt,name=transfer_cutoff_hours,json=transferCutoffHours,proto3" json:"transfer_cutoff_hours,omitempty"`
+	// applications for the ballot are taken from ballot_opens_at until ballot_closes_at, RFC3339,
+	// both empty when the event sells first come first served
+	BallotOpensAt  string `protobuf:"bytes,17,opt,name=ballot_opens_at,json=ballotOpensAt,proto3" json:"ballot_opens_at,omitempty"`
+	BallotClosesAt string `protobuf:"bytes,18,opt,name=ballot_closes_at,json=ballotClosesAt,proto3" json:"ballot_closes_at,omitempty"`
+	// winners of the ballot draw have this many hours to pay for their seats
+	BallotPaymentHours int32 `protobuf:"varint,19,opt,name=ballot_payment_hours,json=ballotPaymentHours,proto3" json:"ballot_payment_hours,omitempty"`
+	// ISO 4217 code every zone of the event is priced in
+	Currency string `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`
+	// unset when the event charges the default fees and VAT
+	Fees          *EventFees `protobuf:"bytes,21,opt,name=fees,proto3" json:"fees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -146,24 +167,180 @@ func (x *Event) GetDeletedAt() string {
 	return ""
 }
 
+func (x *Event) GetMaxTicketsPerUser() int32 {
+	if x != nil {
+		return x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (x *Event) GetMaxHoldExtensionSeconds() int32 {
+	if x != nil {
+		return x.MaxHoldExtensionSeconds
+	}
+	return 0
+}
+
+func (x *Event) GetRefundDeadlineHours() int32 {
+	if x != nil {
+		return x.RefundDeadlineHours
+	}
+	return 0
+}
+
+func (x *Event) GetRefundPercentage() int32 {
+	if x != nil {
+		return x.RefundPercentage
+	}
+	return 0
+}
+
+func (x *Event) GetTransferCutoffHours() int32 {
+	if x != nil {
+		return x.TransferCutoffHours
+	}
+	return 0
+}
+
+func (x *Event) GetBallotOpensAt() string {
+	if x != nil {
+		return x.BallotOpensAt
+	}
+	return ""
+}
+
+func (x *Event) GetBallotClosesAt() string {
+	if x != nil {
+		return x.BallotClosesAt
+	}
+	return ""
+}
+
+func (x *Event) GetBallotPaymentHours() int32 {
+	if x != nil {
+		return x.BallotPaymentHours
+	}
+	return 0
+}
+
+func (x *Event) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Event) GetFees() *EventFees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// EventFees overrides the fees and VAT the reservation service charges by default, an unset field keeps
+// the default. Fees are in the minor unit of the currency of the event.
+type EventFees struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// charged per ticket
+	BookingFee *int64 `protobuf:"varint,1,opt,name=booking_fee,json=bookingFee,proto3,oneof" json:"booking_fee,omitempty"`
+	// charged once per order
+	ProcessingFee *int64 `protobuf:"varint,2,opt,name=processing_fee,json=processingFee,proto3,oneof" json:"processing_fee,omitempty"`
+	// VAT in percent, 7 in Thailand
+	VatRate *float64 `protobuf:"fixed64,3,opt,name=vat_rate,json=vatRate,proto3,oneof" json:"vat_rate,omitempty"`
+	// whether zone prices and fees already include the VAT
+	VatInclusive  *bool `protobuf:"varint,4,opt,name=vat_inclusive,json=vatInclusive,proto3,oneof" json:"vat_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFees) Reset() {
+	*x = EventFees{}
+	mi := &file_event_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFees) ProtoMessage() {}
+
+func (x *EventFees) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFees.ProtoReflect.Descriptor instead.
+func (*EventFees) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventFees) GetBookingFee() int64 {
+	if x != nil && x.BookingFee != nil {
+		return *x.BookingFee
+	}
+	return 0
+}
+
+func (x *EventFees) GetProcessingFee() int64 {
+	if x != nil && x.ProcessingFee != nil {
+		return *x.ProcessingFee
+	}
+	return 0
+}
+
+func (x *EventFees) GetVatRate() float64 {
+	if x != nil && x.VatRate != nil {
+		return *x.VatRate
+	}
+	return 0
+}
+
+func (x *EventFees) GetVatInclusive() bool {
+	if x != nil && x.VatInclusive != nil {
+		return *x.VatInclusive
+	}
+	return false
+}
+
 // CreateEvent
 type CreateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LocationId    string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	Artist        []string               `protobuf:"bytes,4,rep,name=artist,proto3" json:"artist,omitempty"`
-	EventDate     string                 `protobuf:"bytes,5,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
-	Thumbnail     string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	UserId        string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LocationId        string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Artist            []string               `protobuf:"bytes,4,rep,name=artist,proto3" json:"artist,omitempty"`
+	EventDate         string                 `protobuf:"bytes,5,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	Thumbnail         string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Images            []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	UserId            string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxTicketsPerUser int32                  `protobuf:"varint,9,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	// defaults to 300 seconds when unset
+	MaxHoldExtensionSeconds *int32 `protobuf:"varint,10,opt,name=max_hold_extension_seconds,json=maxHoldExtensionSeconds,proto3,oneof" json:"max_hold_extension_seconds,omitempty"`
+	RefundDeadlineHours     int32  `protobuf:"varint,11,opt,name=refund_deadline_hours,json=refundDeadlineHours,proto3" json:"refund_deadline_hours,omitempty"`
+	RefundPercentage        int32  `protobuf:"varint,12,opt,name=refund_percentage,json=refundPercentage,proto3" json:"refund_percentage,omitempty"`
+	TransferCutoffHours     int32  `protobuf:"varint,13,opt,name=transfer_cutoff_hours,json=transferCutoffHours,proto3" json:"transfer_cutoff_hours,omitempty"`
+	BallotOpensAt           string `protobuf:"bytes,14,opt,name=ballot_opens_at,json=ballotOpensAt,proto3" json:"ballot_opens_at,omitempty"`
+	BallotClosesAt          string `protobuf:"bytes,15,opt,name=ballot_closes_at,json=ballotClosesAt,proto3" json:"ballot_closes_at,omitempty"`
+	BallotPaymentHours      int32  `protobuf:"varint,16,opt,name=ballot_payment_hours,json=ballotPaymentHours,proto3" json:"ballot_payment_hours,omitempty"`
+	// defaults to THB when unset
+	Currency      string     `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	Fees          *EventFees `protobuf:"bytes,18,opt,name=fees,proto3" json:"fees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateEventRequest) Reset() {
 	*x = CreateEventRequest{}
-	mi := &file_event_event_proto_msgTypes[1]
+	mi := &file_event_event_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +352,7 @@ func (x *CreateEventRequest) String() string {
 func (*CreateEventRequest) ProtoMessage() {}
 
 func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[1]
+	mi := &file_event_event_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +365,7 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{1}
+	return file_event_event_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateEventRequest) GetName() string {
@@ -247,6 +424,76 @@ func (x *CreateEventRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateEventRequest) GetMaxTicketsPerUser() int32 {
+	if x != nil {
+		return x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetMaxHoldExtensionSeconds() int32 {
+	if x != nil && x.MaxHoldExtensionSeconds != nil {
+		return *x.MaxHoldExtensionSeconds
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetRefundDeadlineHours() int32 {
+	if x != nil {
+		return x.RefundDeadlineHours
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetRefundPercentage() int32 {
+	if x != nil {
+		return x.RefundPercentage
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetTransferCutoffHours() int32 {
+	if x != nil {
+		return x.TransferCutoffHours
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetBallotOpensAt() string {
+	if x != nil {
+		return x.BallotOpensAt
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetBallotClosesAt() string {
+	if x != nil {
+		return x.BallotClosesAt
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetBallotPaymentHours() int32 {
+	if x != nil {
+		return x.BallotPaymentHours
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetFees() *EventFees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
 type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -256,7 +503,7 @@ type CreateEventResponse struct {
 
 func (x *CreateEventResponse) Reset() {
 	*x = CreateEventResponse{}
-	mi := &file_event_event_proto_msgTypes[2]
+	mi := &file_event_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +515,7 @@ func (x *CreateEventResponse) String() string {
 func (*CreateEventResponse) ProtoMessage() {}
 
 func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[2]
+	mi := &file_event_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +528,7 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
 func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{2}
+	return file_event_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateEventResponse) GetId() string {
@@ -301,7 +548,7 @@ type GetEventRequest struct {
 
 func (x *GetEventRequest) Reset() {
 	*x = GetEventRequest{}
-	mi := &file_event_event_proto_msgTypes[3]
+	mi := &file_event_event_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +560,7 @@ func (x *GetEventRequest) String() string {
 func (*GetEventRequest) ProtoMessage() {}
 
 func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[3]
+	mi := &file_event_event_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +573,7 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
 func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{3}
+	return file_event_event_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetEventRequest) GetId() string {
@@ -345,7 +592,7 @@ type GetEventResponse struct {
 
 func (x *GetEventResponse) Reset() {
 	*x = GetEventResponse{}
-	mi := &file_event_event_proto_msgTypes[4]
+	mi := &file_event_event_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +604,7 @@ func (x *GetEventResponse) String() string {
 func (*GetEventResponse) ProtoMessage() {}
 
 func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[4]
+	mi := &file_event_event_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +617,7 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
 func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{4}
+	return file_event_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetEventResponse) GetEvent() *Event {
@@ -382,22 +629,35 @@ func (x *GetEventResponse) GetEvent() *Event {
 
 // UpdateEvent
 type UpdateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	LocationId    *string                `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
-	Artist        []string               `protobuf:"bytes,5,rep,name=artist,proto3" json:"artist,omitempty"`
-	EventDate     *string                `protobuf:"bytes,6,opt,name=event_date,json=eventDate,proto3,oneof" json:"event_date,omitempty"`
-	Thumbnail     *string                `protobuf:"bytes,7,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
-	Images        []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description             *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	LocationId              *string                `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
+	Artist                  []string               `protobuf:"bytes,5,rep,name=artist,proto3" json:"artist,omitempty"`
+	EventDate               *string                `protobuf:"bytes,6,opt,name=event_date,json=eventDate,proto3,oneof" json:"event_date,omitempty"`
+	Thumbnail               *string                `protobuf:"bytes,7,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	Images                  []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	MaxTicketsPerUser       *int32                 `protobuf:"varint,9,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3,oneof" json:"max_tickets_per_user,omitempty"`
+	MaxHoldExtensionSeconds *int32                 `protobuf:"varint,10,opt,name=max_hold_extension_seconds,json=maxHoldExtensionSeconds,proto3,oneof" json:"max_hold_extension_seconds,omitempty"`
+	RefundDeadlineHours     *int32                 `protobuf:"varint,11,opt,name=refund_deadline_hours,json=refundDeadlineHours,proto3,oneof" json:"refund_deadline_hours,omitempty"`
+	RefundPercentage        *int32                 `protobuf:"varint,12,opt,name=refund_percentage,json=refundPercentage,proto3,oneof" json:"refund_percentage,omitempty"`
+	TransferCutoffHours     *int32                 `protobuf:"varint,13,opt,name=transfer_cutoff_hours,json=transferCutoffHours,proto3,oneof" json:"transfer_cutoff_hours,omitempty"`
+	// empty strings clear the ballot window
+	BallotOpensAt      *string `protobuf:"bytes,14,opt,name=ballot_opens_at,json=ballotOpensAt,proto3,oneof" json:"ballot_opens_at,omitempty"`
+	BallotClosesAt     *string `protobuf:"bytes,15,opt,name=ballot_closes_at,json=ballotClosesAt,proto3,oneof" json:"ballot_closes_at,omitempty"`
+	BallotPaymentHours *int32  `protobuf:"varint,16,opt,name=ballot_payment_hours,json=ballotPaymentHours,proto3,oneof" json:"ballot_payment_hours,omitempty"`
+	// can only change while no zone is priced yet
+	Currency *string `protobuf:"bytes,17,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	// replaces every override when set, an empty message goes back to the defaults
+	Fees          *EventFees `protobuf:"bytes,18,opt,name=fees,proto3" json:"fees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateEventRequest) Reset() {
 	*x = UpdateEventRequest{}
-	mi := &file_event_event_proto_msgTypes[5]
+	mi := &file_event_event_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +669,7 @@ func (x *UpdateEventRequest) String() string {
 func (*UpdateEventRequest) ProtoMessage() {}
 
 func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[5]
+	mi := &file_event_event_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +682,7 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{5}
+	return file_event_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateEventRequest) GetId() string {
@@ -481,6 +741,76 @@ func (x *UpdateEventRequest) GetImages() []string {
 	return nil
 }
 
+func (x *UpdateEventRequest) GetMaxTicketsPerUser() int32 {
+	if x != nil && x.MaxTicketsPerUser != nil {
+		return *x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetMaxHoldExtensionSeconds() int32 {
+	if x != nil && x.MaxHoldExtensionSeconds != nil {
+		return *x.MaxHoldExtensionSeconds
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetRefundDeadlineHours() int32 {
+	if x != nil && x.RefundDeadlineHours != nil {
+		return *x.RefundDeadlineHours
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetRefundPercentage() int32 {
+	if x != nil && x.RefundPercentage != nil {
+		return *x.RefundPercentage
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetTransferCutoffHours() int32 {
+	if x != nil && x.TransferCutoffHours != nil {
+		return *x.TransferCutoffHours
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetBallotOpensAt() string {
+	if x != nil && x.BallotOpensAt != nil {
+		return *x.BallotOpensAt
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetBallotClosesAt() string {
+	if x != nil && x.BallotClosesAt != nil {
+		return *x.BallotClosesAt
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetBallotPaymentHours() int32 {
+	if x != nil && x.BallotPaymentHours != nil {
+		return *x.BallotPaymentHours
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetFees() *EventFees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
 type UpdateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -490,7 +820,7 @@ type UpdateEventResponse struct {
 
 func (x *UpdateEventResponse) Reset() {
 	*x = UpdateEventResponse{}
-	mi := &file_event_event_proto_msgTypes[6]
+	mi := &file_event_event_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +832,7 @@ func (x *UpdateEventResponse) String() string {
 func (*UpdateEventResponse) ProtoMessage() {}
 
 func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[6]
+	mi := &file_event_event_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +845,7 @@ func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{6}
+	return file_event_event_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateEventResponse) GetId() string {
@@ -535,7 +865,7 @@ type DeleteEventRequest struct {
 
 func (x *DeleteEventRequest) Reset() {
 	*x = DeleteEventRequest{}
-	mi := &file_event_event_proto_msgTypes[7]
+	mi := &file_event_event_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +877,7 @@ func (x *DeleteEventRequest) String() string {
 func (*DeleteEventRequest) ProtoMessage() {}
 
 func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[7]
+	mi := &file_event_event_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +890,7 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{7}
+	return file_event_event_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteEventRequest) GetId() string {
@@ -580,7 +910,7 @@ type DeleteEventResponse struct {
 
 func (x *DeleteEventResponse) Reset() {
 	*x = DeleteEventResponse{}
-	mi := &file_event_event_proto_msgTypes[8]
+	mi := &file_event_event_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +922,7 @@ func (x *DeleteEventResponse) String() string {
 func (*DeleteEventResponse) ProtoMessage() {}
 
 func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[8]
+	mi := &file_event_event_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +935,7 @@ func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{8}
+	return file_event_event_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteEventResponse) GetId() string {
@@ -628,7 +958,7 @@ type Pagination struct {
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_event_event_proto_msgTypes[9]
+	mi := &file_event_event_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +970,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[9]
+	mi := &file_event_event_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +983,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{9}
+	return file_event_event_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Pagination) GetTotal() int32 {
@@ -705,7 +1035,7 @@ type ListEventsRequest struct {
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_event_event_proto_msgTypes[10]
+	mi := &file_event_event_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +1047,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[10]
+	mi := &file_event_event_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +1060,7 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{10}
+	return file_event_event_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListEventsRequest) GetQuery() string {
@@ -778,7 +1108,7 @@ type ListEventsResponse struct {
 
 func (x *ListEventsResponse) Reset() {
 	*x = ListEventsResponse{}
-	mi := &file_event_event_proto_msgTypes[11]
+	mi := &file_event_event_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +1120,7 @@ func (x *ListEventsResponse) String() string {
 func (*ListEventsResponse) ProtoMessage() {}
 
 func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[11]
+	mi := &file_event_event_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1133,7 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{11}
+	return file_event_event_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListEventsResponse) GetEvents() []*Event {
@@ -828,7 +1158,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_event_event_proto_msgTypes[12]
+	mi := &file_event_event_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +1170,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[12]
+	mi := &file_event_event_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,28 +1183,34 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{12}
+	return file_event_event_proto_rawDescGZIP(), []int{13}
 }
 
 // EventZone message
 type EventZone struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	LocationId    string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	ZoneNumber    int32                  `protobuf:"varint,4,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
-	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	IsSoldOut     bool                   `protobuf:"varint,9,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId     string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	LocationId  string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	ZoneNumber  int32                  `protobuf:"varint,4,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Price       *money.Money           `protobuf:"bytes,12,opt,name=price,proto3" json:"price,omitempty"`
+	Color       string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	Name        string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	IsSoldOut   bool                   `protobuf:"varint,9,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`
+	ZoneType    string                 `protobuf:"bytes,10,opt,name=zone_type,json=zoneType,proto3" json:"zone_type,omitempty"`
+	Capacity    int32                  `protobuf:"varint,11,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// the ticket types sold in the zone, a seat costs the zone price when none is picked
+	TicketTypes []*TicketType `protobuf:"bytes,13,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	// the scheduled prices of the zone in the order they start
+	PriceTiers    []*PriceTier `protobuf:"bytes,14,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventZone) Reset() {
 	*x = EventZone{}
-	mi := &file_event_event_proto_msgTypes[13]
+	mi := &file_event_event_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1222,7 @@ func (x *EventZone) String() string {
 func (*EventZone) ProtoMessage() {}
 
 func (x *EventZone) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[13]
+	mi := &file_event_event_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1235,7 @@ func (x *EventZone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventZone.ProtoReflect.Descriptor instead.
 func (*EventZone) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{13}
+	return file_event_event_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EventZone) GetId() string {
@@ -930,11 +1266,11 @@ func (x *EventZone) GetZoneNumber() int32 {
 	return 0
 }
 
-func (x *EventZone) GetPrice() float64 {
+func (x *EventZone) GetPrice() *money.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *EventZone) GetColor() string {
@@ -965,22 +1301,54 @@ func (x *EventZone) GetIsSoldOut() bool {
 	return false
 }
 
+func (x *EventZone) GetZoneType() string {
+	if x != nil {
+		return x.ZoneType
+	}
+	return ""
+}
+
+func (x *EventZone) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *EventZone) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+func (x *EventZone) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
 type CreateEventZoneRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	ZoneNumber    int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	LocationId string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	ZoneNumber int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	// in the currency of the event, which is taken when the currency is empty
+	Price       *money.Money `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Color       string       `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Name        string       `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Description string       `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// SEATED or GENERAL_ADMISSION, general admission zones need a capacity
+	ZoneType      string `protobuf:"bytes,8,opt,name=zone_type,json=zoneType,proto3" json:"zone_type,omitempty"`
+	Capacity      int32  `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateEventZoneRequest) Reset() {
 	*x = CreateEventZoneRequest{}
-	mi := &file_event_event_proto_msgTypes[14]
+	mi := &file_event_event_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -992,7 +1360,7 @@ func (x *CreateEventZoneRequest) String() string {
 func (*CreateEventZoneRequest) ProtoMessage() {}
 
 func (x *CreateEventZoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[14]
+	mi := &file_event_event_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +1373,7 @@ func (x *CreateEventZoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventZoneRequest.ProtoReflect.Descriptor instead.
 func (*CreateEventZoneRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{14}
+	return file_event_event_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateEventZoneRequest) GetEventId() string {
@@ -1029,11 +1397,11 @@ func (x *CreateEventZoneRequest) GetZoneNumber() int32 {
 	return 0
 }
 
-func (x *CreateEventZoneRequest) GetPrice() float64 {
+func (x *CreateEventZoneRequest) GetPrice() *money.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *CreateEventZoneRequest) GetColor() string {
@@ -1057,6 +1425,20 @@ func (x *CreateEventZoneRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateEventZoneRequest) GetZoneType() string {
+	if x != nil {
+		return x.ZoneType
+	}
+	return ""
+}
+
+func (x *CreateEventZoneRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
 type CreateEventZoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1066,7 +1448,7 @@ type CreateEventZoneResponse struct {
 
 func (x *CreateEventZoneResponse) Reset() {
 	*x = CreateEventZoneResponse{}
-	mi := &file_event_event_proto_msgTypes[15]
+	mi := &file_event_event_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1078,7 +1460,7 @@ func (x *CreateEventZoneResponse) String() string {
 func (*CreateEventZoneResponse) ProtoMessage() {}
 
 func (x *CreateEventZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[15]
+	mi := &file_event_event_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1473,7 @@ func (x *CreateEventZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventZoneResponse.ProtoReflect.Descriptor instead.
 func (*CreateEventZoneResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{15}
+	return file_event_event_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateEventZoneResponse) GetId() string {
@@ -1110,7 +1492,7 @@ type GetEventZoneByEventIdRequest struct {
 
 func (x *GetEventZoneByEventIdRequest) Reset() {
 	*x = GetEventZoneByEventIdRequest{}
-	mi := &file_event_event_proto_msgTypes[16]
+	mi := &file_event_event_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1504,7 @@ func (x *GetEventZoneByEventIdRequest) String() string {
 func (*GetEventZoneByEventIdRequest) ProtoMessage() {}
 
 func (x *GetEventZoneByEventIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[16]
+	mi := &file_event_event_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1517,7 @@ func (x *GetEventZoneByEventIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventZoneByEventIdRequest.ProtoReflect.Descriptor instead.
 func (*GetEventZoneByEventIdRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{16}
+	return file_event_event_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetEventZoneByEventIdRequest) GetEventId() string {
@@ -1154,7 +1536,7 @@ type GetEventZoneByEventIdResponse struct {
 
 func (x *GetEventZoneByEventIdResponse) Reset() {
 	*x = GetEventZoneByEventIdResponse{}
-	mi := &file_event_event_proto_msgTypes[17]
+	mi := &file_event_event_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1548,7 @@ func (x *GetEventZoneByEventIdResponse) String() string {
 func (*GetEventZoneByEventIdResponse) ProtoMessage() {}
 
 func (x *GetEventZoneByEventIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[17]
+	mi := &file_event_event_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1561,7 @@ func (x *GetEventZoneByEventIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventZoneByEventIdResponse.ProtoReflect.Descriptor instead.
 func (*GetEventZoneByEventIdResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{17}
+	return file_event_event_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetEventZoneByEventIdResponse) GetList() []*EventZone {
@@ -1195,18 +1577,20 @@ type UpdateEventZoneRequest struct {
 	EventId       *string                `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,oneof" json:"event_id,omitempty"`
 	LocationId    *string                `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3,oneof" json:"location_id,omitempty"`
 	ZoneNumber    *int32                 `protobuf:"varint,4,opt,name=zone_number,json=zoneNumber,proto3,oneof" json:"zone_number,omitempty"`
-	Price         *float64               `protobuf:"fixed64,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,12,opt,name=price,proto3" json:"price,omitempty"`
 	Color         *string                `protobuf:"bytes,6,opt,name=color,proto3,oneof" json:"color,omitempty"`
 	Name          *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	Description   *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	IsSoldOut     *bool                  `protobuf:"varint,9,opt,name=is_sold_out,json=isSoldOut,proto3,oneof" json:"is_sold_out,omitempty"`
+	ZoneType      *string                `protobuf:"bytes,10,opt,name=zone_type,json=zoneType,proto3,oneof" json:"zone_type,omitempty"`
+	Capacity      *int32                 `protobuf:"varint,11,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateEventZoneRequest) Reset() {
 	*x = UpdateEventZoneRequest{}
-	mi := &file_event_event_proto_msgTypes[18]
+	mi := &file_event_event_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +1602,7 @@ func (x *UpdateEventZoneRequest) String() string {
 func (*UpdateEventZoneRequest) ProtoMessage() {}
 
 func (x *UpdateEventZoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[18]
+	mi := &file_event_event_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1615,7 @@ func (x *UpdateEventZoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventZoneRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventZoneRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{18}
+	return file_event_event_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateEventZoneRequest) GetId() string {
@@ -1262,11 +1646,11 @@ func (x *UpdateEventZoneRequest) GetZoneNumber() int32 {
 	return 0
 }
 
-func (x *UpdateEventZoneRequest) GetPrice() float64 {
-	if x != nil && x.Price != nil {
-		return *x.Price
+func (x *UpdateEventZoneRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateEventZoneRequest) GetColor() string {
@@ -1297,6 +1681,20 @@ func (x *UpdateEventZoneRequest) GetIsSoldOut() bool {
 	return false
 }
 
+func (x *UpdateEventZoneRequest) GetZoneType() string {
+	if x != nil && x.ZoneType != nil {
+		return *x.ZoneType
+	}
+	return ""
+}
+
+func (x *UpdateEventZoneRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
 type UpdateEventZoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1306,7 +1704,7 @@ type UpdateEventZoneResponse struct {
 
 func (x *UpdateEventZoneResponse) Reset() {
 	*x = UpdateEventZoneResponse{}
-	mi := &file_event_event_proto_msgTypes[19]
+	mi := &file_event_event_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +1716,7 @@ func (x *UpdateEventZoneResponse) String() string {
 func (*UpdateEventZoneResponse) ProtoMessage() {}
 
 func (x *UpdateEventZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[19]
+	mi := &file_event_event_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1729,7 @@ func (x *UpdateEventZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventZoneResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEventZoneResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{19}
+	return file_event_event_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateEventZoneResponse) GetId() string {
@@ -1350,7 +1748,7 @@ type DeleteEventZoneRequest struct {
 
 func (x *DeleteEventZoneRequest) Reset() {
 	*x = DeleteEventZoneRequest{}
-	mi := &file_event_event_proto_msgTypes[20]
+	mi := &file_event_event_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1362,7 +1760,7 @@ func (x *DeleteEventZoneRequest) String() string {
 func (*DeleteEventZoneRequest) ProtoMessage() {}
 
 func (x *DeleteEventZoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[20]
+	mi := &file_event_event_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,7 +1773,7 @@ func (x *DeleteEventZoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventZoneRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventZoneRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{20}
+	return file_event_event_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteEventZoneRequest) GetId() string {
@@ -1385,147 +1783,1096 @@ func (x *DeleteEventZoneRequest) GetId() string {
 	return ""
 }
 
-var File_event_event_proto protoreflect.FileDescriptor
+// TicketType is a kind of ticket sold in a zone, adult, student or a VIP package, at its own price
+type TicketType struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventZoneId string                 `protobuf:"bytes,2,opt,name=event_zone_id,json=eventZoneId,proto3" json:"event_zone_id,omitempty"`
+	ZoneNumber  int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price       *money.Money           `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// most tickets of the type sold over all reservations, 0 means no cap
+	QuantityCap int32 `protobuf:"varint,7,opt,name=quantity_cap,json=quantityCap,proto3" json:"quantity_cap,omitempty"`
+	// whether the holder has to prove they are eligible at the door, a student card for example
+	RequiresVerification bool `protobuf:"varint,8,opt,name=requires_verification,json=requiresVerification,proto3" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TicketType) Reset() {
+	*x = TicketType{}
+	mi := &file_event_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_event_event_proto_rawDesc = "" +
-	"\n" +
-	"\x11event/event.proto\x12\x05event\"\xb8\x02\n" +
-	"\x05Event\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vlocation_id\x18\x04 \x01(\tR\n" +
-	"locationId\x12\x16\n" +
-	"\x06artist\x18\x05 \x03(\tR\x06artist\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x06 \x01(\tR\teventDate\x12\x1c\n" +
-	"\tthumbnail\x18\a \x01(\tR\tthumbnail\x12\x16\n" +
-	"\x06images\x18\b \x03(\tR\x06images\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\x12\x1d\n" +
-	"\n" +
-	"deleted_at\x18\v \x01(\tR\tdeletedAt\"\xf1\x01\n" +
-	"\x12CreateEventRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vlocation_id\x18\x03 \x01(\tR\n" +
-	"locationId\x12\x16\n" +
-	"\x06artist\x18\x04 \x03(\tR\x06artist\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x05 \x01(\tR\teventDate\x12\x1c\n" +
-	"\tthumbnail\x18\x06 \x01(\tR\tthumbnail\x12\x16\n" +
-	"\x06images\x18\a \x03(\tR\x06images\x12\x17\n" +
-	"\auser_id\x18\b \x01(\tR\x06userId\"%\n" +
-	"\x13CreateEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
-	"\x0fGetEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
-	"\x10GetEventResponse\x12\"\n" +
-	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\xc7\x02\n" +
-	"\x12UpdateEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
-	"\vlocation_id\x18\x04 \x01(\tH\x02R\n" +
-	"locationId\x88\x01\x01\x12\x16\n" +
-	"\x06artist\x18\x05 \x03(\tR\x06artist\x12\"\n" +
-	"\n" +
-	"event_date\x18\x06 \x01(\tH\x03R\teventDate\x88\x01\x01\x12!\n" +
-	"\tthumbnail\x18\a \x01(\tH\x04R\tthumbnail\x88\x01\x01\x12\x16\n" +
-	"\x06images\x18\b \x03(\tR\x06imagesB\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_descriptionB\x0e\n" +
-	"\f_location_idB\r\n" +
-	"\v_event_dateB\f\n" +
-	"\n" +
-	"_thumbnail\"%\n" +
-	"\x13UpdateEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
-	"\x12DeleteEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
-	"\x13DeleteEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x83\x01\n" +
-	"\n" +
-	"Pagination\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
-	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"\xce\x01\n" +
-	"\x11ListEventsRequest\x12\x19\n" +
-	"\x05query\x18\x01 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x1c\n" +
-	"\asort_by\x18\x02 \x01(\tH\x01R\x06sortBy\x88\x01\x01\x12\x19\n" +
-	"\x05order\x18\x03 \x01(\tH\x02R\x05order\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x05 \x01(\x05H\x04R\x04page\x88\x01\x01B\b\n" +
-	"\x06_queryB\n" +
-	"\n" +
-	"\b_sort_byB\b\n" +
-	"\x06_orderB\b\n" +
-	"\x06_limitB\a\n" +
-	"\x05_page\"m\n" +
-	"\x12ListEventsResponse\x12$\n" +
-	"\x06events\x18\x01 \x03(\v2\f.event.EventR\x06events\x121\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x11.event.PaginationR\n" +
-	"pagination\"\a\n" +
-	"\x05Empty\"\xfa\x01\n" +
-	"\tEventZone\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vlocation_id\x18\x03 \x01(\tR\n" +
-	"locationId\x12\x1f\n" +
-	"\vzone_number\x18\x04 \x01(\x05R\n" +
-	"zoneNumber\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x14\n" +
-	"\x05color\x18\x06 \x01(\tR\x05color\x12\x12\n" +
-	"\x04name\x18\a \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1e\n" +
-	"\vis_sold_out\x18\t \x01(\bR\tisSoldOut\"\xd7\x01\n" +
-	"\x16CreateEventZoneRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vlocation_id\x18\x02 \x01(\tR\n" +
-	"locationId\x12\x1f\n" +
-	"\vzone_number\x18\x03 \x01(\x05R\n" +
-	"zoneNumber\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x14\n" +
-	"\x05color\x18\x05 \x01(\tR\x05color\x12\x12\n" +
-	"\x04name\x18\x06 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\")\n" +
-	"\x17CreateEventZoneResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
-	"\x1cGetEventZoneByEventIdRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\"E\n" +
-	"\x1dGetEventZoneByEventIdResponse\x12$\n" +
-	"\x04list\x18\x01 \x03(\v2\x10.event.EventZoneR\x04list\"\x99\x03\n" +
-	"\x16UpdateEventZoneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
-	"\bevent_id\x18\x02 \x01(\tH\x00R\aeventId\x88\x01\x01\x12$\n" +
-	"\vlocation_id\x18\x03 \x01(\tH\x01R\n" +
-	"locationId\x88\x01\x01\x12$\n" +
-	"\vzone_number\x18\x04 \x01(\x05H\x02R\n" +
-	"zoneNumber\x88\x01\x01\x12\x19\n" +
-	"\x05price\x18\x05 \x01(\x01H\x03R\x05price\x88\x01\x01\x12\x19\n" +
-	"\x05color\x18\x06 \x01(\tH\x04R\x05color\x88\x01\x01\x12\x17\n" +
-	"\x04name\x18\a \x01(\tH\x05R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\b \x01(\tH\x06R\vdescription\x88\x01\x01\x12#\n" +
-	"\vis_sold_out\x18\t \x01(\bH\aR\tisSoldOut\x88\x01\x01B\v\n" +
-	"\t_event_idB\x0e\n" +
-	"\f_location_idB\x0e\n" +
-	"\f_zone_numberB\b\n" +
-	"\x06_priceB\b\n" +
-	"\x06_colorB\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_descriptionB\x0e\n" +
-	"\f_is_sold_out\")\n" +
-	"\x17UpdateEventZoneResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
-	"\x16DeleteEventZoneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\x9a\x05\n" +
+func (x *TicketType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketType) ProtoMessage() {}
+
+func (x *TicketType) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TicketType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketType) GetEventZoneId() string {
+	if x != nil {
+		return x.EventZoneId
+	}
+	return ""
+}
+
+func (x *TicketType) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TicketType) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *TicketType) GetQuantityCap() int32 {
+	if x != nil {
+		return x.QuantityCap
+	}
+	return 0
+}
+
+func (x *TicketType) GetRequiresVerification() bool {
+	if x != nil {
+		return x.RequiresVerification
+	}
+	return false
+}
+
+type CreateTicketTypeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventZoneId string                 `protobuf:"bytes,1,opt,name=event_zone_id,json=eventZoneId,proto3" json:"event_zone_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// in the currency of the event, which is taken when the currency is empty
+	Price                *money.Money `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	QuantityCap          int32        `protobuf:"varint,5,opt,name=quantity_cap,json=quantityCap,proto3" json:"quantity_cap,omitempty"`
+	RequiresVerification bool         `protobuf:"varint,6,opt,name=requires_verification,json=requiresVerification,proto3" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeRequest) Reset() {
+	*x = CreateTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeRequest) ProtoMessage() {}
+
+func (x *CreateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateTicketTypeRequest) GetEventZoneId() string {
+	if x != nil {
+		return x.EventZoneId
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateTicketTypeRequest) GetQuantityCap() int32 {
+	if x != nil {
+		return x.QuantityCap
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetRequiresVerification() bool {
+	if x != nil {
+		return x.RequiresVerification
+	}
+	return false
+}
+
+type CreateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeResponse) Reset() {
+	*x = CreateTicketTypeResponse{}
+	mi := &file_event_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeResponse) ProtoMessage() {}
+
+func (x *CreateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateTicketTypeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateTicketTypeRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description          *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price                *money.Money           `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	QuantityCap          *int32                 `protobuf:"varint,5,opt,name=quantity_cap,json=quantityCap,proto3,oneof" json:"quantity_cap,omitempty"`
+	RequiresVerification *bool                  `protobuf:"varint,6,opt,name=requires_verification,json=requiresVerification,proto3,oneof" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeRequest) Reset() {
+	*x = UpdateTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeRequest) ProtoMessage() {}
+
+func (x *UpdateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateTicketTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateTicketTypeRequest) GetQuantityCap() int32 {
+	if x != nil && x.QuantityCap != nil {
+		return *x.QuantityCap
+	}
+	return 0
+}
+
+func (x *UpdateTicketTypeRequest) GetRequiresVerification() bool {
+	if x != nil && x.RequiresVerification != nil {
+		return *x.RequiresVerification
+	}
+	return false
+}
+
+type UpdateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeResponse) Reset() {
+	*x = UpdateTicketTypeResponse{}
+	mi := &file_event_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeResponse) ProtoMessage() {}
+
+func (x *UpdateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateTicketTypeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTicketTypeRequest) Reset() {
+	*x = DeleteTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTicketTypeRequest) ProtoMessage() {}
+
+func (x *DeleteTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTicketTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PriceTier is a scheduled price of a zone, early-bird or door pricing. It sells from starts_at until
+// ends_at or until sell_through tickets of the zone are sold, whichever comes first
+type PriceTier struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventZoneId string                 `protobuf:"bytes,2,opt,name=event_zone_id,json=eventZoneId,proto3" json:"event_zone_id,omitempty"`
+	ZoneNumber  int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Price       *money.Money           `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// RFC3339
+	StartsAt string `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// RFC3339, empty when the tier only ends on sell_through
+	EndsAt string `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// tickets of the zone sold before the tier ends, 0 when it only ends at ends_at
+	SellThrough   int32 `protobuf:"varint,8,opt,name=sell_through,json=sellThrough,proto3" json:"sell_through,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_event_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PriceTier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceTier) GetEventZoneId() string {
+	if x != nil {
+		return x.EventZoneId
+	}
+	return ""
+}
+
+func (x *PriceTier) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *PriceTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceTier) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceTier) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *PriceTier) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *PriceTier) GetSellThrough() int32 {
+	if x != nil {
+		return x.SellThrough
+	}
+	return 0
+}
+
+type CreatePriceTierRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventZoneId string                 `protobuf:"bytes,1,opt,name=event_zone_id,json=eventZoneId,proto3" json:"event_zone_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// in the currency of the event, which is taken when the currency is empty
+	Price         *money.Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt      string       `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string       `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	SellThrough   int32        `protobuf:"varint,6,opt,name=sell_through,json=sellThrough,proto3" json:"sell_through,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceTierRequest) Reset() {
+	*x = CreatePriceTierRequest{}
+	mi := &file_event_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceTierRequest) ProtoMessage() {}
+
+func (x *CreatePriceTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceTierRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceTierRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreatePriceTierRequest) GetEventZoneId() string {
+	if x != nil {
+		return x.EventZoneId
+	}
+	return ""
+}
+
+func (x *CreatePriceTierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePriceTierRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreatePriceTierRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreatePriceTierRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreatePriceTierRequest) GetSellThrough() int32 {
+	if x != nil {
+		return x.SellThrough
+	}
+	return 0
+}
+
+type CreatePriceTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceTierResponse) Reset() {
+	*x = CreatePriceTierResponse{}
+	mi := &file_event_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceTierResponse) ProtoMessage() {}
+
+func (x *CreatePriceTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceTierResponse.ProtoReflect.Descriptor instead.
+func (*CreatePriceTierResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePriceTierResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdatePriceTierRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Price    *money.Money           `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt *string                `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3,oneof" json:"starts_at,omitempty"`
+	// an empty string clears the end time
+	EndsAt        *string `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	SellThrough   *int32  `protobuf:"varint,6,opt,name=sell_through,json=sellThrough,proto3,oneof" json:"sell_through,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePriceTierRequest) Reset() {
+	*x = UpdatePriceTierRequest{}
+	mi := &file_event_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceTierRequest) ProtoMessage() {}
+
+func (x *UpdatePriceTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceTierRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceTierRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdatePriceTierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePriceTierRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePriceTierRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdatePriceTierRequest) GetStartsAt() string {
+	if x != nil && x.StartsAt != nil {
+		return *x.StartsAt
+	}
+	return ""
+}
+
+func (x *UpdatePriceTierRequest) GetEndsAt() string {
+	if x != nil && x.EndsAt != nil {
+		return *x.EndsAt
+	}
+	return ""
+}
+
+func (x *UpdatePriceTierRequest) GetSellThrough() int32 {
+	if x != nil && x.SellThrough != nil {
+		return *x.SellThrough
+	}
+	return 0
+}
+
+type UpdatePriceTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePriceTierResponse) Reset() {
+	*x = UpdatePriceTierResponse{}
+	mi := &file_event_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceTierResponse) ProtoMessage() {}
+
+func (x *UpdatePriceTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceTierResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePriceTierResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePriceTierResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePriceTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceTierRequest) Reset() {
+	*x = DeletePriceTierRequest{}
+	mi := &file_event_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceTierRequest) ProtoMessage() {}
+
+func (x *DeletePriceTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceTierRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceTierRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeletePriceTierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_event_event_proto protoreflect.FileDescriptor
+
+const file_event_event_proto_rawDesc = "" +
+	"\n" +
+	"\x11event/event.proto\x12\x05event\x1a\x11money/money.proto\"\x81\x06\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12\x16\n" +
+	"\x06artist\x18\x05 \x03(\tR\x06artist\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x06 \x01(\tR\teventDate\x12\x1c\n" +
+	"\tthumbnail\x18\a \x01(\tR\tthumbnail\x12\x16\n" +
+	"\x06images\x18\b \x03(\tR\x06images\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\tR\tdeletedAt\x12/\n" +
+	"\x14max_tickets_per_user\x18\f \x01(\x05R\x11maxTicketsPerUser\x12;\n" +
+	"\x1amax_hold_extension_seconds\x18\r \x01(\x05R\x17maxHoldExtensionSeconds\x122\n" +
+	"\x15refund_deadline_hours\x18\x0e \x01(\x05R\x13refundDeadlineHours\x12+\n" +
+	"\x11refund_percentage\x18\x0f \x01(\x05R\x10refundPercentage\x122\n" +
+	"\x15transfer_cutoff_hours\x18\x10 \x01(\x05R\x13transferCutoffHours\x12&\n" +
+	"\x0fballot_opens_at\x18\x11 \x01(\tR\rballotOpensAt\x12(\n" +
+	"\x10ballot_closes_at\x18\x12 \x01(\tR\x0eballotClosesAt\x120\n" +
+	"\x14ballot_payment_hours\x18\x13 \x01(\x05R\x12ballotPaymentHours\x12\x1a\n" +
+	"\bcurrency\x18\x14 \x01(\tR\bcurrency\x12$\n" +
+	"\x04fees\x18\x15 \x01(\v2\x10.event.EventFeesR\x04fees\"\xe9\x01\n" +
+	"\tEventFees\x12$\n" +
+	"\vbooking_fee\x18\x01 \x01(\x03H\x00R\n" +
+	"bookingFee\x88\x01\x01\x12*\n" +
+	"\x0eprocessing_fee\x18\x02 \x01(\x03H\x01R\rprocessingFee\x88\x01\x01\x12\x1e\n" +
+	"\bvat_rate\x18\x03 \x01(\x01H\x02R\avatRate\x88\x01\x01\x12(\n" +
+	"\rvat_inclusive\x18\x04 \x01(\bH\x03R\fvatInclusive\x88\x01\x01B\x0e\n" +
+	"\f_booking_feeB\x11\n" +
+	"\x0f_processing_feeB\v\n" +
+	"\t_vat_rateB\x10\n" +
+	"\x0e_vat_inclusive\"\xde\x05\n" +
+	"\x12CreateEventRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vlocation_id\x18\x03 \x01(\tR\n" +
+	"locationId\x12\x16\n" +
+	"\x06artist\x18\x04 \x03(\tR\x06artist\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x05 \x01(\tR\teventDate\x12\x1c\n" +
+	"\tthumbnail\x18\x06 \x01(\tR\tthumbnail\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x12/\n" +
+	"\x14max_tickets_per_user\x18\t \x01(\x05R\x11maxTicketsPerUser\x12@\n" +
+	"\x1amax_hold_extension_seconds\x18\n" +
+	" \x01(\x05H\x00R\x17maxHoldExtensionSeconds\x88\x01\x01\x122\n" +
+	"\x15refund_deadline_hours\x18\v \x01(\x05R\x13refundDeadlineHours\x12+\n" +
+	"\x11refund_percentage\x18\f \x01(\x05R\x10refundPercentage\x122\n" +
+	"\x15transfer_cutoff_hours\x18\r \x01(\x05R\x13transferCutoffHours\x12&\n" +
+	"\x0fballot_opens_at\x18\x0e \x01(\tR\rballotOpensAt\x12(\n" +
+	"\x10ballot_closes_at\x18\x0f \x01(\tR\x0eballotClosesAt\x120\n" +
+	"\x14ballot_payment_hours\x18\x10 \x01(\x05R\x12ballotPaymentHours\x12\x1a\n" +
+	"\bcurrency\x18\x11 \x01(\tR\bcurrency\x12$\n" +
+	"\x04fees\x18\x12 \x01(\v2\x10.event.EventFeesR\x04feesB\x1d\n" +
+	"\x1b_max_hold_extension_seconds\"%\n" +
+	"\x13CreateEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
+	"\x0fGetEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x10GetEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\x8e\b\n" +
+	"\x12UpdateEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\vlocation_id\x18\x04 \x01(\tH\x02R\n" +
+	"locationId\x88\x01\x01\x12\x16\n" +
+	"\x06artist\x18\x05 \x03(\tR\x06artist\x12\"\n" +
+	"\n" +
+	"event_date\x18\x06 \x01(\tH\x03R\teventDate\x88\x01\x01\x12!\n" +
+	"\tthumbnail\x18\a \x01(\tH\x04R\tthumbnail\x88\x01\x01\x12\x16\n" +
+	"\x06images\x18\b \x03(\tR\x06images\x124\n" +
+	"\x14max_tickets_per_user\x18\t \x01(\x05H\x05R\x11maxTicketsPerUser\x88\x01\x01\x12@\n" +
+	"\x1amax_hold_extension_seconds\x18\n" +
+	" \x01(\x05H\x06R\x17maxHoldExtensionSeconds\x88\x01\x01\x127\n" +
+	"\x15refund_deadline_hours\x18\v \x01(\x05H\aR\x13refundDeadlineHours\x88\x01\x01\x120\n" +
+	"\x11refund_percentage\x18\f \x01(\x05H\bR\x10refundPercentage\x88\x01\x01\x127\n" +
+	"\x15transfer_cutoff_hours\x18\r \x01(\x05H\tR\x13transferCutoffHours\x88\x01\x01\x12+\n" +
+	"\x0fballot_opens_at\x18\x0e \x01(\tH\n" +
+	"R\rballotOpensAt\x88\x01\x01\x12-\n" +
+	"\x10ballot_closes_at\x18\x0f \x01(\tH\vR\x0eballotClosesAt\x88\x01\x01\x125\n" +
+	"\x14ballot_payment_hours\x18\x10 \x01(\x05H\fR\x12ballotPaymentHours\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x11 \x01(\tH\rR\bcurrency\x88\x01\x01\x12$\n" +
+	"\x04fees\x18\x12 \x01(\v2\x10.event.EventFeesR\x04feesB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_location_idB\r\n" +
+	"\v_event_dateB\f\n" +
+	"\n" +
+	"_thumbnailB\x17\n" +
+	"\x15_max_tickets_per_userB\x1d\n" +
+	"\x1b_max_hold_extension_secondsB\x18\n" +
+	"\x16_refund_deadline_hoursB\x14\n" +
+	"\x12_refund_percentageB\x18\n" +
+	"\x16_transfer_cutoff_hoursB\x12\n" +
+	"\x10_ballot_opens_atB\x13\n" +
+	"\x11_ballot_closes_atB\x17\n" +
+	"\x15_ballot_payment_hoursB\v\n" +
+	"\t_currency\"%\n" +
+	"\x13UpdateEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12DeleteEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13DeleteEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x83\x01\n" +
+	"\n" +
+	"Pagination\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"\xce\x01\n" +
+	"\x11ListEventsRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x1c\n" +
+	"\asort_by\x18\x02 \x01(\tH\x01R\x06sortBy\x88\x01\x01\x12\x19\n" +
+	"\x05order\x18\x03 \x01(\tH\x02R\x05order\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x05 \x01(\x05H\x04R\x04page\x88\x01\x01B\b\n" +
+	"\x06_queryB\n" +
+	"\n" +
+	"\b_sort_byB\b\n" +
+	"\x06_orderB\b\n" +
+	"\x06_limitB\a\n" +
+	"\x05_page\"m\n" +
+	"\x12ListEventsResponse\x12$\n" +
+	"\x06events\x18\x01 \x03(\v2\f.event.EventR\x06events\x121\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x11.event.PaginationR\n" +
+	"pagination\"\a\n" +
+	"\x05Empty\"\xb0\x03\n" +
+	"\tEventZone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vlocation_id\x18\x03 \x01(\tR\n" +
+	"locationId\x12\x1f\n" +
+	"\vzone_number\x18\x04 \x01(\x05R\n" +
+	"zoneNumber\x12\"\n" +
+	"\x05price\x18\f \x01(\v2\f.money.MoneyR\x05price\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1e\n" +
+	"\vis_sold_out\x18\t \x01(\bR\tisSoldOut\x12\x1b\n" +
+	"\tzone_type\x18\n" +
+	" \x01(\tR\bzoneType\x12\x1a\n" +
+	"\bcapacity\x18\v \x01(\x05R\bcapacity\x124\n" +
+	"\fticket_types\x18\r \x03(\v2\x11.event.TicketTypeR\vticketTypes\x121\n" +
+	"\vprice_tiers\x18\x0e \x03(\v2\x10.event.PriceTierR\n" +
+	"priceTiersJ\x04\b\x05\x10\x06\"\xa4\x02\n" +
+	"\x16CreateEventZoneRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\"\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x05price\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1b\n" +
+	"\tzone_type\x18\b \x01(\tR\bzoneType\x12\x1a\n" +
+	"\bcapacity\x18\t \x01(\x05R\bcapacityJ\x04\b\x04\x10\x05\")\n" +
+	"\x17CreateEventZoneResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x1cGetEventZoneByEventIdRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"E\n" +
+	"\x1dGetEventZoneByEventIdResponse\x12$\n" +
+	"\x04list\x18\x01 \x03(\v2\x10.event.EventZoneR\x04list\"\xfc\x03\n" +
+	"\x16UpdateEventZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\bevent_id\x18\x02 \x01(\tH\x00R\aeventId\x88\x01\x01\x12$\n" +
+	"\vlocation_id\x18\x03 \x01(\tH\x01R\n" +
+	"locationId\x88\x01\x01\x12$\n" +
+	"\vzone_number\x18\x04 \x01(\x05H\x02R\n" +
+	"zoneNumber\x88\x01\x01\x12\"\n" +
+	"\x05price\x18\f \x01(\v2\f.money.MoneyR\x05price\x12\x19\n" +
+	"\x05color\x18\x06 \x01(\tH\x03R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\a \x01(\tH\x04R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x05R\vdescription\x88\x01\x01\x12#\n" +
+	"\vis_sold_out\x18\t \x01(\bH\x06R\tisSoldOut\x88\x01\x01\x12 \n" +
+	"\tzone_type\x18\n" +
+	" \x01(\tH\aR\bzoneType\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\v \x01(\x05H\bR\bcapacity\x88\x01\x01B\v\n" +
+	"\t_event_idB\x0e\n" +
+	"\f_location_idB\x0e\n" +
+	"\f_zone_numberB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_is_sold_outB\f\n" +
+	"\n" +
+	"_zone_typeB\v\n" +
+	"\t_capacityJ\x04\b\x05\x10\x06\")\n" +
+	"\x17UpdateEventZoneResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x16DeleteEventZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x02\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\revent_zone_id\x18\x02 \x01(\tR\veventZoneId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x06 \x01(\v2\f.money.MoneyR\x05price\x12!\n" +
+	"\fquantity_cap\x18\a \x01(\x05R\vquantityCap\x123\n" +
+	"\x15requires_verification\x18\b \x01(\bR\x14requiresVerification\"\xef\x01\n" +
+	"\x17CreateTicketTypeRequest\x12\"\n" +
+	"\revent_zone_id\x18\x01 \x01(\tR\veventZoneId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12!\n" +
+	"\fquantity_cap\x18\x05 \x01(\x05R\vquantityCap\x123\n" +
+	"\x15requires_verification\x18\x06 \x01(\bR\x14requiresVerification\"*\n" +
+	"\x18CreateTicketTypeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb3\x02\n" +
+	"\x17UpdateTicketTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12&\n" +
+	"\fquantity_cap\x18\x05 \x01(\x05H\x02R\vquantityCap\x88\x01\x01\x128\n" +
+	"\x15requires_verification\x18\x06 \x01(\bH\x03R\x14requiresVerification\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_quantity_capB\x18\n" +
+	"\x16_requires_verification\"*\n" +
+	"\x18UpdateTicketTypeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x17DeleteTicketTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf1\x01\n" +
+	"\tPriceTier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\revent_zone_id\x18\x02 \x01(\tR\veventZoneId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.money.MoneyR\x05price\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\a \x01(\tR\x06endsAt\x12!\n" +
+	"\fsell_through\x18\b \x01(\x05R\vsellThrough\"\xcd\x01\n" +
+	"\x16CreatePriceTierRequest\x12\"\n" +
+	"\revent_zone_id\x18\x01 \x01(\tR\veventZoneId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\x05price\x18\x03 \x01(\v2\f.money.MoneyR\x05price\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12!\n" +
+	"\fsell_through\x18\x06 \x01(\x05R\vsellThrough\")\n" +
+	"\x17CreatePriceTierResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x81\x02\n" +
+	"\x16UpdatePriceTierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\x05price\x18\x03 \x01(\v2\f.money.MoneyR\x05price\x12 \n" +
+	"\tstarts_at\x18\x04 \x01(\tH\x01R\bstartsAt\x88\x01\x01\x12\x1c\n" +
+	"\aends_at\x18\x05 \x01(\tH\x02R\x06endsAt\x88\x01\x01\x12&\n" +
+	"\fsell_through\x18\x06 \x01(\x05H\x03R\vsellThrough\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_starts_atB\n" +
+	"\n" +
+	"\b_ends_atB\x0f\n" +
+	"\r_sell_through\")\n" +
+	"\x17UpdatePriceTierResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x16DeletePriceTierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xea\b\n" +
 	"\fEventService\x12D\n" +
 	"\vCreateEvent\x12\x19.event.CreateEventRequest\x1a\x1a.event.CreateEventResponse\x12;\n" +
 	"\bGetEvent\x12\x16.event.GetEventRequest\x1a\x17.event.GetEventResponse\x12D\n" +
@@ -1536,7 +2883,13 @@ const file_event_event_proto_rawDesc = "" +
 	"\x0fCreateEventZone\x12\x1d.event.CreateEventZoneRequest\x1a\x1e.event.CreateEventZoneResponse\x12b\n" +
 	"\x15GetEventZoneByEventId\x12#.event.GetEventZoneByEventIdRequest\x1a$.event.GetEventZoneByEventIdResponse\x12P\n" +
 	"\x0fUpdateEventZone\x12\x1d.event.UpdateEventZoneRequest\x1a\x1e.event.UpdateEventZoneResponse\x12>\n" +
-	"\x0fDeleteEventZone\x12\x1d.event.DeleteEventZoneRequest\x1a\f.event.EmptyBFZDgithub.com/cp-rektmart/aconcert-microservice/pkg/proto/event;eventpbb\x06proto3"
+	"\x0fDeleteEventZone\x12\x1d.event.DeleteEventZoneRequest\x1a\f.event.Empty\x12S\n" +
+	"\x10CreateTicketType\x12\x1e.event.CreateTicketTypeRequest\x1a\x1f.event.CreateTicketTypeResponse\x12S\n" +
+	"\x10UpdateTicketType\x12\x1e.event.UpdateTicketTypeRequest\x1a\x1f.event.UpdateTicketTypeResponse\x12@\n" +
+	"\x10DeleteTicketType\x12\x1e.event.DeleteTicketTypeRequest\x1a\f.event.Empty\x12P\n" +
+	"\x0fCreatePriceTier\x12\x1d.event.CreatePriceTierRequest\x1a\x1e.event.CreatePriceTierResponse\x12P\n" +
+	"\x0fUpdatePriceTier\x12\x1d.event.UpdatePriceTierRequest\x1a\x1e.event.UpdatePriceTierResponse\x12>\n" +
+	"\x0fDeletePriceTier\x12\x1d.event.DeletePriceTierRequest\x1a\f.event.EmptyBFZDgithub.com/cp-rektmart/aconcert-microservice/pkg/proto/event;eventpbb\x06proto3"
 
 var (
 	file_event_event_proto_rawDescOnce sync.Once
@@ -1550,58 +2903,98 @@ func file_event_event_proto_rawDescGZIP() []byte {
 	return file_event_event_proto_rawDescData
 }
 
-var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_event_event_proto_goTypes = []any{
 	(*Event)(nil),                         // 0: event.Event
-	(*CreateEventRequest)(nil),            // 1: event.CreateEventRequest
-	(*CreateEventResponse)(nil),           // 2: event.CreateEventResponse
-	(*GetEventRequest)(nil),               // 3: event.GetEventRequest
-	(*GetEventResponse)(nil),              // 4: event.GetEventResponse
-	(*UpdateEventRequest)(nil),            // 5: event.UpdateEventRequest
-	(*UpdateEventResponse)(nil),           // 6: event.UpdateEventResponse
-	(*DeleteEventRequest)(nil),            // 7: event.DeleteEventRequest
-	(*DeleteEventResponse)(nil),           // 8: event.DeleteEventResponse
-	(*Pagination)(nil),                    // 9: event.Pagination
-	(*ListEventsRequest)(nil),             // 10: event.ListEventsRequest
-	(*ListEventsResponse)(nil),            // 11: event.ListEventsResponse
-	(*Empty)(nil),                         // 12: event.Empty
-	(*EventZone)(nil),                     // 13: event.EventZone
-	(*CreateEventZoneRequest)(nil),        // 14: event.CreateEventZoneRequest
-	(*CreateEventZoneResponse)(nil),       // 15: event.CreateEventZoneResponse
-	(*GetEventZoneByEventIdRequest)(nil),  // 16: event.GetEventZoneByEventIdRequest
-	(*GetEventZoneByEventIdResponse)(nil), // 17: event.GetEventZoneByEventIdResponse
-	(*UpdateEventZoneRequest)(nil),        // 18: event.UpdateEventZoneRequest
-	(*UpdateEventZoneResponse)(nil),       // 19: event.UpdateEventZoneResponse
-	(*DeleteEventZoneRequest)(nil),        // 20: event.DeleteEventZoneRequest
+	(*EventFees)(nil),                     // 1: event.EventFees
+	(*CreateEventRequest)(nil),            // 2: event.CreateEventRequest
+	(*CreateEventResponse)(nil),           // 3: event.CreateEventResponse
+	(*GetEventRequest)(nil),               // 4: event.GetEventRequest
+	(*GetEventResponse)(nil),              // 5: event.GetEventResponse
+	(*UpdateEventRequest)(nil),            // 6: event.UpdateEventRequest
+	(*UpdateEventResponse)(nil),           // 7: event.UpdateEventResponse
+	(*DeleteEventRequest)(nil),            // 8: event.DeleteEventRequest
+	(*DeleteEventResponse)(nil),           // 9: event.DeleteEventResponse
+	(*Pagination)(nil),                    // 10: event.Pagination
+	(*ListEventsRequest)(nil),             // 11: event.ListEventsRequest
+	(*ListEventsResponse)(nil),            // 12: event.ListEventsResponse
+	(*Empty)(nil),                         // 13: event.Empty
+	(*EventZone)(nil),                     // 14: event.EventZone
+	(*CreateEventZoneRequest)(nil),        // 15: event.CreateEventZoneRequest
+	(*CreateEventZoneResponse)(nil),       // 16: event.CreateEventZoneResponse
+	(*GetEventZoneByEventIdRequest)(nil),  // 17: event.GetEventZoneByEventIdRequest
+	(*GetEventZoneByEventIdResponse)(nil), // 18: event.GetEventZoneByEventIdResponse
+	(*UpdateEventZoneRequest)(nil),        // 19: event.UpdateEventZoneRequest
+	(*UpdateEventZoneResponse)(nil),       // 20: event.UpdateEventZoneResponse
+	(*DeleteEventZoneRequest)(nil),        // 21: event.DeleteEventZoneRequest
+	(*TicketType)(nil),                    // 22: event.TicketType
+	(*CreateTicketTypeRequest)(nil),       // 23: event.CreateTicketTypeRequest
+	(*CreateTicketTypeResponse)(nil),      // 24: event.CreateTicketTypeResponse
+	(*UpdateTicketTypeRequest)(nil),       // 25: event.UpdateTicketTypeRequest
+	(*UpdateTicketTypeResponse)(nil),      // 26: event.UpdateTicketTypeResponse
+	(*DeleteTicketTypeRequest)(nil),       // 27: event.DeleteTicketTypeRequest
+	(*PriceTier)(nil),                     // 28: event.PriceTier
+	(*CreatePriceTierRequest)(nil),        // 29: event.CreatePriceTierRequest
+	(*CreatePriceTierResponse)(nil),       // 30: event.CreatePriceTierResponse
+	(*UpdatePriceTierRequest)(nil),        // 31: event.UpdatePriceTierRequest
+	(*UpdatePriceTierResponse)(nil),       // 32: event.UpdatePriceTierResponse
+	(*DeletePriceTierRequest)(nil),        // 33: event.DeletePriceTierRequest
+	(*money.Money)(nil),                   // 34: money.Money
 }
 var file_event_event_proto_depIdxs = []int32{
-	0,  // 0: event.GetEventResponse.event:type_name -> event.Event
-	0,  // 1: event.ListEventsResponse.events:type_name -> event.Event
-	9,  // 2: event.ListEventsResponse.pagination:type_name -> event.Pagination
-	13, // 3: event.GetEventZoneByEventIdResponse.list:type_name -> event.EventZone
-	1,  // 4: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
-	3,  // 5: event.EventService.GetEvent:input_type -> event.GetEventRequest
-	5,  // 6: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
-	7,  // 7: event.EventService.DeleteEvent:input_type -> event.DeleteEventRequest
-	10, // 8: event.EventService.ListEvents:input_type -> event.ListEventsRequest
-	14, // 9: event.EventService.CreateEventZone:input_type -> event.CreateEventZoneRequest
-	16, // 10: event.EventService.GetEventZoneByEventId:input_type -> event.GetEventZoneByEventIdRequest
-	18, // 11: event.EventService.UpdateEventZone:input_type -> event.UpdateEventZoneRequest
-	20, // 12: event.EventService.DeleteEventZone:input_type -> event.DeleteEventZoneRequest
-	2,  // 13: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
-	4,  // 14: event.EventService.GetEvent:output_type -> event.GetEventResponse
-	6,  // 15: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
-	12, // 16: event.EventService.DeleteEvent:output_type -> event.Empty
-	11, // 17: event.EventService.ListEvents:output_type -> event.ListEventsResponse
-	15, // 18: event.EventService.CreateEventZone:output_type -> event.CreateEventZoneResponse
-	17, // 19: event.EventService.GetEventZoneByEventId:output_type -> event.GetEventZoneByEventIdResponse
-	19, // 20: event.EventService.UpdateEventZone:output_type -> event.UpdateEventZoneResponse
-	12, // 21: event.EventService.DeleteEventZone:output_type -> event.Empty
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 0: event.Event.fees:type_name -> event.EventFees
+	1,  // 1: event.CreateEventRequest.fees:type_name -> event.EventFees
+	0,  // 2: event.GetEventResponse.event:type_name -> event.Event
+	1,  // 3: event.UpdateEventRequest.fees:type_name -> event.EventFees
+	0,  // 4: event.ListEventsResponse.events:type_name -> event.Event
+	10, // 5: event.ListEventsResponse.pagination:type_name -> event.Pagination
+	34, // 6: event.EventZone.price:type_name -> money.Money
+	22, // 7: event.EventZone.ticket_types:type_name -> event.TicketType
+	28, // 8: event.EventZone.price_tiers:type_name -> event.PriceTier
+	34, // 9: event.CreateEventZoneRequest.price:type_name -> money.Money
+	14, // 10: event.GetEventZoneByEventIdResponse.list:type_name -> event.EventZone
+	34, // 11: event.UpdateEventZoneRequest.price:type_name -> money.Money
+	34, // 12: event.TicketType.price:type_name -> money.Money
+	34, // 13: event.CreateTicketTypeRequest.price:type_name -> money.Money
+	34, // 14: event.UpdateTicketTypeRequest.price:type_name -> money.Money
+	34, // 15: event.PriceTier.price:type_name -> money.Money
+	34, // 16: event.CreatePriceTierRequest.price:type_name -> money.Money
+	34, // 17: event.UpdatePriceTierRequest.price:type_name -> money.Money
+	2,  // 18: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
+	4,  // 19: event.EventService.GetEvent:input_type -> event.GetEventRequest
+	6,  // 20: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
+	8,  // 21: event.EventService.DeleteEvent:input_type -> event.DeleteEventRequest
+	11, // 22: event.EventService.ListEvents:input_type -> event.ListEventsRequest
+	15, // 23: event.EventService.CreateEventZone:input_type -> event.CreateEventZoneRequest
+	17, // 24: event.EventService.GetEventZoneByEventId:input_type -> event.GetEventZoneByEventIdRequest
+	19, // 25: event.EventService.UpdateEventZone:input_type -> event.UpdateEventZoneRequest
+	21, // 26: event.EventService.DeleteEventZone:input_type -> event.DeleteEventZoneRequest
+	23, // 27: event.EventService.CreateTicketType:input_type -> event.CreateTicketTypeRequest
+	25, // 28: event.EventService.UpdateTicketType:input_type -> event.UpdateTicketTypeRequest
+	27, // 29: event.EventService.DeleteTicketType:input_type -> event.DeleteTicketTypeRequest
+	29, // 30: event.EventService.CreatePriceTier:input_type -> event.CreatePriceTierRequest
+	31, // 31: event.EventService.UpdatePriceTier:input_type -> event.UpdatePriceTierRequest
+	33, // 32: event.EventService.DeletePriceTier:input_type -> event.DeletePriceTierRequest
+	3,  // 33: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
+	5,  // 34: event.EventService.GetEvent:output_type -> event.GetEventResponse
+	7,  // 35: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
+	13, // 36: event.EventService.DeleteEvent:output_type -> event.Empty
+	12, // 37: event.EventService.ListEvents:output_type -> event.ListEventsResponse
+	16, // 38: event.EventService.CreateEventZone:output_type -> event.CreateEventZoneResponse
+	18, // 39: event.EventService.GetEventZoneByEventId:output_type -> event.GetEventZoneByEventIdResponse
+	20, // 40: event.EventService.UpdateEventZone:output_type -> event.UpdateEventZoneResponse
+	13, // 41: event.EventService.DeleteEventZone:output_type -> event.Empty
+	24, // 42: event.EventService.CreateTicketType:output_type -> event.CreateTicketTypeResponse
+	26, // 43: event.EventService.UpdateTicketType:output_type -> event.UpdateTicketTypeResponse
+	13, // 44: event.EventService.DeleteTicketType:output_type -> event.Empty
+	30, // 45: event.EventService.CreatePriceTier:output_type -> event.CreatePriceTierResponse
+	32, // 46: event.EventService.UpdatePriceTier:output_type -> event.UpdatePriceTierResponse
+	13, // 47: event.EventService.DeletePriceTier:output_type -> event.Empty
+	33, // [33:48] is the sub-list for method output_type
+	18, // [18:33] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_event_event_proto_init() }
@@ -1609,16 +3002,20 @@ func file_event_event_proto_init() {
 	if File_event_event_proto != nil {
 		return
 	}
-	file_event_event_proto_msgTypes[5].OneofWrappers = []any{}
-	file_event_event_proto_msgTypes[10].OneofWrappers = []any{}
-	file_event_event_proto_msgTypes[18].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[1].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[2].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[6].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[11].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[19].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[25].OneofWrappers = []any{}
+	file_event_event_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_event_proto_rawDesc), len(file_event_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,12 @@ const (
 	EventService_GetEventZoneByEventId_FullMethodName = "/event.EventService/GetEventZoneByEventId"
 	EventService_UpdateEventZone_FullMethodName       = "/event.EventService/UpdateEventZone"
 	EventService_DeleteEventZone_FullMethodName       = "/event.EventService/DeleteEventZone"
+	EventService_CreateTicketType_FullMethodName      = "/event.EventService/CreateTicketType"
+	EventService_UpdateTicketType_FullMethodName      = "/event.EventService/UpdateTicketType"
+	EventService_DeleteTicketType_FullMethodName      = "/event.EventService/DeleteTicketType"
+	EventService_CreatePriceTier_FullMethodName       = "/event.EventService/CreatePriceTier"
+	EventService_UpdatePriceTier_FullMethodName       = "/event.EventService/UpdatePriceTier"
+	EventService_DeletePriceTier_FullMethodName       = "/event.EventService/DeletePriceTier"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -45,6 +51,12 @@ type EventServiceClient interface {
 	GetEventZoneByEventId(ctx context.Context, in *GetEventZoneByEventIdRequest, opts ...grpc.CallOption) (*GetEventZoneByEventIdResponse, error)
 	UpdateEventZone(ctx context.Context, in *UpdateEventZoneRequest, opts ...grpc.CallOption) (*UpdateEventZoneResponse, error)
 	DeleteEventZone(ctx context.Context, in *DeleteEventZoneRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error)
+	UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreatePriceTier(ctx context.Context, in *CreatePriceTierRequest, opts ...grpc.CallOption) (*CreatePriceTierResponse, error)
+	UpdatePriceTier(ctx context.Context, in *UpdatePriceTierRequest, opts ...grpc.CallOption) (*UpdatePriceTierResponse, error)
+	DeletePriceTier(ctx context.Context, in *DeletePriceTierRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type eventServiceClient struct {
@@ -145,6 +157,66 @@ func (c *eventServiceClient) DeleteEventZone(ctx context.Context, in *DeleteEven
 	return out, nil
 }
 
+func (c *eventServiceClient) CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreatePriceTier(ctx context.Context, in *CreatePriceTierRequest, opts ...grpc.CallOption) (*CreatePriceTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePriceTierResponse)
+	err := c.cc.Invoke(ctx, EventService_CreatePriceTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdatePriceTier(ctx context.Context, in *UpdatePriceTierRequest, opts ...grpc.CallOption) (*UpdatePriceTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePriceTierResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdatePriceTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeletePriceTier(ctx context.Context, in *DeletePriceTierRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EventService_DeletePriceTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -160,6 +232,12 @@ type EventServiceServer interface {
 	GetEventZoneByEventId(context.Context, *GetEventZoneByEventIdRequest) (*GetEventZoneByEventIdResponse, error)
 	UpdateEventZone(context.Context, *UpdateEventZoneRequest) (*UpdateEventZoneResponse, error)
 	DeleteEventZone(context.Context, *DeleteEventZoneRequest) (*Empty, error)
+	CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error)
+	UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(context.Context, *DeleteTicketTypeRequest) (*Empty, error)
+	CreatePriceTier(context.Context, *CreatePriceTierRequest) (*CreatePriceTierResponse, error)
+	UpdatePriceTier(context.Context, *UpdatePriceTierRequest) (*UpdatePriceTierResponse, error)
+	DeletePriceTier(context.Context, *DeletePriceTierRequest) (*Empty, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -197,6 +275,24 @@ func (UnimplementedEventServiceServer) UpdateEventZone(context.Context, *UpdateE
 func (UnimplementedEventServiceServer) DeleteEventZone(context.Context, *DeleteEventZoneRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventZone not implemented")
 }
+func (UnimplementedEventServiceServer) CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteTicketType(context.Context, *DeleteTicketTypeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) CreatePriceTier(context.Context, *CreatePriceTierRequest) (*CreatePriceTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceTier not implemented")
+}
+func (UnimplementedEventServiceServer) UpdatePriceTier(context.Context, *UpdatePriceTierRequest) (*UpdatePriceTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceTier not implemented")
+}
+func (UnimplementedEventServiceServer) DeletePriceTier(context.Context, *DeletePriceTierRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceTier not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -380,6 +476,114 @@ func _EventService_DeleteEventZone_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_CreateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateTicketType(ctx, req.(*CreateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, req.(*UpdateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteTicketType(ctx, req.(*DeleteTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreatePriceTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreatePriceTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreatePriceTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreatePriceTier(ctx, req.(*CreatePriceTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdatePriceTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdatePriceTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdatePriceTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdatePriceTier(ctx, req.(*UpdatePriceTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeletePriceTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeletePriceTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeletePriceTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeletePriceTier(ctx, req.(*DeletePriceTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,6 +627,30 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEventZone",
 			Handler:    _EventService_DeleteEventZone_Handler,
 		},
+		{
+			MethodName: "CreateTicketType",
+			Handler:    _EventService_CreateTicketType_Handler,
+		},
+		{
+			MethodName: "UpdateTicketType",
+			Handler:    _EventService_UpdateTicketType_Handler,
+		},
+		{
+			MethodName: "DeleteTicketType",
+			Handler:    _EventService_DeleteTicketType_Handler,
+		},
+		{
+			MethodName: "CreatePriceTier",
+			Handler:    _EventService_CreatePriceTier_Handler,
+		},
+		{
+			MethodName: "UpdatePriceTier",
+			Handler:    _EventService_UpdatePriceTier_Handler,
+		},
+		{
+			MethodName: "DeletePriceTier",
+			Handler:    _EventService_DeletePriceTier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event/event.proto",
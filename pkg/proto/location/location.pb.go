@@ -123,12 +123,14 @@ func (x *Location) GetZones() []*Zone {
 }
 
 type Zone struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	ZoneName      string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	SeatsPerRow   int32                  `protobuf:"varint,4,opt,name=seatsPerRow,proto3" json:"seatsPerRow,omitempty"`
-	NumberOfRows  int32                  `protobuf:"varint,5,opt,name=numberOfRows,proto3" json:"numberOfRows,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber   int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	ZoneName     string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Capacity     int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	SeatsPerRow  int32                  `protobuf:"varint,4,opt,name=seatsPerRow,proto3" json:"seatsPerRow,omitempty"`
+	NumberOfRows int32                  `protobuf:"varint,5,opt,name=numberOfRows,proto3" json:"numberOfRows,omitempty"`
+	// SEATED (default) or GENERAL_ADMISSION, general admission zones only have a capacity
+	ZoneType      string `protobuf:"bytes,6,opt,name=zone_type,json=zoneType,proto3" json:"zone_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,6 +200,13 @@ func (x *Zone) GetNumberOfRows() int32 {
 	return 0
 }
 
+func (x *Zone) GetZoneType() string {
+	if x != nil {
+		return x.ZoneType
+	}
+	return ""
+}
+
 type LocationIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -674,14 +683,15 @@ const file_location_location_proto_rawDesc = "" +
 	"\acountry\x18\x05 \x01(\tR\acountry\x12\x1a\n" +
 	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12$\n" +
-	"\x05zones\x18\b \x03(\v2\x0e.location.ZoneR\x05zones\"\xa6\x01\n" +
+	"\x05zones\x18\b \x03(\v2\x0e.location.ZoneR\x05zones\"\xc3\x01\n" +
 	"\x04Zone\x12\x1f\n" +
 	"\vzone_number\x18\x01 \x01(\x05R\n" +
 	"zoneNumber\x12\x1b\n" +
 	"\tzone_name\x18\x02 \x01(\tR\bzoneName\x12\x1a\n" +
 	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12 \n" +
 	"\vseatsPerRow\x18\x04 \x01(\x05R\vseatsPerRow\x12\"\n" +
-	"\fnumberOfRows\x18\x05 \x01(\x05R\fnumberOfRows\"$\n" +
+	"\fnumberOfRows\x18\x05 \x01(\x05R\fnumberOfRows\x12\x1b\n" +
+	"\tzone_type\x18\x06 \x01(\tR\bzoneType\"$\n" +
 	"\x12LocationIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
 	"\x12GetLocationRequest\x12\x0e\n" +
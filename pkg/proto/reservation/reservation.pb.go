@@ -7,6 +7,7 @@
 package reservationpb
 
 import (
+	money "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -59,11 +60,15 @@ func (*Empty) Descriptor() ([]byte, []int) {
 }
 
 type Seat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Row           int32                  `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
-	Column        int32                  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Price      *money.Money           `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	Row        int32                  `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
+	Column     int32                  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	// set once the seat is sold
+	TicketId string `protobuf:"bytes,6,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// empty when the seat is sold at the zone price
+	TicketTypeId  string `protobuf:"bytes,8,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,11 +110,11 @@ func (x *Seat) GetZoneNumber() int32 {
 	return 0
 }
 
-func (x *Seat) GetPrice() float64 {
+func (x *Seat) GetPrice() *money.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *Seat) GetRow() int32 {
@@ -126,23 +131,134 @@ func (x *Seat) GetColumn() int32 {
 	return 0
 }
 
+func (x *Seat) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Seat) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+// one change of a ticket, ISSUED when it is sold, TRANSFERRED when it changes owner, CHECKED_IN when it is scanned
+// at the door and CANCELLED or REFUNDED when it is given back
+type TicketHistory struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TicketId       string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	ZoneNumber     int32                  `protobuf:"varint,2,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row            int32                  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Column         int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	Action         string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	RefundedAmount *money.Money           `protobuf:"bytes,8,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TicketHistory) Reset() {
+	*x = TicketHistory{}
+	mi := &file_reservation_reservation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketHistory) ProtoMessage() {}
+
+func (x *TicketHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketHistory.ProtoReflect.Descriptor instead.
+func (*TicketHistory) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketHistory) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketHistory) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *TicketHistory) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TicketHistory) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *TicketHistory) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TicketHistory) GetRefundedAmount() *money.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *TicketHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 type Reservation struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	EventId            string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	TotalPrice         float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalPrice         *money.Money           `protobuf:"bytes,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	Seats              []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
 	StripeClientSecret string                 `protobuf:"bytes,6,opt,name=stripe_client_secret,json=stripeClientSecret,proto3" json:"stripe_client_secret,omitempty"`
 	TimeLeft           *float64               `protobuf:"fixed64,7,opt,name=time_left,json=timeLeft,proto3,oneof" json:"time_left,omitempty"`
 	Status             string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// ISO 4217 code of the event the reservation is paid in
+	Currency      string          `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Breakdown     *PriceBreakdown `protobuf:"bytes,11,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Reservation) Reset() {
 	*x = Reservation{}
-	mi := &file_reservation_reservation_proto_msgTypes[2]
+	mi := &file_reservation_reservation_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +270,7 @@ func (x *Reservation) String() string {
 func (*Reservation) ProtoMessage() {}
 
 func (x *Reservation) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[2]
+	mi := &file_reservation_reservation_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +283,7 @@ func (x *Reservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
 func (*Reservation) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{2}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Reservation) GetId() string {
@@ -191,11 +307,11 @@ func (x *Reservation) GetEventId() string {
 	return ""
 }
 
-func (x *Reservation) GetTotalPrice() float64 {
+func (x *Reservation) GetTotalPrice() *money.Money {
 	if x != nil {
 		return x.TotalPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *Reservation) GetSeats() []*Seat {
@@ -226,18 +342,34 @@ func (x *Reservation) GetStatus() string {
 	return ""
 }
 
+func (x *Reservation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Reservation) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
 type CreateReservationSeatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
-	Column        int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row        int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Column     int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	// a ticket type of the zone, the seat costs the zone price when it is empty
+	TicketTypeId  string `protobuf:"bytes,4,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateReservationSeatRequest) Reset() {
 	*x = CreateReservationSeatRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[3]
+	mi := &file_reservation_reservation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +381,7 @@ func (x *CreateReservationSeatRequest) String() string {
 func (*CreateReservationSeatRequest) ProtoMessage() {}
 
 func (x *CreateReservationSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[3]
+	mi := &file_reservation_reservation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +394,7 @@ func (x *CreateReservationSeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationSeatRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationSeatRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{3}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateReservationSeatRequest) GetZoneNumber() int32 {
@@ -286,18 +418,30 @@ func (x *CreateReservationSeatRequest) GetColumn() int32 {
 	return 0
 }
 
+func (x *CreateReservationSeatRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
 type CreateReservationRequest struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	UserId        string                          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       string                          `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Seats         []*CreateReservationSeatRequest `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty"`
+	state   protoimpl.MessageState          `protogen:"open.v1"`
+	UserId  string                          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId string                          `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Seats   []*CreateReservationSeatRequest `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty"`
+	// retries with the same key get the first response instead of a second hold
+	IdempotencyKey   *string                    `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	GeneralAdmission []*GeneralAdmissionRequest `protobuf:"bytes,6,rep,name=general_admission,json=generalAdmission,proto3" json:"general_admission,omitempty"`
+	// at most 3, codes that are not stackable must be used alone
+	PromoCodes    []string `protobuf:"bytes,7,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateReservationRequest) Reset() {
 	*x = CreateReservationRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[4]
+	mi := &file_reservation_reservation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +453,7 @@ func (x *CreateReservationRequest) String() string {
 func (*CreateReservationRequest) ProtoMessage() {}
 
 func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[4]
+	mi := &file_reservation_reservation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +466,7 @@ func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{4}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateReservationRequest) GetUserId() string {
@@ -346,6 +490,89 @@ func (x *CreateReservationRequest) GetSeats() []*CreateReservationSeatRequest {
 	return nil
 }
 
+func (x *CreateReservationRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetGeneralAdmission() []*GeneralAdmissionRequest {
+	if x != nil {
+		return x.GeneralAdmission
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+// GeneralAdmissionRequest asks for unnumbered tickets in a standing zone.
+type GeneralAdmissionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity   int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// a ticket type of the zone, a zone can be requested once per ticket type
+	TicketTypeId  string `protobuf:"bytes,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneralAdmissionRequest) Reset() {
+	*x = GeneralAdmissionRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneralAdmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralAdmissionRequest) ProtoMessage() {}
+
+func (x *GeneralAdmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneralAdmissionRequest.ProtoReflect.Descriptor instead.
+func (*GeneralAdmissionRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GeneralAdmissionRequest) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *GeneralAdmissionRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GeneralAdmissionRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
 type DeleteReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -355,7 +582,7 @@ type DeleteReservationRequest struct {
 
 func (x *DeleteReservationRequest) Reset() {
 	*x = DeleteReservationRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[5]
+	mi := &file_reservation_reservation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +594,7 @@ func (x *DeleteReservationRequest) String() string {
 func (*DeleteReservationRequest) ProtoMessage() {}
 
 func (x *DeleteReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[5]
+	mi := &file_reservation_reservation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +607,7 @@ func (x *DeleteReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReservationRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{5}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteReservationRequest) GetId() string {
@@ -399,7 +626,7 @@ type ListReservationRequest struct {
 
 func (x *ListReservationRequest) Reset() {
 	*x = ListReservationRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[6]
+	mi := &file_reservation_reservation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +638,7 @@ func (x *ListReservationRequest) String() string {
 func (*ListReservationRequest) ProtoMessage() {}
 
 func (x *ListReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[6]
+	mi := &file_reservation_reservation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +651,7 @@ func (x *ListReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReservationRequest.ProtoReflect.Descriptor instead.
 func (*ListReservationRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{6}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListReservationRequest) GetUserId() string {
@@ -443,7 +670,7 @@ type GetReservationRequest struct {
 
 func (x *GetReservationRequest) Reset() {
 	*x = GetReservationRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[7]
+	mi := &file_reservation_reservation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +682,7 @@ func (x *GetReservationRequest) String() string {
 func (*GetReservationRequest) ProtoMessage() {}
 
 func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[7]
+	mi := &file_reservation_reservation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +695,7 @@ func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
 func (*GetReservationRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{7}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetReservationRequest) GetId() string {
@@ -487,7 +714,7 @@ type GetReservationByStripeSessionIDRequest struct {
 
 func (x *GetReservationByStripeSessionIDRequest) Reset() {
 	*x = GetReservationByStripeSessionIDRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[8]
+	mi := &file_reservation_reservation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +726,7 @@ func (x *GetReservationByStripeSessionIDRequest) String() string {
 func (*GetReservationByStripeSessionIDRequest) ProtoMessage() {}
 
 func (x *GetReservationByStripeSessionIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[8]
+	mi := &file_reservation_reservation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +739,7 @@ func (x *GetReservationByStripeSessionIDRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetReservationByStripeSessionIDRequest.ProtoReflect.Descriptor instead.
 func (*GetReservationByStripeSessionIDRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{8}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetReservationByStripeSessionIDRequest) GetSessionId() string {
@@ -531,7 +758,7 @@ type ConfirmReservationRequest struct {
 
 func (x *ConfirmReservationRequest) Reset() {
 	*x = ConfirmReservationRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[9]
+	mi := &file_reservation_reservation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +770,7 @@ func (x *ConfirmReservationRequest) String() string {
 func (*ConfirmReservationRequest) ProtoMessage() {}
 
 func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[9]
+	mi := &file_reservation_reservation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +783,7 @@ func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
 func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{9}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ConfirmReservationRequest) GetId() string {
@@ -566,28 +793,34 @@ func (x *ConfirmReservationRequest) GetId() string {
 	return ""
 }
 
-type CreateReservationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ReserveBestAvailableRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId    string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity   int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// all seats side by side in one row
+	Contiguous    bool     `protobuf:"varint,5,opt,name=contiguous,proto3" json:"contiguous,omitempty"`
+	PromoCodes    []string `protobuf:"bytes,6,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReservationResponse) Reset() {
-	*x = CreateReservationResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[10]
+func (x *ReserveBestAvailableRequest) Reset() {
+	*x = ReserveBestAvailableRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReservationResponse) String() string {
+func (x *ReserveBestAvailableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReservationResponse) ProtoMessage() {}
+func (*ReserveBestAvailableRequest) ProtoMessage() {}
 
-func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[10]
+func (x *ReserveBestAvailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,43 +831,174 @@ func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
-func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReserveBestAvailableRequest.ProtoReflect.Descriptor instead.
+func (*ReserveBestAvailableRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateReservationResponse) GetId() string {
+func (x *ReserveBestAvailableRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteReservationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReserveBestAvailableRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
 }
 
-func (x *DeleteReservationResponse) Reset() {
-	*x = DeleteReservationResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReserveBestAvailableRequest) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
 }
 
-func (x *DeleteReservationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReserveBestAvailableRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (*DeleteReservationResponse) ProtoMessage() {}
-
-func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[11]
+func (x *ReserveBestAvailableRequest) GetContiguous() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
+		return x.Contiguous
+	}
+	return false
+}
+
+func (x *ReserveBestAvailableRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateReservationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReserveBestAvailableResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Id            string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seats         []*CreateReservationSeatRequest `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveBestAvailableResponse) Reset() {
+	*x = ReserveBestAvailableResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveBestAvailableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveBestAvailableResponse) ProtoMessage() {}
+
+func (x *ReserveBestAvailableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveBestAvailableResponse.ProtoReflect.Descriptor instead.
+func (*ReserveBestAvailableResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReserveBestAvailableResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReserveBestAvailableResponse) GetSeats() []*CreateReservationSeatRequest {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type DeleteReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReservationResponse) Reset() {
+	*x = DeleteReservationResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReservationResponse) ProtoMessage() {}
+
+func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
 		return ms
@@ -644,7 +1008,7 @@ func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReservationResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{11}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteReservationResponse) GetId() string {
@@ -663,7 +1027,7 @@ type ListReservationResponse struct {
 
 func (x *ListReservationResponse) Reset() {
 	*x = ListReservationResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[12]
+	mi := &file_reservation_reservation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +1039,7 @@ func (x *ListReservationResponse) String() string {
 func (*ListReservationResponse) ProtoMessage() {}
 
 func (x *ListReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[12]
+	mi := &file_reservation_reservation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +1052,7 @@ func (x *ListReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReservationResponse.ProtoReflect.Descriptor instead.
 func (*ListReservationResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{12}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListReservationResponse) GetReservation() []*Reservation {
@@ -703,18 +1067,26 @@ type GetReservationResponse struct {
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	EventId            string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	TotalPrice         float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalPrice         *money.Money           `protobuf:"bytes,11,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	Seats              []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
 	StripeClientSecret string                 `protobuf:"bytes,6,opt,name=stripe_client_secret,json=stripeClientSecret,proto3" json:"stripe_client_secret,omitempty"`
 	TimeLeft           *float64               `protobuf:"fixed64,7,opt,name=time_left,json=timeLeft,proto3,oneof" json:"time_left,omitempty"`
 	Status             string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	History            []*TicketHistory       `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+	// total_price is after these discounts
+	Promotions []*AppliedPromotion `protobuf:"bytes,10,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	// ISO 4217 code of the event the reservation is paid in
+	Currency string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	// what the buyer was charged for, the lines add up to total_price
+	LineItems     []*LineItem     `protobuf:"bytes,13,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	Breakdown     *PriceBreakdown `protobuf:"bytes,14,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReservationResponse) Reset() {
 	*x = GetReservationResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[13]
+	mi := &file_reservation_reservation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +1098,7 @@ func (x *GetReservationResponse) String() string {
 func (*GetReservationResponse) ProtoMessage() {}
 
 func (x *GetReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[13]
+	mi := &file_reservation_reservation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +1111,7 @@ func (x *GetReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReservationResponse.ProtoReflect.Descriptor instead.
 func (*GetReservationResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{13}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetReservationResponse) GetId() string {
@@ -763,11 +1135,11 @@ func (x *GetReservationResponse) GetEventId() string {
 	return ""
 }
 
-func (x *GetReservationResponse) GetTotalPrice() float64 {
+func (x *GetReservationResponse) GetTotalPrice() *money.Money {
 	if x != nil {
 		return x.TotalPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *GetReservationResponse) GetSeats() []*Seat {
@@ -798,18 +1170,55 @@ func (x *GetReservationResponse) GetStatus() string {
 	return ""
 }
 
+func (x *GetReservationResponse) GetHistory() []*TicketHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetReservationResponse) GetPromotions() []*AppliedPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *GetReservationResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetReservationResponse) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *GetReservationResponse) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
 type ConfirmReservationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// set when the reservation could not be confirmed and the payment was refunded
+	Refunded      bool `protobuf:"varint,4,opt,name=refunded,proto3" json:"refunded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConfirmReservationResponse) Reset() {
 	*x = ConfirmReservationResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[14]
+	mi := &file_reservation_reservation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +1230,7 @@ func (x *ConfirmReservationResponse) String() string {
 func (*ConfirmReservationResponse) ProtoMessage() {}
 
 func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[14]
+	mi := &file_reservation_reservation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1243,7 @@ func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmReservationResponse.ProtoReflect.Descriptor instead.
 func (*ConfirmReservationResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{14}
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ConfirmReservationResponse) GetId() string {
@@ -858,33 +1267,38 @@ func (x *ConfirmReservationResponse) GetMessage() string {
 	return ""
 }
 
-type GetReservationByStripeSessionIDResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	Seats         []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+func (x *ConfirmReservationResponse) GetRefunded() bool {
+	if x != nil {
+		return x.Refunded
+	}
+	return false
+}
+
+type ExtendReservationRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// capped by the event maximum, the maximum is used when unset
+	Seconds       *int32 `protobuf:"varint,3,opt,name=seconds,proto3,oneof" json:"seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReservationByStripeSessionIDResponse) Reset() {
-	*x = GetReservationByStripeSessionIDResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[15]
+func (x *ExtendReservationRequest) Reset() {
+	*x = ExtendReservationRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReservationByStripeSessionIDResponse) String() string {
+func (x *ExtendReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReservationByStripeSessionIDResponse) ProtoMessage() {}
+func (*ExtendReservationRequest) ProtoMessage() {}
 
-func (x *GetReservationByStripeSessionIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[15]
+func (x *ExtendReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,75 +1309,116 @@ func (x *GetReservationByStripeSessionIDResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReservationByStripeSessionIDResponse.ProtoReflect.Descriptor instead.
-func (*GetReservationByStripeSessionIDResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ExtendReservationRequest.ProtoReflect.Descriptor instead.
+func (*ExtendReservationRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetId() string {
+func (x *ExtendReservationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetUserId() string {
+func (x *ExtendReservationRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetEventId() string {
+func (x *ExtendReservationRequest) GetSeconds() int32 {
+	if x != nil && x.Seconds != nil {
+		return *x.Seconds
+	}
+	return 0
+}
+
+type ExtendReservationResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeLeft float64                `protobuf:"fixed64,2,opt,name=time_left,json=timeLeft,proto3" json:"time_left,omitempty"`
+	// the extended hold is paid on a new checkout session, the old one is expired
+	StripeClientSecret string `protobuf:"bytes,3,opt,name=stripe_client_secret,json=stripeClientSecret,proto3" json:"stripe_client_secret,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExtendReservationResponse) Reset() {
+	*x = ExtendReservationResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendReservationResponse) ProtoMessage() {}
+
+func (x *ExtendReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[20]
 	if x != nil {
-		return x.EventId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetTotalPrice() float64 {
+// Deprecated: Use ExtendReservationResponse.ProtoReflect.Descriptor instead.
+func (*ExtendReservationResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExtendReservationResponse) GetId() string {
 	if x != nil {
-		return x.TotalPrice
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetSeats() []*Seat {
+func (x *ExtendReservationResponse) GetTimeLeft() float64 {
 	if x != nil {
-		return x.Seats
+		return x.TimeLeft
 	}
-	return nil
+	return 0
 }
 
-func (x *GetReservationByStripeSessionIDResponse) GetStatus() string {
+func (x *ExtendReservationResponse) GetStripeClientSecret() string {
 	if x != nil {
-		return x.Status
+		return x.StripeClientSecret
 	}
 	return ""
 }
 
-type GetEventSeatsRequest struct {
+type RefundReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventSeatsRequest) Reset() {
-	*x = GetEventSeatsRequest{}
-	mi := &file_reservation_reservation_proto_msgTypes[16]
+func (x *RefundReservationRequest) Reset() {
+	*x = RefundReservationRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventSeatsRequest) String() string {
+func (x *RefundReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventSeatsRequest) ProtoMessage() {}
+func (*RefundReservationRequest) ProtoMessage() {}
 
-func (x *GetEventSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[16]
+func (x *RefundReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,43 +1429,3523 @@ func (x *GetEventSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RefundReservationRequest.ProtoReflect.Descriptor instead.
+func (*RefundReservationRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetEventSeatsRequest) GetEventId() string {
+func (x *RefundReservationRequest) GetId() string {
 	if x != nil {
-		return x.EventId
+		return x.Id
 	}
 	return ""
 }
 
-type SeatStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
-	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
-	Column        int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "AVAILABLE", "PENDING", "RESERVED"
+func (x *RefundReservationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RefundReservationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RefundedAmount *money.Money           `protobuf:"bytes,4,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundReservationResponse) Reset() {
+	*x = RefundReservationResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReservationResponse) ProtoMessage() {}
+
+func (x *RefundReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReservationResponse.ProtoReflect.Descriptor instead.
+func (*RefundReservationResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefundReservationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundReservationResponse) GetRefundedAmount() *money.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *RefundReservationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CancelTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketIds     []string               `protobuf:"bytes,3,rep,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTicketsRequest) Reset() {
+	*x = CancelTicketsRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketsRequest) ProtoMessage() {}
+
+func (x *CancelTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketsRequest.ProtoReflect.Descriptor instead.
+func (*CancelTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelTicketsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelTicketsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelTicketsRequest) GetTicketIds() []string {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type CancelTicketsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RefundedAmount *money.Money           `protobuf:"bytes,4,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	TotalPrice     *money.Money           `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelTicketsResponse) Reset() {
+	*x = CancelTicketsResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketsResponse) ProtoMessage() {}
+
+func (x *CancelTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketsResponse.ProtoReflect.Descriptor instead.
+func (*CancelTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelTicketsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelTicketsResponse) GetRefundedAmount() *money.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *CancelTicketsResponse) GetTotalPrice() *money.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+// a live ticket as seen by its owner, credential_version changes whenever the ticket is transferred
+type Ticket struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId           string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ReservationId     string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	ZoneNumber        int32                  `protobuf:"varint,4,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row               int32                  `protobuf:"varint,5,opt,name=row,proto3" json:"row,omitempty"`
+	Column            int32                  `protobuf:"varint,6,opt,name=column,proto3" json:"column,omitempty"`
+	CredentialVersion int32                  `protobuf:"varint,7,opt,name=credential_version,json=credentialVersion,proto3" json:"credential_version,omitempty"`
+	// signed token rendered as the QR code of the ticket
+	Credential string `protobuf:"bytes,8,opt,name=credential,proto3" json:"credential,omitempty"`
+	// set once the ticket was scanned at the door
+	CheckedInAt  string `protobuf:"bytes,9,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	TicketTypeId string `protobuf:"bytes,10,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	// the holder has to prove they are eligible for the ticket type at the door
+	RequiresVerification bool `protobuf:"varint,11,opt,name=requires_verification,json=requiresVerification,proto3" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_reservation_reservation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Ticket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ticket) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Ticket) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Ticket) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *Ticket) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Ticket) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Ticket) GetCredentialVersion() int32 {
+	if x != nil {
+		return x.CredentialVersion
+	}
+	return 0
+}
+
+func (x *Ticket) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *Ticket) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *Ticket) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *Ticket) GetRequiresVerification() bool {
+	if x != nil {
+		return x.RequiresVerification
+	}
+	return false
+}
+
+type TicketTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,5,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ZoneNumber    int32                  `protobuf:"varint,7,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row           int32                  `protobuf:"varint,8,opt,name=row,proto3" json:"row,omitempty"`
+	Column        int32                  `protobuf:"varint,9,opt,name=column,proto3" json:"column,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketTransfer) Reset() {
+	*x = TicketTransfer{}
+	mi := &file_reservation_reservation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketTransfer) ProtoMessage() {}
+
+func (x *TicketTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketTransfer.ProtoReflect.Descriptor instead.
+func (*TicketTransfer) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TicketTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *TicketTransfer) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TicketTransfer) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *TicketTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsRequest) ProtoMessage() {}
+
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListTicketsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsResponse) Reset() {
+	*x = ListTicketsResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsResponse) ProtoMessage() {}
+
+func (x *ListTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type OfferTicketTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferTicketTransferRequest) Reset() {
+	*x = OfferTicketTransferRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferTicketTransferRequest) ProtoMessage() {}
+
+func (x *OfferTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*OfferTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OfferTicketTransferRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *OfferTicketTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OfferTicketTransferRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+type OfferTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferTicketTransferResponse) Reset() {
+	*x = OfferTicketTransferResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferTicketTransferResponse) ProtoMessage() {}
+
+func (x *OfferTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*OfferTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OfferTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptTicketTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTicketTransferRequest) Reset() {
+	*x = AcceptTicketTransferRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTicketTransferRequest) ProtoMessage() {}
+
+func (x *AcceptTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AcceptTicketTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcceptTicketTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Ticket        *Ticket                `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTicketTransferResponse) Reset() {
+	*x = AcceptTicketTransferResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTicketTransferResponse) ProtoMessage() {}
+
+func (x *AcceptTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AcceptTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *AcceptTicketTransferResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+// pending transfers offered by or to the user
+type ListTicketTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTransfersRequest) Reset() {
+	*x = ListTicketTransfersRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTransfersRequest) ProtoMessage() {}
+
+func (x *ListTicketTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListTicketTransfersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTicketTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*TicketTransfer      `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTransfersResponse) Reset() {
+	*x = ListTicketTransfersResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTransfersResponse) ProtoMessage() {}
+
+func (x *ListTicketTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTicketTransfersResponse) GetTransfers() []*TicketTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type CheckInTicketRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Credential string                 `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	// the event the door belongs to, credentials of other events are refused
+	EventId       string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	GateId        string `protobuf:"bytes,3,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInTicketRequest) Reset() {
+	*x = CheckInTicketRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketRequest) ProtoMessage() {}
+
+func (x *CheckInTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketRequest.ProtoReflect.Descriptor instead.
+func (*CheckInTicketRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckInTicketRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type CheckInTicketResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TicketId     string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId      string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber   int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row          int32                  `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
+	Column       int32                  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CheckedInAt  string                 `protobuf:"bytes,7,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	GateId       string                 `protobuf:"bytes,8,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	TicketTypeId string                 `protobuf:"bytes,9,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	// staff have to check the holder is eligible for the ticket type, a student card for example
+	RequiresVerification bool `protobuf:"varint,10,opt,name=requires_verification,json=requiresVerification,proto3" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CheckInTicketResponse) Reset() {
+	*x = CheckInTicketResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketResponse) ProtoMessage() {}
+
+func (x *CheckInTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketResponse.ProtoReflect.Descriptor instead.
+func (*CheckInTicketResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckInTicketResponse) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *CheckInTicketResponse) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CheckInTicketResponse) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *CheckInTicketResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *CheckInTicketResponse) GetRequiresVerification() bool {
+	if x != nil {
+		return x.RequiresVerification
+	}
+	return false
+}
+
+type GetScanBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanBundleRequest) Reset() {
+	*x = GetScanBundleRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanBundleRequest) ProtoMessage() {}
+
+func (x *GetScanBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetScanBundleRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetScanBundleRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// ScanBundleTicket is what a door device needs to admit a ticket offline, the rest is in its credential
+type ScanBundleTicket struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// credentials of older versions were replaced by a transfer
+	CredentialVersion int32 `protobuf:"varint,2,opt,name=credential_version,json=credentialVersion,proto3" json:"credential_version,omitempty"`
+	// revoked tickets were given back and must not be admitted
+	Revoked              bool   `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CheckedInAt          string `protobuf:"bytes,4,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	RequiresVerification bool   `protobuf:"varint,5,opt,name=requires_verification,json=requiresVerification,proto3" json:"requires_verification,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScanBundleTicket) Reset() {
+	*x = ScanBundleTicket{}
+	mi := &file_reservation_reservation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanBundleTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanBundleTicket) ProtoMessage() {}
+
+func (x *ScanBundleTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanBundleTicket.ProtoReflect.Descriptor instead.
+func (*ScanBundleTicket) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ScanBundleTicket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ScanBundleTicket) GetCredentialVersion() int32 {
+	if x != nil {
+		return x.CredentialVersion
+	}
+	return 0
+}
+
+func (x *ScanBundleTicket) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ScanBundleTicket) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *ScanBundleTicket) GetRequiresVerification() bool {
+	if x != nil {
+		return x.RequiresVerification
+	}
+	return false
+}
+
+type GetScanBundleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// base64 encoded Ed25519 key that verifies ticket credentials
+	PublicKey     string              `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	GeneratedAt   string              `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Tickets       []*ScanBundleTicket `protobuf:"bytes,4,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanBundleResponse) Reset() {
+	*x = GetScanBundleResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanBundleResponse) ProtoMessage() {}
+
+func (x *GetScanBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetScanBundleResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetScanBundleResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetScanBundleResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *GetScanBundleResponse) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *GetScanBundleResponse) GetTickets() []*ScanBundleTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type OfflineScan struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Credential string                 `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	GateId     string                 `protobuf:"bytes,2,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	// RFC 3339 time the door device admitted the holder
+	ScannedAt     string `protobuf:"bytes,3,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfflineScan) Reset() {
+	*x = OfflineScan{}
+	mi := &file_reservation_reservation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfflineScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineScan) ProtoMessage() {}
+
+func (x *OfflineScan) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineScan.ProtoReflect.Descriptor instead.
+func (*OfflineScan) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OfflineScan) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *OfflineScan) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *OfflineScan) GetScannedAt() string {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return ""
+}
+
+type UploadScansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Scans         []*OfflineScan         `protobuf:"bytes,2,rep,name=scans,proto3" json:"scans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadScansRequest) Reset() {
+	*x = UploadScansRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadScansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadScansRequest) ProtoMessage() {}
+
+func (x *UploadScansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadScansRequest.ProtoReflect.Descriptor instead.
+func (*UploadScansRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UploadScansRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UploadScansRequest) GetScans() []*OfflineScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+// ScanResult is the outcome of one uploaded scan: ADMITTED, DUPLICATE, REVOKED, SUPERSEDED, UNPAID or INVALID
+type ScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	GateId        string                 `protobuf:"bytes,2,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	ScannedAt     string                 `protobuf:"bytes,3,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanResult) Reset() {
+	*x = ScanResult{}
+	mi := &file_reservation_reservation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResult) ProtoMessage() {}
+
+func (x *ScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanResult.ProtoReflect.Descriptor instead.
+func (*ScanResult) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScanResult) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ScanResult) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *ScanResult) GetScannedAt() string {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return ""
+}
+
+func (x *ScanResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ScanConflict is a ticket admitted more than once, the earliest admission is kept
+type ScanConflict struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TicketId       string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	AdmittedGateId string                 `protobuf:"bytes,2,opt,name=admitted_gate_id,json=admittedGateId,proto3" json:"admitted_gate_id,omitempty"`
+	AdmittedAt     string                 `protobuf:"bytes,3,opt,name=admitted_at,json=admittedAt,proto3" json:"admitted_at,omitempty"`
+	RejectedGateId string                 `protobuf:"bytes,4,opt,name=rejected_gate_id,json=rejectedGateId,proto3" json:"rejected_gate_id,omitempty"`
+	RejectedAt     string                 `protobuf:"bytes,5,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScanConflict) Reset() {
+	*x = ScanConflict{}
+	mi := &file_reservation_reservation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanConflict) ProtoMessage() {}
+
+func (x *ScanConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanConflict.ProtoReflect.Descriptor instead.
+func (*ScanConflict) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ScanConflict) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ScanConflict) GetAdmittedGateId() string {
+	if x != nil {
+		return x.AdmittedGateId
+	}
+	return ""
+}
+
+func (x *ScanConflict) GetAdmittedAt() string {
+	if x != nil {
+		return x.AdmittedAt
+	}
+	return ""
+}
+
+func (x *ScanConflict) GetRejectedGateId() string {
+	if x != nil {
+		return x.RejectedGateId
+	}
+	return ""
+}
+
+func (x *ScanConflict) GetRejectedAt() string {
+	if x != nil {
+		return x.RejectedAt
+	}
+	return ""
+}
+
+type UploadScansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of the uploaded scans
+	Results       []*ScanResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Conflicts     []*ScanConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadScansResponse) Reset() {
+	*x = UploadScansResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadScansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadScansResponse) ProtoMessage() {}
+
+func (x *UploadScansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadScansResponse.ProtoReflect.Descriptor instead.
+func (*UploadScansResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UploadScansResponse) GetResults() []*ScanResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UploadScansResponse) GetConflicts() []*ScanConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// WaitlistEntry waits for seats given back in a zone of an event, zone 0 takes seats of any zone.
+// An OFFERED entry holds the seats as a PENDING reservation until offer_expires_at
+type WaitlistEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber     int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity       int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ReservationId  string                 `protobuf:"bytes,6,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	OfferExpiresAt string                 `protobuf:"bytes,7,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_reservation_reservation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() string {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber    int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *JoinWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *JoinWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LeaveWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LeaveWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LeaveWaitlistResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWaitlistEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistEntriesRequest) Reset() {
+	*x = ListWaitlistEntriesRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesRequest) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWaitlistEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListWaitlistEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistEntriesResponse) Reset() {
+	*x = ListWaitlistEntriesResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesResponse) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListWaitlistEntriesResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type BallotApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber    int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ReservationId string                 `protobuf:"bytes,6,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	DrawPosition  int32                  `protobuf:"varint,7,opt,name=draw_position,json=drawPosition,proto3" json:"draw_position,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BallotApplication) Reset() {
+	*x = BallotApplication{}
+	mi := &file_reservation_reservation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BallotApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BallotApplication) ProtoMessage() {}
+
+func (x *BallotApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BallotApplication.ProtoReflect.Descriptor instead.
+func (*BallotApplication) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BallotApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BallotApplication) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *BallotApplication) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *BallotApplication) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BallotApplication) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BallotApplication) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *BallotApplication) GetDrawPosition() int32 {
+	if x != nil {
+		return x.DrawPosition
+	}
+	return 0
+}
+
+func (x *BallotApplication) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ApplyBallotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber    int32                  `protobuf:"varint,3,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyBallotRequest) Reset() {
+	*x = ApplyBallotRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyBallotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBallotRequest) ProtoMessage() {}
+
+func (x *ApplyBallotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBallotRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBallotRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ApplyBallotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ApplyBallotRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ApplyBallotRequest) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *ApplyBallotRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ApplyBallotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *BallotApplication     `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyBallotResponse) Reset() {
+	*x = ApplyBallotResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyBallotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBallotResponse) ProtoMessage() {}
+
+func (x *ApplyBallotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBallotResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBallotResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ApplyBallotResponse) GetApplication() *BallotApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type WithdrawBallotApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawBallotApplicationRequest) Reset() {
+	*x = WithdrawBallotApplicationRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawBallotApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawBallotApplicationRequest) ProtoMessage() {}
+
+func (x *WithdrawBallotApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawBallotApplicationRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawBallotApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WithdrawBallotApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithdrawBallotApplicationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type WithdrawBallotApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawBallotApplicationResponse) Reset() {
+	*x = WithdrawBallotApplicationResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawBallotApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawBallotApplicationResponse) ProtoMessage() {}
+
+func (x *WithdrawBallotApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawBallotApplicationResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawBallotApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WithdrawBallotApplicationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBallotApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBallotApplicationsRequest) Reset() {
+	*x = ListBallotApplicationsRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBallotApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBallotApplicationsRequest) ProtoMessage() {}
+
+func (x *ListBallotApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBallotApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBallotApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListBallotApplicationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListBallotApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*BallotApplication   `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBallotApplicationsResponse) Reset() {
+	*x = ListBallotApplicationsResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBallotApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBallotApplicationsResponse) ProtoMessage() {}
+
+func (x *ListBallotApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBallotApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListBallotApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListBallotApplicationsResponse) GetApplications() []*BallotApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type GetBallotDrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotDrawRequest) Reset() {
+	*x = GetBallotDrawRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotDrawRequest) ProtoMessage() {}
+
+func (x *GetBallotDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotDrawRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotDrawRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetBallotDrawRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// the draw shuffles the application ids, sorted, with a PCG generator seeded by seed
+type GetBallotDrawResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Seed           int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	ApplicationIds []string               `protobuf:"bytes,3,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	DrawnAt        string                 `protobuf:"bytes,4,opt,name=drawn_at,json=drawnAt,proto3" json:"drawn_at,omitempty"`
+	CompletedAt    string                 `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBallotDrawResponse) Reset() {
+	*x = GetBallotDrawResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotDrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotDrawResponse) ProtoMessage() {}
+
+func (x *GetBallotDrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotDrawResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotDrawResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetBallotDrawResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetBallotDrawResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GetBallotDrawResponse) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *GetBallotDrawResponse) GetDrawnAt() string {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return ""
+}
+
+func (x *GetBallotDrawResponse) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+// Promotion is a promo code taking a PERCENTAGE or a FIXED amount off the seats in its scope.
+// A cap of 0 is unlimited.
+type Promotion struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code         string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// the share taken off by a PERCENTAGE promotion
+	PercentOff float64 `protobuf:"fixed64,4,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	// the amount taken off by a FIXED promotion
+	AmountOff *money.Money `protobuf:"bytes,15,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	// every event when empty
+	EventId string `protobuf:"bytes,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// every zone of the event when unset
+	ZoneNumber            *int32 `protobuf:"varint,6,opt,name=zone_number,json=zoneNumber,proto3,oneof" json:"zone_number,omitempty"`
+	MaxRedemptions        int32  `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser int32  `protobuf:"varint,8,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"`
+	RedemptionCount       int32  `protobuf:"varint,9,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"`
+	Stackable             bool   `protobuf:"varint,10,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	StartsAt              string `protobuf:"bytes,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt                string `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Active                bool   `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt             string `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_reservation_reservation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Promotion) GetPercentOff() float64 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Promotion) GetAmountOff() *money.Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *Promotion) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Promotion) GetZoneNumber() int32 {
+	if x != nil && x.ZoneNumber != nil {
+		return *x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxRedemptionsPerUser() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *Promotion) GetRedemptionCount() int32 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *Promotion) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *Promotion) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promotion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// PriceBreakdown is what the total price of a reservation is made of. The total is the subtotal less the
+// discount plus the fees, plus the tax unless vat_inclusive, in which case the tax is the VAT inside it.
+type PriceBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the tickets before discounts
+	Subtotal *money.Money `protobuf:"bytes,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount *money.Money `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	// the booking fee of every ticket
+	BookingFee    *money.Money `protobuf:"bytes,3,opt,name=booking_fee,json=bookingFee,proto3" json:"booking_fee,omitempty"`
+	ProcessingFee *money.Money `protobuf:"bytes,4,opt,name=processing_fee,json=processingFee,proto3" json:"processing_fee,omitempty"`
+	Tax           *money.Money `protobuf:"bytes,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	// VAT in percent
+	VatRate       float64      `protobuf:"fixed64,6,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate,omitempty"`
+	VatInclusive  bool         `protobuf:"varint,7,opt,name=vat_inclusive,json=vatInclusive,proto3" json:"vat_inclusive,omitempty"`
+	Total         *money.Money `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_reservation_reservation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PriceBreakdown) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetDiscount() *money.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetBookingFee() *money.Money {
+	if x != nil {
+		return x.BookingFee
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetProcessingFee() *money.Money {
+	if x != nil {
+		return x.ProcessingFee
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetTax() *money.Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetVatRate() float64 {
+	if x != nil {
+		return x.VatRate
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetVatInclusive() bool {
+	if x != nil {
+		return x.VatInclusive
+	}
+	return false
+}
+
+func (x *PriceBreakdown) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// AppliedPromotion is the discount a promo code gave a reservation.
+type AppliedPromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedPromotion) Reset() {
+	*x = AppliedPromotion{}
+	mi := &file_reservation_reservation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPromotion) ProtoMessage() {}
+
+func (x *AppliedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedPromotion.ProtoReflect.Descriptor instead.
+func (*AppliedPromotion) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AppliedPromotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// LineItem is one line of the checkout of a reservation. A TICKET line is the seats of one zone, DISCOUNT,
+// FEE and TAX lines follow them, a discount has a negative amount. VAT included in the prices has no line.
+type LineItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Kind        string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// set on TICKET lines
+	ZoneNumber    *int32       `protobuf:"varint,4,opt,name=zone_number,json=zoneNumber,proto3,oneof" json:"zone_number,omitempty"`
+	Quantity      int32        `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitAmount    *money.Money `protobuf:"bytes,6,opt,name=unit_amount,json=unitAmount,proto3" json:"unit_amount,omitempty"`
+	Amount        *money.Money `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	mi := &file_reservation_reservation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LineItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LineItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LineItem) GetZoneNumber() int32 {
+	if x != nil && x.ZoneNumber != nil {
+		return *x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *LineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LineItem) GetUnitAmount() *money.Money {
+	if x != nil {
+		return x.UnitAmount
+	}
+	return nil
+}
+
+func (x *LineItem) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CreatePromotionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Code         string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType string                 `protobuf:"bytes,2,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// for PERCENTAGE promotions
+	PercentOff float64 `protobuf:"fixed64,3,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	// for FIXED promotions
+	AmountOff             *money.Money `protobuf:"bytes,11,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	EventId               string       `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ZoneNumber            *int32       `protobuf:"varint,5,opt,name=zone_number,json=zoneNumber,proto3,oneof" json:"zone_number,omitempty"`
+	MaxRedemptions        int32        `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser int32        `protobuf:"varint,7,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"`
+	Stackable             bool         `protobuf:"varint,8,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	StartsAt              string       `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt                string       `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetPercentOff() float64 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetAmountOff() *money.Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetZoneNumber() int32 {
+	if x != nil && x.ZoneNumber != nil {
+		return *x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxRedemptionsPerUser() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *CreatePromotionRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{67}
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type DeactivatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromotionRequest) Reset() {
+	*x = DeactivatePromotionRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromotionRequest) ProtoMessage() {}
+
+func (x *DeactivatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeactivatePromotionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeactivatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromotionResponse) Reset() {
+	*x = DeactivatePromotionResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromotionResponse) ProtoMessage() {}
+
+func (x *DeactivatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*DeactivatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeactivatePromotionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReservationByStripeSessionIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TotalPrice    *money.Money           `protobuf:"bytes,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Seats         []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Breakdown     *PriceBreakdown        `protobuf:"bytes,10,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationByStripeSessionIDResponse) Reset() {
+	*x = GetReservationByStripeSessionIDResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationByStripeSessionIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationByStripeSessionIDResponse) ProtoMessage() {}
+
+func (x *GetReservationByStripeSessionIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationByStripeSessionIDResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationByStripeSessionIDResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetTotalPrice() *money.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetReservationByStripeSessionIDResponse) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type GetEventSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventSeatsRequest) Reset() {
+	*x = GetEventSeatsRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventSeatsRequest) ProtoMessage() {}
+
+func (x *GetEventSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetEventSeatsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type SeatStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Column        int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "AVAILABLE", "PENDING", "RESERVED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatStatus) Reset() {
+	*x = SeatStatus{}
+	mi := &file_reservation_reservation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatStatus) ProtoMessage() {}
+
+func (x *SeatStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatStatus.ProtoReflect.Descriptor instead.
+func (*SeatStatus) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SeatStatus) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *SeatStatus) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatStatus) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GeneralAdmissionStatus counts held and sold tickets of a general admission zone.
+type GeneralAdmissionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber    int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Taken         int32                  `protobuf:"varint,3,opt,name=taken,proto3" json:"taken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneralAdmissionStatus) Reset() {
+	*x = GeneralAdmissionStatus{}
+	mi := &file_reservation_reservation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneralAdmissionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralAdmissionStatus) ProtoMessage() {}
+
+func (x *GeneralAdmissionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneralAdmissionStatus.ProtoReflect.Descriptor instead.
+func (*GeneralAdmissionStatus) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GeneralAdmissionStatus) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
+	}
+	return 0
+}
+
+func (x *GeneralAdmissionStatus) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GeneralAdmissionStatus) GetTaken() int32 {
+	if x != nil {
+		return x.Taken
+	}
+	return 0
+}
+
+type GetEventSeatsResponse struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Seats            []*SeatStatus             `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	GeneralAdmission []*GeneralAdmissionStatus `protobuf:"bytes,2,rep,name=general_admission,json=generalAdmission,proto3" json:"general_admission,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetEventSeatsResponse) Reset() {
+	*x = GetEventSeatsResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventSeatsResponse) ProtoMessage() {}
+
+func (x *GetEventSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventSeatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetEventSeatsResponse) GetSeats() []*SeatStatus {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GetEventSeatsResponse) GetGeneralAdmission() []*GeneralAdmissionStatus {
+	if x != nil {
+		return x.GeneralAdmission
+	}
+	return nil
+}
+
+type GetZonePricingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeatStatus) Reset() {
-	*x = SeatStatus{}
-	mi := &file_reservation_reservation_proto_msgTypes[17]
+func (x *GetZonePricingRequest) Reset() {
+	*x = GetZonePricingRequest{}
+	mi := &file_reservation_reservation_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatStatus) String() string {
+func (x *GetZonePricingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatStatus) ProtoMessage() {}
+func (*GetZonePricingRequest) ProtoMessage() {}
 
-func (x *SeatStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[17]
+func (x *GetZonePricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,61 +4956,263 @@ func (x *SeatStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatStatus.ProtoReflect.Descriptor instead.
-func (*SeatStatus) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetZonePricingRequest.ProtoReflect.Descriptor instead.
+func (*GetZonePricingRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SeatStatus) GetZoneNumber() int32 {
+func (x *GetZonePricingRequest) GetEventId() string {
 	if x != nil {
-		return x.ZoneNumber
+		return x.EventId
+	}
+	return ""
+}
+
+// ZonePriceTier is a scheduled price tier of a zone as it stands for the sales of the zone so far
+type ZonePriceTier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price *money.Money           `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// RFC3339
+	StartsAt string `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// RFC3339, empty when the tier only ends on sell-through
+	EndsAt string `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// tickets left before the tier sells through, unset when it has no sell-through
+	Remaining     *int32 `protobuf:"varint,6,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZonePriceTier) Reset() {
+	*x = ZonePriceTier{}
+	mi := &file_reservation_reservation_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZonePriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonePriceTier) ProtoMessage() {}
+
+func (x *ZonePriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonePriceTier.ProtoReflect.Descriptor instead.
+func (*ZonePriceTier) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ZonePriceTier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ZonePriceTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZonePriceTier) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ZonePriceTier) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *ZonePriceTier) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *ZonePriceTier) GetRemaining() int32 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
 	}
 	return 0
 }
 
-func (x *SeatStatus) GetRow() int32 {
+// ZonePricing is what a seat of a zone costs when it is held now
+type ZonePricing struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ZoneNumber int32                  `protobuf:"varint,1,opt,name=zone_number,json=zoneNumber,proto3" json:"zone_number,omitempty"`
+	// the price of the current tier, or the zone price when no tier is selling
+	Price       *money.Money   `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	CurrentTier *ZonePriceTier `protobuf:"bytes,3,opt,name=current_tier,json=currentTier,proto3" json:"current_tier,omitempty"`
+	// the tier that sells after the current one, unset when none follows
+	NextTier *ZonePriceTier `protobuf:"bytes,4,opt,name=next_tier,json=nextTier,proto3" json:"next_tier,omitempty"`
+	// tickets of the zone sold so far
+	Sold          int32 `protobuf:"varint,5,opt,name=sold,proto3" json:"sold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZonePricing) Reset() {
+	*x = ZonePricing{}
+	mi := &file_reservation_reservation_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZonePricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonePricing) ProtoMessage() {}
+
+func (x *ZonePricing) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[78]
 	if x != nil {
-		return x.Row
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonePricing.ProtoReflect.Descriptor instead.
+func (*ZonePricing) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ZonePricing) GetZoneNumber() int32 {
+	if x != nil {
+		return x.ZoneNumber
 	}
 	return 0
 }
 
-func (x *SeatStatus) GetColumn() int32 {
+func (x *ZonePricing) GetPrice() *money.Money {
 	if x != nil {
-		return x.Column
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ZonePricing) GetCurrentTier() *ZonePriceTier {
+	if x != nil {
+		return x.CurrentTier
+	}
+	return nil
+}
+
+func (x *ZonePricing) GetNextTier() *ZonePriceTier {
+	if x != nil {
+		return x.NextTier
+	}
+	return nil
+}
+
+func (x *ZonePricing) GetSold() int32 {
+	if x != nil {
+		return x.Sold
 	}
 	return 0
 }
 
-func (x *SeatStatus) GetStatus() string {
+type GetZonePricingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Zones []*ZonePricing         `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	// RFC3339 time the pricing was resolved at, lets clients count down to the tier boundaries
+	ResolvedAt    string `protobuf:"bytes,2,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetZonePricingResponse) Reset() {
+	*x = GetZonePricingResponse{}
+	mi := &file_reservation_reservation_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetZonePricingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZonePricingResponse) ProtoMessage() {}
+
+func (x *GetZonePricingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[79]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZonePricingResponse.ProtoReflect.Descriptor instead.
+func (*GetZonePricingResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetZonePricingResponse) GetZones() []*ZonePricing {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *GetZonePricingResponse) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
 	}
 	return ""
 }
 
-type GetEventSeatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Seats         []*SeatStatus          `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+// SeatConflict is attached as a status detail when a hold could not be placed
+// because some of the requested seats are already held or sold.
+type SeatConflict struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Seats         []*CreateReservationSeatRequest `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventSeatsResponse) Reset() {
-	*x = GetEventSeatsResponse{}
-	mi := &file_reservation_reservation_proto_msgTypes[18]
+func (x *SeatConflict) Reset() {
+	*x = SeatConflict{}
+	mi := &file_reservation_reservation_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventSeatsResponse) String() string {
+func (x *SeatConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventSeatsResponse) ProtoMessage() {}
+func (*SeatConflict) ProtoMessage() {}
 
-func (x *GetEventSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reservation_reservation_proto_msgTypes[18]
+func (x *SeatConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,51 +5223,132 @@ func (x *GetEventSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventSeatsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_reservation_reservation_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SeatConflict.ProtoReflect.Descriptor instead.
+func (*SeatConflict) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetEventSeatsResponse) GetSeats() []*SeatStatus {
+func (x *SeatConflict) GetSeats() []*CreateReservationSeatRequest {
 	if x != nil {
 		return x.Seats
 	}
 	return nil
 }
 
+// TicketLimitExceeded is attached as a status detail when a request would take the user over the
+// ticket limit per user of the event, or could not be checked against it in time.
+type TicketLimitExceeded struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unset when the check timed out behind another request of the user
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining     int32 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketLimitExceeded) Reset() {
+	*x = TicketLimitExceeded{}
+	mi := &file_reservation_reservation_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketLimitExceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketLimitExceeded) ProtoMessage() {}
+
+func (x *TicketLimitExceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_reservation_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketLimitExceeded.ProtoReflect.Descriptor instead.
+func (*TicketLimitExceeded) Descriptor() ([]byte, []int) {
+	return file_reservation_reservation_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TicketLimitExceeded) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TicketLimitExceeded) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
 var File_reservation_reservation_proto protoreflect.FileDescriptor
 
 const file_reservation_reservation_proto_rawDesc = "" +
 	"\n" +
-	"\x1dreservation/reservation.proto\x12\vreservation\"\a\n" +
-	"\x05Empty\"g\n" +
+	"\x1dreservation/reservation.proto\x12\vreservation\x1a\x11money/money.proto\"\a\n" +
+	"\x05Empty\"\xbe\x01\n" +
 	"\x04Seat\x12\x1f\n" +
 	"\vzone_number\x18\x01 \x01(\x05R\n" +
-	"zoneNumber\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x10\n" +
+	"zoneNumber\x12\"\n" +
+	"\x05price\x18\a \x01(\v2\f.money.MoneyR\x05price\x12\x10\n" +
 	"\x03row\x18\x04 \x01(\x05R\x03row\x12\x16\n" +
-	"\x06column\x18\x05 \x01(\x05R\x06column\"\x95\x02\n" +
+	"\x06column\x18\x05 \x01(\x05R\x06column\x12\x1b\n" +
+	"\tticket_id\x18\x06 \x01(\tR\bticketId\x12$\n" +
+	"\x0eticket_type_id\x18\b \x01(\tR\fticketTypeIdJ\x04\b\x03\x10\x04\"\xeb\x01\n" +
+	"\rTicketHistory\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x1f\n" +
+	"\vzone_number\x18\x02 \x01(\x05R\n" +
+	"zoneNumber\x12\x10\n" +
+	"\x03row\x18\x03 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\x05R\x06column\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x125\n" +
+	"\x0frefunded_amount\x18\b \x01(\v2\f.money.MoneyR\x0erefundedAmount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAtJ\x04\b\x06\x10\a\"\x80\x03\n" +
 	"\vReservation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
-	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12-\n" +
+	"\vtotal_price\x18\t \x01(\v2\f.money.MoneyR\n" +
 	"totalPrice\x12'\n" +
 	"\x05seats\x18\x05 \x03(\v2\x11.reservation.SeatR\x05seats\x120\n" +
 	"\x14stripe_client_secret\x18\x06 \x01(\tR\x12stripeClientSecret\x12 \n" +
 	"\ttime_left\x18\a \x01(\x01H\x00R\btimeLeft\x88\x01\x01\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06statusB\f\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x129\n" +
+	"\tbreakdown\x18\v \x01(\v2\x1b.reservation.PriceBreakdownR\tbreakdownB\f\n" +
 	"\n" +
-	"_time_left\"i\n" +
+	"_time_leftJ\x04\b\x04\x10\x05\"\x8f\x01\n" +
 	"\x1cCreateReservationSeatRequest\x12\x1f\n" +
 	"\vzone_number\x18\x01 \x01(\x05R\n" +
 	"zoneNumber\x12\x10\n" +
 	"\x03row\x18\x02 \x01(\x05R\x03row\x12\x16\n" +
-	"\x06column\x18\x03 \x01(\x05R\x06column\"\x8f\x01\n" +
+	"\x06column\x18\x03 \x01(\x05R\x06column\x12$\n" +
+	"\x0eticket_type_id\x18\x04 \x01(\tR\fticketTypeId\"\xc5\x02\n" +
 	"\x18CreateReservationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12?\n" +
-	"\x05seats\x18\x04 \x03(\v2).reservation.CreateReservationSeatRequestR\x05seats\"*\n" +
+	"\x05seats\x18\x04 \x03(\v2).reservation.CreateReservationSeatRequestR\x05seats\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12Q\n" +
+	"\x11general_admission\x18\x06 \x03(\v2$.reservation.GeneralAdmissionRequestR\x10generalAdmission\x12\x1f\n" +
+	"\vpromo_codes\x18\a \x03(\tR\n" +
+	"promoCodesB\x12\n" +
+	"\x10_idempotency_key\"|\n" +
+	"\x17GeneralAdmissionRequest\x12\x1f\n" +
+	"\vzone_number\x18\x01 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\tR\fticketTypeId\"*\n" +
 	"\x18DeleteReservationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x16ListReservationRequest\x12\x17\n" +
@@ -1141,37 +5359,342 @@ const file_reservation_reservation_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"+\n" +
 	"\x19ConfirmReservationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xcf\x01\n" +
+	"\x1bReserveBestAvailableRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1e\n" +
+	"\n" +
+	"contiguous\x18\x05 \x01(\bR\n" +
+	"contiguous\x12\x1f\n" +
+	"\vpromo_codes\x18\x06 \x03(\tR\n" +
+	"promoCodes\"+\n" +
 	"\x19CreateReservationResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
+	"\x1cReserveBestAvailableResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\x05seats\x18\x02 \x03(\v2).reservation.CreateReservationSeatRequestR\x05seats\"+\n" +
 	"\x19DeleteReservationResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
 	"\x17ListReservationResponse\x12:\n" +
-	"\vreservation\x18\x01 \x03(\v2\x18.reservation.ReservationR\vreservation\"\xa0\x02\n" +
+	"\vreservation\x18\x01 \x03(\v2\x18.reservation.ReservationR\vreservation\"\xb6\x04\n" +
 	"\x16GetReservationResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
-	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12-\n" +
+	"\vtotal_price\x18\v \x01(\v2\f.money.MoneyR\n" +
 	"totalPrice\x12'\n" +
 	"\x05seats\x18\x05 \x03(\v2\x11.reservation.SeatR\x05seats\x120\n" +
 	"\x14stripe_client_secret\x18\x06 \x01(\tR\x12stripeClientSecret\x12 \n" +
 	"\ttime_left\x18\a \x01(\x01H\x00R\btimeLeft\x88\x01\x01\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06statusB\f\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x124\n" +
+	"\ahistory\x18\t \x03(\v2\x1a.reservation.TicketHistoryR\ahistory\x12=\n" +
+	"\n" +
+	"promotions\x18\n" +
+	" \x03(\v2\x1d.reservation.AppliedPromotionR\n" +
+	"promotions\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x124\n" +
 	"\n" +
-	"_time_left\"`\n" +
+	"line_items\x18\r \x03(\v2\x15.reservation.LineItemR\tlineItems\x129\n" +
+	"\tbreakdown\x18\x0e \x01(\v2\x1b.reservation.PriceBreakdownR\tbreakdownB\f\n" +
+	"\n" +
+	"_time_leftJ\x04\b\x04\x10\x05\"|\n" +
 	"\x1aConfirmReservationResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xcf\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\brefunded\x18\x04 \x01(\bR\brefunded\"n\n" +
+	"\x18ExtendReservationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\aseconds\x18\x03 \x01(\x05H\x00R\aseconds\x88\x01\x01B\n" +
+	"\n" +
+	"\b_seconds\"z\n" +
+	"\x19ExtendReservationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttime_left\x18\x02 \x01(\x01R\btimeLeft\x120\n" +
+	"\x14stripe_client_secret\x18\x03 \x01(\tR\x12stripeClientSecret\"C\n" +
+	"\x18RefundReservationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x80\x01\n" +
+	"\x19RefundReservationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x0frefunded_amount\x18\x04 \x01(\v2\f.money.MoneyR\x0erefundedAmount\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06statusJ\x04\b\x02\x10\x03\"^\n" +
+	"\x14CancelTicketsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x03 \x03(\tR\tticketIds\"\x99\x01\n" +
+	"\x15CancelTicketsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x0frefunded_amount\x18\x04 \x01(\v2\f.money.MoneyR\x0erefundedAmount\x12-\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\f.money.MoneyR\n" +
+	"totalPriceJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"\xf3\x02\n" +
+	"\x06Ticket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\x12\x1f\n" +
+	"\vzone_number\x18\x04 \x01(\x05R\n" +
+	"zoneNumber\x12\x10\n" +
+	"\x03row\x18\x05 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x06 \x01(\x05R\x06column\x12-\n" +
+	"\x12credential_version\x18\a \x01(\x05R\x11credentialVersion\x12\x1e\n" +
+	"\n" +
+	"credential\x18\b \x01(\tR\n" +
+	"credential\x12\"\n" +
+	"\rchecked_in_at\x18\t \x01(\tR\vcheckedInAt\x12$\n" +
+	"\x0eticket_type_id\x18\n" +
+	" \x01(\tR\fticketTypeId\x123\n" +
+	"\x15requires_verification\x18\v \x01(\bR\x14requiresVerification\"\x9a\x02\n" +
+	"\x0eTicketTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x05 \x01(\tR\btoUserId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vzone_number\x18\a \x01(\x05R\n" +
+	"zoneNumber\x12\x10\n" +
+	"\x03row\x18\b \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\t \x01(\x05R\x06column\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"-\n" +
+	"\x12ListTicketsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x13ListTicketsResponse\x12-\n" +
+	"\atickets\x18\x01 \x03(\v2\x13.reservation.TicketR\atickets\"p\n" +
+	"\x1aOfferTicketTransferRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\"V\n" +
+	"\x1bOfferTicketTransferResponse\x127\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1b.reservation.TicketTransferR\btransfer\"F\n" +
+	"\x1bAcceptTicketTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x84\x01\n" +
+	"\x1cAcceptTicketTransferResponse\x127\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1b.reservation.TicketTransferR\btransfer\x12+\n" +
+	"\x06ticket\x18\x02 \x01(\v2\x13.reservation.TicketR\x06ticket\"5\n" +
+	"\x1aListTicketTransfersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"X\n" +
+	"\x1bListTicketTransfersResponse\x129\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1b.reservation.TicketTransferR\ttransfers\"j\n" +
+	"\x14CheckInTicketRequest\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\tR\n" +
+	"credential\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\agate_id\x18\x03 \x01(\tR\x06gateId\"\xca\x02\n" +
+	"\x15CheckInTicketResponse\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x10\n" +
+	"\x03row\x18\x04 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\x05R\x06column\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\"\n" +
+	"\rchecked_in_at\x18\a \x01(\tR\vcheckedInAt\x12\x17\n" +
+	"\agate_id\x18\b \x01(\tR\x06gateId\x12$\n" +
+	"\x0eticket_type_id\x18\t \x01(\tR\fticketTypeId\x123\n" +
+	"\x15requires_verification\x18\n" +
+	" \x01(\bR\x14requiresVerification\"1\n" +
+	"\x14GetScanBundleRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xd1\x01\n" +
+	"\x10ScanBundleTicket\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12-\n" +
+	"\x12credential_version\x18\x02 \x01(\x05R\x11credentialVersion\x12\x18\n" +
+	"\arevoked\x18\x03 \x01(\bR\arevoked\x12\"\n" +
+	"\rchecked_in_at\x18\x04 \x01(\tR\vcheckedInAt\x123\n" +
+	"\x15requires_verification\x18\x05 \x01(\bR\x14requiresVerification\"\xad\x01\n" +
+	"\x15GetScanBundleResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12!\n" +
+	"\fgenerated_at\x18\x03 \x01(\tR\vgeneratedAt\x127\n" +
+	"\atickets\x18\x04 \x03(\v2\x1d.reservation.ScanBundleTicketR\atickets\"e\n" +
+	"\vOfflineScan\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\tR\n" +
+	"credential\x12\x17\n" +
+	"\agate_id\x18\x02 \x01(\tR\x06gateId\x12\x1d\n" +
+	"\n" +
+	"scanned_at\x18\x03 \x01(\tR\tscannedAt\"_\n" +
+	"\x12UploadScansRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12.\n" +
+	"\x05scans\x18\x02 \x03(\v2\x18.reservation.OfflineScanR\x05scans\"y\n" +
+	"\n" +
+	"ScanResult\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x17\n" +
+	"\agate_id\x18\x02 \x01(\tR\x06gateId\x12\x1d\n" +
+	"\n" +
+	"scanned_at\x18\x03 \x01(\tR\tscannedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xc1\x01\n" +
+	"\fScanConflict\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12(\n" +
+	"\x10admitted_gate_id\x18\x02 \x01(\tR\x0eadmittedGateId\x12\x1f\n" +
+	"\vadmitted_at\x18\x03 \x01(\tR\n" +
+	"admittedAt\x12(\n" +
+	"\x10rejected_gate_id\x18\x04 \x01(\tR\x0erejectedGateId\x12\x1f\n" +
+	"\vrejected_at\x18\x05 \x01(\tR\n" +
+	"rejectedAt\"\x81\x01\n" +
+	"\x13UploadScansResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.reservation.ScanResultR\aresults\x127\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x19.reservation.ScanConflictR\tconflicts\"\xff\x01\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\x0ereservation_id\x18\x06 \x01(\tR\rreservationId\x12(\n" +
+	"\x10offer_expires_at\x18\a \x01(\tR\x0eofferExpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x86\x01\n" +
+	"\x13JoinWaitlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"H\n" +
+	"\x14JoinWaitlistResponse\x120\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1a.reservation.WaitlistEntryR\x05entry\"?\n" +
+	"\x14LeaveWaitlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"'\n" +
+	"\x15LeaveWaitlistResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x1aListWaitlistEntriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"S\n" +
+	"\x1bListWaitlistEntriesResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.reservation.WaitlistEntryR\aentries\"\xfe\x01\n" +
+	"\x11BallotApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\x0ereservation_id\x18\x06 \x01(\tR\rreservationId\x12#\n" +
+	"\rdraw_position\x18\a \x01(\x05R\fdrawPosition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x85\x01\n" +
+	"\x12ApplyBallotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vzone_number\x18\x03 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"W\n" +
+	"\x13ApplyBallotResponse\x12@\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1e.reservation.BallotApplicationR\vapplication\"K\n" +
+	" WithdrawBallotApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"!WithdrawBallotApplicationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x1dListBallotApplicationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"d\n" +
+	"\x1eListBallotApplicationsResponse\x12B\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1e.reservation.BallotApplicationR\fapplications\"1\n" +
+	"\x14GetBallotDrawRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xad\x01\n" +
+	"\x15GetBallotDrawResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12'\n" +
+	"\x0fapplication_ids\x18\x03 \x03(\tR\x0eapplicationIds\x12\x19\n" +
+	"\bdrawn_at\x18\x04 \x01(\tR\adrawnAt\x12!\n" +
+	"\fcompleted_at\x18\x05 \x01(\tR\vcompletedAt\"\x8b\x04\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x03 \x01(\tR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x04 \x01(\x01R\n" +
+	"percentOff\x12+\n" +
+	"\n" +
+	"amount_off\x18\x0f \x01(\v2\f.money.MoneyR\tamountOff\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\tR\aeventId\x12$\n" +
+	"\vzone_number\x18\x06 \x01(\x05H\x00R\n" +
+	"zoneNumber\x88\x01\x01\x12'\n" +
+	"\x0fmax_redemptions\x18\a \x01(\x05R\x0emaxRedemptions\x127\n" +
+	"\x18max_redemptions_per_user\x18\b \x01(\x05R\x15maxRedemptionsPerUser\x12)\n" +
+	"\x10redemption_count\x18\t \x01(\x05R\x0fredemptionCount\x12\x1c\n" +
+	"\tstackable\x18\n" +
+	" \x01(\bR\tstackable\x12\x1b\n" +
+	"\tstarts_at\x18\v \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\f \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06active\x18\r \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAtB\x0e\n" +
+	"\f_zone_number\"\xcc\x02\n" +
+	"\x0ePriceBreakdown\x12(\n" +
+	"\bsubtotal\x18\x01 \x01(\v2\f.money.MoneyR\bsubtotal\x12(\n" +
+	"\bdiscount\x18\x02 \x01(\v2\f.money.MoneyR\bdiscount\x12-\n" +
+	"\vbooking_fee\x18\x03 \x01(\v2\f.money.MoneyR\n" +
+	"bookingFee\x123\n" +
+	"\x0eprocessing_fee\x18\x04 \x01(\v2\f.money.MoneyR\rprocessingFee\x12\x1e\n" +
+	"\x03tax\x18\x05 \x01(\v2\f.money.MoneyR\x03tax\x12\x19\n" +
+	"\bvat_rate\x18\x06 \x01(\x01R\avatRate\x12#\n" +
+	"\rvat_inclusive\x18\a \x01(\bR\fvatInclusive\x12\"\n" +
+	"\x05total\x18\b \x01(\v2\f.money.MoneyR\x05total\"L\n" +
+	"\x10AppliedPromotion\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12$\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.money.MoneyR\x06amount\"\xfb\x01\n" +
+	"\bLineItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\vzone_number\x18\x04 \x01(\x05H\x00R\n" +
+	"zoneNumber\x88\x01\x01\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12-\n" +
+	"\vunit_amount\x18\x06 \x01(\v2\f.money.MoneyR\n" +
+	"unitAmount\x12$\n" +
+	"\x06amount\x18\a \x01(\v2\f.money.MoneyR\x06amountB\x0e\n" +
+	"\f_zone_number\"\xa6\x03\n" +
+	"\x16CreatePromotionRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x02 \x01(\tR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x03 \x01(\x01R\n" +
+	"percentOff\x12+\n" +
+	"\n" +
+	"amount_off\x18\v \x01(\v2\f.money.MoneyR\tamountOff\x12\x19\n" +
+	"\bevent_id\x18\x04 \x01(\tR\aeventId\x12$\n" +
+	"\vzone_number\x18\x05 \x01(\x05H\x00R\n" +
+	"zoneNumber\x88\x01\x01\x12'\n" +
+	"\x0fmax_redemptions\x18\x06 \x01(\x05R\x0emaxRedemptions\x127\n" +
+	"\x18max_redemptions_per_user\x18\a \x01(\x05R\x15maxRedemptionsPerUser\x12\x1c\n" +
+	"\tstackable\x18\b \x01(\bR\tstackable\x12\x1b\n" +
+	"\tstarts_at\x18\t \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\n" +
+	" \x01(\tR\x06endsAtB\x0e\n" +
+	"\f_zone_number\"O\n" +
+	"\x17CreatePromotionResponse\x124\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x16.reservation.PromotionR\tpromotion\"\x17\n" +
+	"\x15ListPromotionsRequest\"P\n" +
+	"\x16ListPromotionsResponse\x126\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x16.reservation.PromotionR\n" +
+	"promotions\",\n" +
+	"\x1aDeactivatePromotionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x1bDeactivatePromotionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9e\x02\n" +
 	"'GetReservationByStripeSessionIDResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
-	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12-\n" +
+	"\vtotal_price\x18\t \x01(\v2\f.money.MoneyR\n" +
 	"totalPrice\x12'\n" +
 	"\x05seats\x18\x05 \x03(\v2\x11.reservation.SeatR\x05seats\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\"1\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\tbreakdown\x18\n" +
+	" \x01(\v2\x1b.reservation.PriceBreakdownR\tbreakdownJ\x04\b\x04\x10\x05\"1\n" +
 	"\x14GetEventSeatsRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"o\n" +
 	"\n" +
@@ -1180,17 +5703,72 @@ const file_reservation_reservation_proto_rawDesc = "" +
 	"zoneNumber\x12\x10\n" +
 	"\x03row\x18\x02 \x01(\x05R\x03row\x12\x16\n" +
 	"\x06column\x18\x03 \x01(\x05R\x06column\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"F\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"k\n" +
+	"\x16GeneralAdmissionStatus\x12\x1f\n" +
+	"\vzone_number\x18\x01 \x01(\x05R\n" +
+	"zoneNumber\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x14\n" +
+	"\x05taken\x18\x03 \x01(\x05R\x05taken\"\x98\x01\n" +
 	"\x15GetEventSeatsResponse\x12-\n" +
-	"\x05seats\x18\x01 \x03(\v2\x17.reservation.SeatStatusR\x05seats2\xf1\x05\n" +
+	"\x05seats\x18\x01 \x03(\v2\x17.reservation.SeatStatusR\x05seats\x12P\n" +
+	"\x11general_admission\x18\x02 \x03(\v2#.reservation.GeneralAdmissionStatusR\x10generalAdmission\"2\n" +
+	"\x15GetZonePricingRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xbe\x01\n" +
+	"\rZonePriceTier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\x05price\x18\x03 \x01(\v2\f.money.MoneyR\x05price\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12!\n" +
+	"\tremaining\x18\x06 \x01(\x05H\x00R\tremaining\x88\x01\x01B\f\n" +
+	"\n" +
+	"_remaining\"\xde\x01\n" +
+	"\vZonePricing\x12\x1f\n" +
+	"\vzone_number\x18\x01 \x01(\x05R\n" +
+	"zoneNumber\x12\"\n" +
+	"\x05price\x18\x02 \x01(\v2\f.money.MoneyR\x05price\x12=\n" +
+	"\fcurrent_tier\x18\x03 \x01(\v2\x1a.reservation.ZonePriceTierR\vcurrentTier\x127\n" +
+	"\tnext_tier\x18\x04 \x01(\v2\x1a.reservation.ZonePriceTierR\bnextTier\x12\x12\n" +
+	"\x04sold\x18\x05 \x01(\x05R\x04sold\"i\n" +
+	"\x16GetZonePricingResponse\x12.\n" +
+	"\x05zones\x18\x01 \x03(\v2\x18.reservation.ZonePricingR\x05zones\x12\x1f\n" +
+	"\vresolved_at\x18\x02 \x01(\tR\n" +
+	"resolvedAt\"O\n" +
+	"\fSeatConflict\x12?\n" +
+	"\x05seats\x18\x01 \x03(\v2).reservation.CreateReservationSeatRequestR\x05seats\"I\n" +
+	"\x13TicketLimitExceeded\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x05R\tremaining2\xed\x16\n" +
 	"\x12ReservationService\x12d\n" +
-	"\x11CreateReservation\x12%.reservation.CreateReservationRequest\x1a&.reservation.CreateReservationResponse\"\x00\x12d\n" +
+	"\x11CreateReservation\x12%.reservation.CreateReservationRequest\x1a&.reservation.CreateReservationResponse\"\x00\x12m\n" +
+	"\x14ReserveBestAvailable\x12(.reservation.ReserveBestAvailableRequest\x1a).reservation.ReserveBestAvailableResponse\"\x00\x12d\n" +
 	"\x11DeleteReservation\x12%.reservation.DeleteReservationRequest\x1a&.reservation.DeleteReservationResponse\"\x00\x12^\n" +
 	"\x0fListReservation\x12#.reservation.ListReservationRequest\x1a$.reservation.ListReservationResponse\"\x00\x12[\n" +
 	"\x0eGetReservation\x12\".reservation.GetReservationRequest\x1a#.reservation.GetReservationResponse\"\x00\x12g\n" +
-	"\x12ConfirmReservation\x12&.reservation.ConfirmReservationRequest\x1a'.reservation.ConfirmReservationResponse\"\x00\x12\x8e\x01\n" +
+	"\x12ConfirmReservation\x12&.reservation.ConfirmReservationRequest\x1a'.reservation.ConfirmReservationResponse\"\x00\x12d\n" +
+	"\x11ExtendReservation\x12%.reservation.ExtendReservationRequest\x1a&.reservation.ExtendReservationResponse\"\x00\x12d\n" +
+	"\x11RefundReservation\x12%.reservation.RefundReservationRequest\x1a&.reservation.RefundReservationResponse\"\x00\x12X\n" +
+	"\rCancelTickets\x12!.reservation.CancelTicketsRequest\x1a\".reservation.CancelTicketsResponse\"\x00\x12R\n" +
+	"\vListTickets\x12\x1f.reservation.ListTicketsRequest\x1a .reservation.ListTicketsResponse\"\x00\x12j\n" +
+	"\x13OfferTicketTransfer\x12'.reservation.OfferTicketTransferRequest\x1a(.reservation.OfferTicketTransferResponse\"\x00\x12m\n" +
+	"\x14AcceptTicketTransfer\x12(.reservation.AcceptTicketTransferRequest\x1a).reservation.AcceptTicketTransferResponse\"\x00\x12j\n" +
+	"\x13ListTicketTransfers\x12'.reservation.ListTicketTransfersRequest\x1a(.reservation.ListTicketTransfersResponse\"\x00\x12X\n" +
+	"\rCheckInTicket\x12!.reservation.CheckInTicketRequest\x1a\".reservation.CheckInTicketResponse\"\x00\x12X\n" +
+	"\rGetScanBundle\x12!.reservation.GetScanBundleRequest\x1a\".reservation.GetScanBundleResponse\"\x00\x12R\n" +
+	"\vUploadScans\x12\x1f.reservation.UploadScansRequest\x1a .reservation.UploadScansResponse\"\x00\x12U\n" +
+	"\fJoinWaitlist\x12 .reservation.JoinWaitlistRequest\x1a!.reservation.JoinWaitlistResponse\"\x00\x12X\n" +
+	"\rLeaveWaitlist\x12!.reservation.LeaveWaitlistRequest\x1a\".reservation.LeaveWaitlistResponse\"\x00\x12j\n" +
+	"\x13ListWaitlistEntries\x12'.reservation.ListWaitlistEntriesRequest\x1a(.reservation.ListWaitlistEntriesResponse\"\x00\x12R\n" +
+	"\vApplyBallot\x12\x1f.reservation.ApplyBallotRequest\x1a .reservation.ApplyBallotResponse\"\x00\x12|\n" +
+	"\x19WithdrawBallotApplication\x12-.reservation.WithdrawBallotApplicationRequest\x1a..reservation.WithdrawBallotApplicationResponse\"\x00\x12s\n" +
+	"\x16ListBallotApplications\x12*.reservation.ListBallotApplicationsRequest\x1a+.reservation.ListBallotApplicationsResponse\"\x00\x12X\n" +
+	"\rGetBallotDraw\x12!.reservation.GetBallotDrawRequest\x1a\".reservation.GetBallotDrawResponse\"\x00\x12^\n" +
+	"\x0fCreatePromotion\x12#.reservation.CreatePromotionRequest\x1a$.reservation.CreatePromotionResponse\"\x00\x12[\n" +
+	"\x0eListPromotions\x12\".reservation.ListPromotionsRequest\x1a#.reservation.ListPromotionsResponse\"\x00\x12j\n" +
+	"\x13DeactivatePromotion\x12'.reservation.DeactivatePromotionRequest\x1a(.reservation.DeactivatePromotionResponse\"\x00\x12\x8e\x01\n" +
 	"\x1fGetReservationByStripeSessionID\x123.reservation.GetReservationByStripeSessionIDRequest\x1a4.reservation.GetReservationByStripeSessionIDResponse\"\x00\x12X\n" +
-	"\rGetEventSeats\x12!.reservation.GetEventSeatsRequest\x1a\".reservation.GetEventSeatsResponse\"\x00BRZPgithub.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation;reservationpbb\x06proto3"
+	"\rGetEventSeats\x12!.reservation.GetEventSeatsRequest\x1a\".reservation.GetEventSeatsResponse\"\x00\x12[\n" +
+	"\x0eGetZonePricing\x12\".reservation.GetZonePricingRequest\x1a#.reservation.GetZonePricingResponse\"\x00BRZPgithub.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation;reservationpbb\x06proto3"
 
 var (
 	file_reservation_reservation_proto_rawDescOnce sync.Once
@@ -1204,54 +5782,211 @@ func file_reservation_reservation_proto_rawDescGZIP() []byte {
 	return file_reservation_reservation_proto_rawDescData
 }
 
-var file_reservation_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_reservation_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_reservation_reservation_proto_goTypes = []any{
 	(*Empty)(nil),                                   // 0: reservation.Empty
 	(*Seat)(nil),                                    // 1: reservation.Seat
-	(*Reservation)(nil),                             // 2: reservation.Reservation
-	(*CreateReservationSeatRequest)(nil),            // 3: reservation.CreateReservationSeatRequest
-	(*CreateReservationRequest)(nil),                // 4: reservation.CreateReservationRequest
-	(*DeleteReservationRequest)(nil),                // 5: reservation.DeleteReservationRequest
-	(*ListReservationRequest)(nil),                  // 6: reservation.ListReservationRequest
-	(*GetReservationRequest)(nil),                   // 7: reservation.GetReservationRequest
-	(*GetReservationByStripeSessionIDRequest)(nil),  // 8: reservation.GetReservationByStripeSessionIDRequest
-	(*ConfirmReservationRequest)(nil),               // 9: reservation.ConfirmReservationRequest
-	(*CreateReservationResponse)(nil),               // 10: reservation.CreateReservationResponse
-	(*DeleteReservationResponse)(nil),               // 11: reservation.DeleteReservationResponse
-	(*ListReservationResponse)(nil),                 // 12: reservation.ListReservationResponse
-	(*GetReservationResponse)(nil),                  // 13: reservation.GetReservationResponse
-	(*ConfirmReservationResponse)(nil),              // 14: reservation.ConfirmReservationResponse
-	(*GetReservationByStripeSessionIDResponse)(nil), // 15: reservation.GetReservationByStripeSessionIDResponse
-	(*GetEventSeatsRequest)(nil),                    // 16: reservation.GetEventSeatsRequest
-	(*SeatStatus)(nil),                              // 17: reservation.SeatStatus
-	(*GetEventSeatsResponse)(nil),                   // 18: reservation.GetEventSeatsResponse
+	(*TicketHistory)(nil),                           // 2: reservation.TicketHistory
+	(*Reservation)(nil),                             // 3: reservation.Reservation
+	(*CreateReservationSeatRequest)(nil),            // 4: reservation.CreateReservationSeatRequest
+	(*CreateReservationRequest)(nil),                // 5: reservation.CreateReservationRequest
+	(*GeneralAdmissionRequest)(nil),                 // 6: reservation.GeneralAdmissionRequest
+	(*DeleteReservationRequest)(nil),                // 7: reservation.DeleteReservationRequest
+	(*ListReservationRequest)(nil),                  // 8: reservation.ListReservationRequest
+	(*GetReservationRequest)(nil),                   // 9: reservation.GetReservationRequest
+	(*GetReservationByStripeSessionIDRequest)(nil),  // 10: reservation.GetReservationByStripeSessionIDRequest
+	(*ConfirmReservationRequest)(nil),               // 11: reservation.ConfirmReservationRequest
+	(*ReserveBestAvailableRequest)(nil),             // 12: reservation.ReserveBestAvailableRequest
+	(*CreateReservationResponse)(nil),               // 13: reservation.CreateReservationResponse
+	(*ReserveBestAvailableResponse)(nil),            // 14: reservation.ReserveBestAvailableResponse
+	(*DeleteReservationResponse)(nil),               // 15: reservation.DeleteReservationResponse
+	(*ListReservationResponse)(nil),                 // 16: reservation.ListReservationResponse
+	(*GetReservationResponse)(nil),                  // 17: reservation.GetReservationResponse
+	(*ConfirmReservationResponse)(nil),              // 18: reservation.ConfirmReservationResponse
+	(*ExtendReservationRequest)(nil),                // 19: reservation.ExtendReservationRequest
+	(*ExtendReservationResponse)(nil),               // 20: reservation.ExtendReservationResponse
+	(*RefundReservationRequest)(nil),                // 21: reservation.RefundReservationRequest
+	(*RefundReservationResponse)(nil),               // 22: reservation.RefundReservationResponse
+	(*CancelTicketsRequest)(nil),                    // 23: reservation.CancelTicketsRequest
+	(*CancelTicketsResponse)(nil),                   // 24: reservation.CancelTicketsResponse
+	(*Ticket)(nil),                                  // 25: reservation.Ticket
+	(*TicketTransfer)(nil),                          // 26: reservation.TicketTransfer
+	(*ListTicketsRequest)(nil),                      // 27: reservation.ListTicketsRequest
+	(*ListTicketsResponse)(nil),                     // 28: reservation.ListTicketsResponse
+	(*OfferTicketTransferRequest)(nil),              // 29: reservation.OfferTicketTransferRequest
+	(*OfferTicketTransferResponse)(nil),             // 30: reservation.OfferTicketTransferResponse
+	(*AcceptTicketTransferRequest)(nil),             // 31: reservation.AcceptTicketTransferRequest
+	(*AcceptTicketTransferResponse)(nil),            // 32: reservation.AcceptTicketTransferResponse
+	(*ListTicketTransfersRequest)(nil),              // 33: reservation.ListTicketTransfersRequest
+	(*ListTicketTransfersResponse)(nil),             // 34: reservation.ListTicketTransfersResponse
+	(*CheckInTicketRequest)(nil),                    // 35: reservation.CheckInTicketRequest
+	(*CheckInTicketResponse)(nil),                   // 36: reservation.CheckInTicketResponse
+	(*GetScanBundleRequest)(nil),                    // 37: reservation.GetScanBundleRequest
+	(*ScanBundleTicket)(nil),                        // 38: reservation.ScanBundleTicket
+	(*GetScanBundleResponse)(nil),                   // 39: reservation.GetScanBundleResponse
+	(*OfflineScan)(nil),                             // 40: reservation.OfflineScan
+	(*UploadScansRequest)(nil),                      // 41: reservation.UploadScansRequest
+	(*ScanResult)(nil),                              // 42: reservation.ScanResult
+	(*ScanConflict)(nil),                            // 43: reservation.ScanConflict
+	(*UploadScansResponse)(nil),                     // 44: reservation.UploadScansResponse
+	(*WaitlistEntry)(nil),                           // 45: reservation.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),                     // 46: reservation.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),                    // 47: reservation.JoinWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),                    // 48: reservation.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),                   // 49: reservation.LeaveWaitlistResponse
+	(*ListWaitlistEntriesRequest)(nil),              // 50: reservation.ListWaitlistEntriesRequest
+	(*ListWaitlistEntriesResponse)(nil),             // 51: reservation.ListWaitlistEntriesResponse
+	(*BallotApplication)(nil),                       // 52: reservation.BallotApplication
+	(*ApplyBallotRequest)(nil),                      // 53: reservation.ApplyBallotRequest
+	(*ApplyBallotResponse)(nil),                     // 54: reservation.ApplyBallotResponse
+	(*WithdrawBallotApplicationRequest)(nil),        // 55: reservation.WithdrawBallotApplicationRequest
+	(*WithdrawBallotApplicationResponse)(nil),       // 56: reservation.WithdrawBallotApplicationResponse
+	(*ListBallotApplicationsRequest)(nil),           // 57: reservation.ListBallotApplicationsRequest
+	(*ListBallotApplicationsResponse)(nil),          // 58: reservation.ListBallotApplicationsResponse
+	(*GetBallotDrawRequest)(nil),                    // 59: reservation.GetBallotDrawRequest
+	(*GetBallotDrawResponse)(nil),                   // 60: reservation.GetBallotDrawResponse
+	(*Promotion)(nil),                               // 61: reservation.Promotion
+	(*PriceBreakdown)(nil),                          // 62: reservation.PriceBreakdown
+	(*AppliedPromotion)(nil),                        // 63: reservation.AppliedPromotion
+	(*LineItem)(nil),                                // 64: reservation.LineItem
+	(*CreatePromotionRequest)(nil),                  // 65: reservation.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),                 // 66: reservation.CreatePromotionResponse
+	(*ListPromotionsRequest)(nil),                   // 67: reservation.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),                  // 68: reservation.ListPromotionsResponse
+	(*DeactivatePromotionRequest)(nil),              // 69: reservation.DeactivatePromotionRequest
+	(*DeactivatePromotionResponse)(nil),             // 70: reservation.DeactivatePromotionResponse
+	(*GetReservationByStripeSessionIDResponse)(nil), // 71: reservation.GetReservationByStripeSessionIDResponse
+	(*GetEventSeatsRequest)(nil),                    // 72: reservation.GetEventSeatsRequest
+	(*SeatStatus)(nil),                              // 73: reservation.SeatStatus
+	(*GeneralAdmissionStatus)(nil),                  // 74: reservation.GeneralAdmissionStatus
+	(*GetEventSeatsResponse)(nil),                   // 75: reservation.GetEventSeatsResponse
+	(*GetZonePricingRequest)(nil),                   // 76: reservation.GetZonePricingRequest
+	(*ZonePriceTier)(nil),                           // 77: reservation.ZonePriceTier
+	(*ZonePricing)(nil),                             // 78: reservation.ZonePricing
+	(*GetZonePricingResponse)(nil),                  // 79: reservation.GetZonePricingResponse
+	(*SeatConflict)(nil),                            // 80: reservation.SeatConflict
+	(*TicketLimitExceeded)(nil),                     // 81: reservation.TicketLimitExceeded
+	(*money.Money)(nil),                             // 82: money.Money
 }
 var file_reservation_reservation_proto_depIdxs = []int32{
-	1,  // 0: reservation.Reservation.seats:type_name -> reservation.Seat
-	3,  // 1: reservation.CreateReservationRequest.seats:type_name -> reservation.CreateReservationSeatRequest
-	2,  // 2: reservation.ListReservationResponse.reservation:type_name -> reservation.Reservation
-	1,  // 3: reservation.GetReservationResponse.seats:type_name -> reservation.Seat
-	1,  // 4: reservation.GetReservationByStripeSessionIDResponse.seats:type_name -> reservation.Seat
-	17, // 5: reservation.GetEventSeatsResponse.seats:type_name -> reservation.SeatStatus
-	4,  // 6: reservation.ReservationService.CreateReservation:input_type -> reservation.CreateReservationRequest
-	5,  // 7: reservation.ReservationService.DeleteReservation:input_type -> reservation.DeleteReservationRequest
-	6,  // 8: reservation.ReservationService.ListReservation:input_type -> reservation.ListReservationRequest
-	7,  // 9: reservation.ReservationService.GetReservation:input_type -> reservation.GetReservationRequest
-	9,  // 10: reservation.ReservationService.ConfirmReservation:input_type -> reservation.ConfirmReservationRequest
-	8,  // 11: reservation.ReservationService.GetReservationByStripeSessionID:input_type -> reservation.GetReservationByStripeSessionIDRequest
-	16, // 12: reservation.ReservationService.GetEventSeats:input_type -> reservation.GetEventSeatsRequest
-	10, // 13: reservation.ReservationService.CreateReservation:output_type -> reservation.CreateReservationResponse
-	11, // 14: reservation.ReservationService.DeleteReservation:output_type -> reservation.DeleteReservationResponse
-	12, // 15: reservation.ReservationService.ListReservation:output_type -> reservation.ListReservationResponse
-	13, // 16: reservation.ReservationService.GetReservation:output_type -> reservation.GetReservationResponse
-	14, // 17: reservation.ReservationService.ConfirmReservation:output_type -> reservation.ConfirmReservationResponse
-	15, // 18: reservation.ReservationService.GetReservationByStripeSessionID:output_type -> reservation.GetReservationByStripeSessionIDResponse
-	18, // 19: reservation.ReservationService.GetEventSeats:output_type -> reservation.GetEventSeatsResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	82, // 0: reservation.Seat.price:type_name -> money.Money
+	82, // 1: reservation.TicketHistory.refunded_amount:type_name -> money.Money
+	82, // 2: reservation.Reservation.total_price:type_name -> money.Money
+	1,  // 3: reservation.Reservation.seats:type_name -> reservation.Seat
+	62, // 4: reservation.Reservation.breakdown:type_name -> reservation.PriceBreakdown
+	4,  // 5: reservation.CreateReservationRequest.seats:type_name -> reservation.CreateReservationSeatRequest
+	6,  // 6: reservation.CreateReservationRequest.general_admission:type_name -> reservation.GeneralAdmissionRequest
+	4,  // 7: reservation.ReserveBestAvailableResponse.seats:type_name -> reservation.CreateReservationSeatRequest
+	3,  // 8: reservation.ListReservationResponse.reservation:type_name -> reservation.Reservation
+	82, // 9: reservation.GetReservationResponse.total_price:type_name -> money.Money
+	1,  // 10: reservation.GetReservationResponse.seats:type_name -> reservation.Seat
+	2,  // 11: reservation.GetReservationResponse.history:type_name -> reservation.TicketHistory
+	63, // 12: reservation.GetReservationResponse.promotions:type_name -> reservation.AppliedPromotion
+	64, // 13: reservation.GetReservationResponse.line_items:type_name -> reservation.LineItem
+	62, // 14: reservation.GetReservationResponse.breakdown:type_name -> reservation.PriceBreakdown
+	82, // 15: reservation.RefundReservationResponse.refunded_amount:type_name -> money.Money
+	82, // 16: reservation.CancelTicketsResponse.refunded_amount:type_name -> money.Money
+	82, // 17: reservation.CancelTicketsResponse.total_price:type_name -> money.Money
+	25, // 18: reservation.ListTicketsResponse.tickets:type_name -> reservation.Ticket
+	26, // 19: reservation.OfferTicketTransferResponse.transfer:type_name -> reservation.TicketTransfer
+	26, // 20: reservation.AcceptTicketTransferResponse.transfer:type_name -> reservation.TicketTransfer
+	25, // 21: reservation.AcceptTicketTransferResponse.ticket:type_name -> reservation.Ticket
+	26, // 22: reservation.ListTicketTransfersResponse.transfers:type_name -> reservation.TicketTransfer
+	38, // 23: reservation.GetScanBundleResponse.tickets:type_name -> reservation.ScanBundleTicket
+	40, // 24: reservation.UploadScansRequest.scans:type_name -> reservation.OfflineScan
+	42, // 25: reservation.UploadScansResponse.results:type_name -> reservation.ScanResult
+	43, // 26: reservation.UploadScansResponse.conflicts:type_name -> reservation.ScanConflict
+	45, // 27: reservation.JoinWaitlistResponse.entry:type_name -> reservation.WaitlistEntry
+	45, // 28: reservation.ListWaitlistEntriesResponse.entries:type_name -> reservation.WaitlistEntry
+	52, // 29: reservation.ApplyBallotResponse.application:type_name -> reservation.BallotApplication
+	52, // 30: reservation.ListBallotApplicationsResponse.applications:type_name -> reservation.BallotApplication
+	82, // 31: reservation.Promotion.amount_off:type_name -> money.Money
+	82, // 32: reservation.PriceBreakdown.subtotal:type_name -> money.Money
+	82, // 33: reservation.PriceBreakdown.discount:type_name -> money.Money
+	82, // 34: reservation.PriceBreakdown.booking_fee:type_name -> money.Money
+	82, // 35: reservation.PriceBreakdown.processing_fee:type_name -> money.Money
+	82, // 36: reservation.PriceBreakdown.tax:type_name -> money.Money
+	82, // 37: reservation.PriceBreakdown.total:type_name -> money.Money
+	82, // 38: reservation.AppliedPromotion.amount:type_name -> money.Money
+	82, // 39: reservation.LineItem.unit_amount:type_name -> money.Money
+	82, // 40: reservation.LineItem.amount:type_name -> money.Money
+	82, // 41: reservation.CreatePromotionRequest.amount_off:type_name -> money.Money
+	61, // 42: reservation.CreatePromotionResponse.promotion:type_name -> reservation.Promotion
+	61, // 43: reservation.ListPromotionsResponse.promotions:type_name -> reservation.Promotion
+	82, // 44: reservation.GetReservationByStripeSessionIDResponse.total_price:type_name -> money.Money
+	1,  // 45: reservation.GetReservationByStripeSessionIDResponse.seats:type_name -> reservation.Seat
+	62, // 46: reservation.GetReservationByStripeSessionIDResponse.breakdown:type_name -> reservation.PriceBreakdown
+	73, // 47: reservation.GetEventSeatsResponse.seats:type_name -> reservation.SeatStatus
+	74, // 48: reservation.GetEventSeatsResponse.general_admission:type_name -> reservation.GeneralAdmissionStatus
+	82, // 49: reservation.ZonePriceTier.price:type_name -> money.Money
+	82, // 50: reservation.ZonePricing.price:type_name -> money.Money
+	77, // 51: reservation.ZonePricing.current_tier:type_name -> reservation.ZonePriceTier
+	77, // 52: reservation.ZonePricing.next_tier:type_name -> reservation.ZonePriceTier
+	78, // 53: reservation.GetZonePricingResponse.zones:type_name -> reservation.ZonePricing
+	4,  // 54: reservation.SeatConflict.seats:type_name -> reservation.CreateReservationSeatRequest
+	5,  // 55: reservation.ReservationService.CreateReservation:input_type -> reservation.CreateReservationRequest
+	12, // 56: reservation.ReservationService.ReserveBestAvailable:input_type -> reservation.ReserveBestAvailableRequest
+	7,  // 57: reservation.ReservationService.DeleteReservation:input_type -> reservation.DeleteReservationRequest
+	8,  // 58: reservation.ReservationService.ListReservation:input_type -> reservation.ListReservationRequest
+	9,  // 59: reservation.ReservationService.GetReservation:input_type -> reservation.GetReservationRequest
+	11, // 60: reservation.ReservationService.ConfirmReservation:input_type -> reservation.ConfirmReservationRequest
+	19, // 61: reservation.ReservationService.ExtendReservation:input_type -> reservation.ExtendReservationRequest
+	21, // 62: reservation.ReservationService.RefundReservation:input_type -> reservation.RefundReservationRequest
+	23, // 63: reservation.ReservationService.CancelTickets:input_type -> reservation.CancelTicketsRequest
+	27, // 64: reservation.ReservationService.ListTickets:input_type -> reservation.ListTicketsRequest
+	29, // 65: reservation.ReservationService.OfferTicketTransfer:input_type -> reservation.OfferTicketTransferRequest
+	31, // 66: reservation.ReservationService.AcceptTicketTransfer:input_type -> reservation.AcceptTicketTransferRequest
+	33, // 67: reservation.ReservationService.ListTicketTransfers:input_type -> reservation.ListTicketTransfersRequest
+	35, // 68: reservation.ReservationService.CheckInTicket:input_type -> reservation.CheckInTicketRequest
+	37, // 69: reservation.ReservationService.GetScanBundle:input_type -> reservation.GetScanBundleRequest
+	41, // 70: reservation.ReservationService.UploadScans:input_type -> reservation.UploadScansRequest
+	46, // 71: reservation.ReservationService.JoinWaitlist:input_type -> reservation.JoinWaitlistRequest
+	48, // 72: reservation.ReservationService.LeaveWaitlist:input_type -> reservation.LeaveWaitlistRequest
+	50, // 73: reservation.ReservationService.ListWaitlistEntries:input_type -> reservation.ListWaitlistEntriesRequest
+	53, // 74: reservation.ReservationService.ApplyBallot:input_type -> reservation.ApplyBallotRequest
+	55, // 75: reservation.ReservationService.WithdrawBallotApplication:input_type -> reservation.WithdrawBallotApplicationRequest
+	57, // 76: reservation.ReservationService.ListBallotApplications:input_type -> reservation.ListBallotApplicationsRequest
+	59, // 77: reservation.ReservationService.GetBallotDraw:input_type -> reservation.GetBallotDrawRequest
+	65, // 78: reservation.ReservationService.CreatePromotion:input_type -> reservation.CreatePromotionRequest
+	67, // 79: reservation.ReservationService.ListPromotions:input_type -> reservation.ListPromotionsRequest
+	69, // 80: reservation.ReservationService.DeactivatePromotion:input_type -> reservation.DeactivatePromotionRequest
+	10, // 81: reservation.ReservationService.GetReservationByStripeSessionID:input_type -> reservation.GetReservationByStripeSessionIDRequest
+	72, // 82: reservation.ReservationService.GetEventSeats:input_type -> reservation.GetEventSeatsRequest
+	76, // 83: reservation.ReservationService.GetZonePricing:input_type -> reservation.GetZonePricingRequest
+	13, // 84: reservation.ReservationService.CreateReservation:output_type -> reservation.CreateReservationResponse
+	14, // 85: reservation.ReservationService.ReserveBestAvailable:output_type -> reservation.ReserveBestAvailableResponse
+	15, // 86: reservation.ReservationService.DeleteReservation:output_type -> reservation.DeleteReservationResponse
+	16, // 87: reservation.ReservationService.ListReservation:output_type -> reservation.ListReservationResponse
+	17, // 88: reservation.ReservationService.GetReservation:output_type -> reservation.GetReservationResponse
+	18, // 89: reservation.ReservationService.ConfirmReservation:output_type -> reservation.ConfirmReservationResponse
+	20, // 90: reservation.ReservationService.ExtendReservation:output_type -> reservation.ExtendReservationResponse
+	22, // 91: reservation.ReservationService.RefundReservation:output_type -> reservation.RefundReservationResponse
+	24, // 92: reservation.ReservationService.CancelTickets:output_type -> reservation.CancelTicketsResponse
+	28, // 93: reservation.ReservationService.ListTickets:output_type -> reservation.ListTicketsResponse
+	30, // 94: reservation.ReservationService.OfferTicketTransfer:output_type -> reservation.OfferTicketTransferResponse
+	32, // 95: reservation.ReservationService.AcceptTicketTransfer:output_type -> reservation.AcceptTicketTransferResponse
+	34, // 96: reservation.ReservationService.ListTicketTransfers:output_type -> reservation.ListTicketTransfersResponse
+	36, // 97: reservation.ReservationService.CheckInTicket:output_type -> reservation.CheckInTicketResponse
+	39, // 98: reservation.ReservationService.GetScanBundle:output_type -> reservation.GetScanBundleResponse
+	44, // 99: reservation.ReservationService.UploadScans:output_type -> reservation.UploadScansResponse
+	47, // 100: reservation.ReservationService.JoinWaitlist:output_type -> reservation.JoinWaitlistResponse
+	49, // 101: reservation.ReservationService.LeaveWaitlist:output_type -> reservation.LeaveWaitlistResponse
+	51, // 102: reservation.ReservationService.ListWaitlistEntries:output_type -> reservation.ListWaitlistEntriesResponse
+	54, // 103: reservation.ReservationService.ApplyBallot:output_type -> reservation.ApplyBallotResponse
+	56, // 104: reservation.ReservationService.WithdrawBallotApplication:output_type -> reservation.WithdrawBallotApplicationResponse
+	58, // 105: reservation.ReservationService.ListBallotApplications:output_type -> reservation.ListBallotApplicationsResponse
+	60, // 106: reservation.ReservationService.GetBallotDraw:output_type -> reservation.GetBallotDrawResponse
+	66, // 107: reservation.ReservationService.CreatePromotion:output_type -> reservation.CreatePromotionResponse
+	68, // 108: reservation.ReservationService.ListPromotions:output_type -> reservation.ListPromotionsResponse
+	70, // 109: reservation.ReservationService.DeactivatePromotion:output_type -> reservation.DeactivatePromotionResponse
+	71, // 110: reservation.ReservationService.GetReservationByStripeSessionID:output_type -> reservation.GetReservationByStripeSessionIDResponse
+	75, // 111: reservation.ReservationService.GetEventSeats:output_type -> reservation.GetEventSeatsResponse
+	79, // 112: reservation.ReservationService.GetZonePricing:output_type -> reservation.GetZonePricingResponse
+	84, // [84:113] is the sub-list for method output_type
+	55, // [55:84] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_reservation_reservation_proto_init() }
@@ -1259,15 +5994,21 @@ func file_reservation_reservation_proto_init() {
 	if File_reservation_reservation_proto != nil {
 		return
 	}
-	file_reservation_reservation_proto_msgTypes[2].OneofWrappers = []any{}
-	file_reservation_reservation_proto_msgTypes[13].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[3].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[5].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[17].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[19].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[61].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[64].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[65].OneofWrappers = []any{}
+	file_reservation_reservation_proto_msgTypes[77].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reservation_reservation_proto_rawDesc), len(file_reservation_reservation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,12 +20,34 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ReservationService_CreateReservation_FullMethodName               = "/reservation.ReservationService/CreateReservation"
+	ReservationService_ReserveBestAvailable_FullMethodName            = "/reservation.ReservationService/ReserveBestAvailable"
 	ReservationService_DeleteReservation_FullMethodName               = "/reservation.ReservationService/DeleteReservation"
 	ReservationService_ListReservation_FullMethodName                 = "/reservation.ReservationService/ListReservation"
 	ReservationService_GetReservation_FullMethodName                  = "/reservation.ReservationService/GetReservation"
 	ReservationService_ConfirmReservation_FullMethodName              = "/reservation.ReservationService/ConfirmReservation"
+	ReservationService_ExtendReservation_FullMethodName               = "/reservation.ReservationService/ExtendReservation"
+	ReservationService_RefundReservation_FullMethodName               = "/reservation.ReservationService/RefundReservation"
+	ReservationService_CancelTickets_FullMethodName                   = "/reservation.ReservationService/CancelTickets"
+	ReservationService_ListTickets_FullMethodName                     = "/reservation.ReservationService/ListTickets"
+	ReservationService_OfferTicketTransfer_FullMethodName             = "/reservation.ReservationService/OfferTicketTransfer"
+	ReservationService_AcceptTicketTransfer_FullMethodName            = "/reservation.ReservationService/AcceptTicketTransfer"
+	ReservationService_ListTicketTransfers_FullMethodName             = "/reservation.ReservationService/ListTicketTransfers"
+	ReservationService_CheckInTicket_FullMethodName                   = "/reservation.ReservationService/CheckInTicket"
+	ReservationService_GetScanBundle_FullMethodName                   = "/reservation.ReservationService/GetScanBundle"
+	ReservationService_UploadScans_FullMethodName                     = "/reservation.ReservationService/UploadScans"
+	ReservationService_JoinWaitlist_FullMethodName                    = "/reservation.ReservationService/JoinWaitlist"
+	ReservationService_LeaveWaitlist_FullMethodName                   = "/reservation.ReservationService/LeaveWaitlist"
+	ReservationService_ListWaitlistEntries_FullMethodName             = "/reservation.ReservationService/ListWaitlistEntries"
+	ReservationService_ApplyBallot_FullMethodName                     = "/reservation.ReservationService/ApplyBallot"
+	ReservationService_WithdrawBallotApplication_FullMethodName       = "/reservation.ReservationService/WithdrawBallotApplication"
+	ReservationService_ListBallotApplications_FullMethodName          = "/reservation.ReservationService/ListBallotApplications"
+	ReservationService_GetBallotDraw_FullMethodName                   = "/reservation.ReservationService/GetBallotDraw"
+	ReservationService_CreatePromotion_FullMethodName                 = "/reservation.ReservationService/CreatePromotion"
+	ReservationService_ListPromotions_FullMethodName                  = "/reservation.ReservationService/ListPromotions"
+	ReservationService_DeactivatePromotion_FullMethodName             = "/reservation.ReservationService/DeactivatePromotion"
 	ReservationService_GetReservationByStripeSessionID_FullMethodName = "/reservation.ReservationService/GetReservationByStripeSessionID"
 	ReservationService_GetEventSeats_FullMethodName                   = "/reservation.ReservationService/GetEventSeats"
+	ReservationService_GetZonePricing_FullMethodName                  = "/reservation.ReservationService/GetZonePricing"
 )
 
 // ReservationServiceClient is the client API for ReservationService service.
@@ -36,12 +58,34 @@ const (
 type ReservationServiceClient interface {
 	// reservation operations
 	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	ReserveBestAvailable(ctx context.Context, in *ReserveBestAvailableRequest, opts ...grpc.CallOption) (*ReserveBestAvailableResponse, error)
 	DeleteReservation(ctx context.Context, in *DeleteReservationRequest, opts ...grpc.CallOption) (*DeleteReservationResponse, error)
 	ListReservation(ctx context.Context, in *ListReservationRequest, opts ...grpc.CallOption) (*ListReservationResponse, error)
 	GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error)
 	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ConfirmReservationResponse, error)
+	ExtendReservation(ctx context.Context, in *ExtendReservationRequest, opts ...grpc.CallOption) (*ExtendReservationResponse, error)
+	RefundReservation(ctx context.Context, in *RefundReservationRequest, opts ...grpc.CallOption) (*RefundReservationResponse, error)
+	CancelTickets(ctx context.Context, in *CancelTicketsRequest, opts ...grpc.CallOption) (*CancelTicketsResponse, error)
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error)
+	OfferTicketTransfer(ctx context.Context, in *OfferTicketTransferRequest, opts ...grpc.CallOption) (*OfferTicketTransferResponse, error)
+	AcceptTicketTransfer(ctx context.Context, in *AcceptTicketTransferRequest, opts ...grpc.CallOption) (*AcceptTicketTransferResponse, error)
+	ListTicketTransfers(ctx context.Context, in *ListTicketTransfersRequest, opts ...grpc.CallOption) (*ListTicketTransfersResponse, error)
+	CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...grpc.CallOption) (*CheckInTicketResponse, error)
+	GetScanBundle(ctx context.Context, in *GetScanBundleRequest, opts ...grpc.CallOption) (*GetScanBundleResponse, error)
+	UploadScans(ctx context.Context, in *UploadScansRequest, opts ...grpc.CallOption) (*UploadScansResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error)
+	ApplyBallot(ctx context.Context, in *ApplyBallotRequest, opts ...grpc.CallOption) (*ApplyBallotResponse, error)
+	WithdrawBallotApplication(ctx context.Context, in *WithdrawBallotApplicationRequest, opts ...grpc.CallOption) (*WithdrawBallotApplicationResponse, error)
+	ListBallotApplications(ctx context.Context, in *ListBallotApplicationsRequest, opts ...grpc.CallOption) (*ListBallotApplicationsResponse, error)
+	GetBallotDraw(ctx context.Context, in *GetBallotDrawRequest, opts ...grpc.CallOption) (*GetBallotDrawResponse, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error)
 	GetReservationByStripeSessionID(ctx context.Context, in *GetReservationByStripeSessionIDRequest, opts ...grpc.CallOption) (*GetReservationByStripeSessionIDResponse, error)
 	GetEventSeats(ctx context.Context, in *GetEventSeatsRequest, opts ...grpc.CallOption) (*GetEventSeatsResponse, error)
+	GetZonePricing(ctx context.Context, in *GetZonePricingRequest, opts ...grpc.CallOption) (*GetZonePricingResponse, error)
 }
 
 type reservationServiceClient struct {
@@ -62,6 +106,16 @@ func (c *reservationServiceClient) CreateReservation(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *reservationServiceClient) ReserveBestAvailable(ctx context.Context, in *ReserveBestAvailableRequest, opts ...grpc.CallOption) (*ReserveBestAvailableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveBestAvailableResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ReserveBestAvailable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reservationServiceClient) DeleteReservation(ctx context.Context, in *DeleteReservationRequest, opts ...grpc.CallOption) (*DeleteReservationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteReservationResponse)
@@ -102,6 +156,206 @@ func (c *reservationServiceClient) ConfirmReservation(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *reservationServiceClient) ExtendReservation(ctx context.Context, in *ExtendReservationRequest, opts ...grpc.CallOption) (*ExtendReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendReservationResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ExtendReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) RefundReservation(ctx context.Context, in *RefundReservationRequest, opts ...grpc.CallOption) (*RefundReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundReservationResponse)
+	err := c.cc.Invoke(ctx, ReservationService_RefundReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) CancelTickets(ctx context.Context, in *CancelTicketsRequest, opts ...grpc.CallOption) (*CancelTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTicketsResponse)
+	err := c.cc.Invoke(ctx, ReservationService_CancelTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) OfferTicketTransfer(ctx context.Context, in *OfferTicketTransferRequest, opts ...grpc.CallOption) (*OfferTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferTicketTransferResponse)
+	err := c.cc.Invoke(ctx, ReservationService_OfferTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) AcceptTicketTransfer(ctx context.Context, in *AcceptTicketTransferRequest, opts ...grpc.CallOption) (*AcceptTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTicketTransferResponse)
+	err := c.cc.Invoke(ctx, ReservationService_AcceptTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListTicketTransfers(ctx context.Context, in *ListTicketTransfersRequest, opts ...grpc.CallOption) (*ListTicketTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketTransfersResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListTicketTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...grpc.CallOption) (*CheckInTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInTicketResponse)
+	err := c.cc.Invoke(ctx, ReservationService_CheckInTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) GetScanBundle(ctx context.Context, in *GetScanBundleRequest, opts ...grpc.CallOption) (*GetScanBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScanBundleResponse)
+	err := c.cc.Invoke(ctx, ReservationService_GetScanBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) UploadScans(ctx context.Context, in *UploadScansRequest, opts ...grpc.CallOption) (*UploadScansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadScansResponse)
+	err := c.cc.Invoke(ctx, ReservationService_UploadScans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, ReservationService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, ReservationService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWaitlistEntriesResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListWaitlistEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ApplyBallot(ctx context.Context, in *ApplyBallotRequest, opts ...grpc.CallOption) (*ApplyBallotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyBallotResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ApplyBallot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) WithdrawBallotApplication(ctx context.Context, in *WithdrawBallotApplicationRequest, opts ...grpc.CallOption) (*WithdrawBallotApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawBallotApplicationResponse)
+	err := c.cc.Invoke(ctx, ReservationService_WithdrawBallotApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListBallotApplications(ctx context.Context, in *ListBallotApplicationsRequest, opts ...grpc.CallOption) (*ListBallotApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBallotApplicationsResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListBallotApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) GetBallotDraw(ctx context.Context, in *GetBallotDrawRequest, opts ...grpc.CallOption) (*GetBallotDrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBallotDrawResponse)
+	err := c.cc.Invoke(ctx, ReservationService_GetBallotDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, ReservationService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePromotionResponse)
+	err := c.cc.Invoke(ctx, ReservationService_DeactivatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reservationServiceClient) GetReservationByStripeSessionID(ctx context.Context, in *GetReservationByStripeSessionIDRequest, opts ...grpc.CallOption) (*GetReservationByStripeSessionIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetReservationByStripeSessionIDResponse)
@@ -122,6 +376,16 @@ func (c *reservationServiceClient) GetEventSeats(ctx context.Context, in *GetEve
 	return out, nil
 }
 
+func (c *reservationServiceClient) GetZonePricing(ctx context.Context, in *GetZonePricingRequest, opts ...grpc.CallOption) (*GetZonePricingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetZonePricingResponse)
+	err := c.cc.Invoke(ctx, ReservationService_GetZonePricing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReservationServiceServer is the server API for ReservationService service.
 // All implementations must embed UnimplementedReservationServiceServer
 // for forward compatibility.
@@ -130,12 +394,34 @@ func (c *reservationServiceClient) GetEventSeats(ctx context.Context, in *GetEve
 type ReservationServiceServer interface {
 	// reservation operations
 	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	ReserveBestAvailable(context.Context, *ReserveBestAvailableRequest) (*ReserveBestAvailableResponse, error)
 	DeleteReservation(context.Context, *DeleteReservationRequest) (*DeleteReservationResponse, error)
 	ListReservation(context.Context, *ListReservationRequest) (*ListReservationResponse, error)
 	GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error)
 	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error)
+	ExtendReservation(context.Context, *ExtendReservationRequest) (*ExtendReservationResponse, error)
+	RefundReservation(context.Context, *RefundReservationRequest) (*RefundReservationResponse, error)
+	CancelTickets(context.Context, *CancelTicketsRequest) (*CancelTicketsResponse, error)
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error)
+	OfferTicketTransfer(context.Context, *OfferTicketTransferRequest) (*OfferTicketTransferResponse, error)
+	AcceptTicketTransfer(context.Context, *AcceptTicketTransferRequest) (*AcceptTicketTransferResponse, error)
+	ListTicketTransfers(context.Context, *ListTicketTransfersRequest) (*ListTicketTransfersResponse, error)
+	CheckInTicket(context.Context, *CheckInTicketRequest) (*CheckInTicketResponse, error)
+	GetScanBundle(context.Context, *GetScanBundleRequest) (*GetScanBundleResponse, error)
+	UploadScans(context.Context, *UploadScansRequest) (*UploadScansResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error)
+	ApplyBallot(context.Context, *ApplyBallotRequest) (*ApplyBallotResponse, error)
+	WithdrawBallotApplication(context.Context, *WithdrawBallotApplicationRequest) (*WithdrawBallotApplicationResponse, error)
+	ListBallotApplications(context.Context, *ListBallotApplicationsRequest) (*ListBallotApplicationsResponse, error)
+	GetBallotDraw(context.Context, *GetBallotDrawRequest) (*GetBallotDrawResponse, error)
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error)
 	GetReservationByStripeSessionID(context.Context, *GetReservationByStripeSessionIDRequest) (*GetReservationByStripeSessionIDResponse, error)
 	GetEventSeats(context.Context, *GetEventSeatsRequest) (*GetEventSeatsResponse, error)
+	GetZonePricing(context.Context, *GetZonePricingRequest) (*GetZonePricingResponse, error)
 	mustEmbedUnimplementedReservationServiceServer()
 }
 
@@ -149,6 +435,9 @@ type UnimplementedReservationServiceServer struct{}
 func (UnimplementedReservationServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
 }
+func (UnimplementedReservationServiceServer) ReserveBestAvailable(context.Context, *ReserveBestAvailableRequest) (*ReserveBestAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveBestAvailable not implemented")
+}
 func (UnimplementedReservationServiceServer) DeleteReservation(context.Context, *DeleteReservationRequest) (*DeleteReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteReservation not implemented")
 }
@@ -161,12 +450,75 @@ func (UnimplementedReservationServiceServer) GetReservation(context.Context, *Ge
 func (UnimplementedReservationServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
 }
+func (UnimplementedReservationServiceServer) ExtendReservation(context.Context, *ExtendReservationRequest) (*ExtendReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendReservation not implemented")
+}
+func (UnimplementedReservationServiceServer) RefundReservation(context.Context, *RefundReservationRequest) (*RefundReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundReservation not implemented")
+}
+func (UnimplementedReservationServiceServer) CancelTickets(context.Context, *CancelTicketsRequest) (*CancelTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTickets not implemented")
+}
+func (UnimplementedReservationServiceServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedReservationServiceServer) OfferTicketTransfer(context.Context, *OfferTicketTransferRequest) (*OfferTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferTicketTransfer not implemented")
+}
+func (UnimplementedReservationServiceServer) AcceptTicketTransfer(context.Context, *AcceptTicketTransferRequest) (*AcceptTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTicketTransfer not implemented")
+}
+func (UnimplementedReservationServiceServer) ListTicketTransfers(context.Context, *ListTicketTransfersRequest) (*ListTicketTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketTransfers not implemented")
+}
+func (UnimplementedReservationServiceServer) CheckInTicket(context.Context, *CheckInTicketRequest) (*CheckInTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInTicket not implemented")
+}
+func (UnimplementedReservationServiceServer) GetScanBundle(context.Context, *GetScanBundleRequest) (*GetScanBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScanBundle not implemented")
+}
+func (UnimplementedReservationServiceServer) UploadScans(context.Context, *UploadScansRequest) (*UploadScansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadScans not implemented")
+}
+func (UnimplementedReservationServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedReservationServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedReservationServiceServer) ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitlistEntries not implemented")
+}
+func (UnimplementedReservationServiceServer) ApplyBallot(context.Context, *ApplyBallotRequest) (*ApplyBallotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBallot not implemented")
+}
+func (UnimplementedReservationServiceServer) WithdrawBallotApplication(context.Context, *WithdrawBallotApplicationRequest) (*WithdrawBallotApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBallotApplication not implemented")
+}
+func (UnimplementedReservationServiceServer) ListBallotApplications(context.Context, *ListBallotApplicationsRequest) (*ListBallotApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBallotApplications not implemented")
+}
+func (UnimplementedReservationServiceServer) GetBallotDraw(context.Context, *GetBallotDrawRequest) (*GetBallotDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBallotDraw not implemented")
+}
+func (UnimplementedReservationServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedReservationServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedReservationServiceServer) DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromotion not implemented")
+}
 func (UnimplementedReservationServiceServer) GetReservationByStripeSessionID(context.Context, *GetReservationByStripeSessionIDRequest) (*GetReservationByStripeSessionIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReservationByStripeSessionID not implemented")
 }
 func (UnimplementedReservationServiceServer) GetEventSeats(context.Context, *GetEventSeatsRequest) (*GetEventSeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventSeats not implemented")
 }
+func (UnimplementedReservationServiceServer) GetZonePricing(context.Context, *GetZonePricingRequest) (*GetZonePricingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetZonePricing not implemented")
+}
 func (UnimplementedReservationServiceServer) mustEmbedUnimplementedReservationServiceServer() {}
 func (UnimplementedReservationServiceServer) testEmbeddedByValue()                            {}
 
@@ -206,6 +558,24 @@ func _ReservationService_CreateReservation_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReservationService_ReserveBestAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveBestAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ReserveBestAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ReserveBestAvailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ReserveBestAvailable(ctx, req.(*ReserveBestAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReservationService_DeleteReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteReservationRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +648,366 @@ func _ReservationService_ConfirmReservation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReservationService_ExtendReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ExtendReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ExtendReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ExtendReservation(ctx, req.(*ExtendReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_RefundReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).RefundReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_RefundReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).RefundReservation(ctx, req.(*RefundReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_CancelTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).CancelTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_CancelTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).CancelTickets(ctx, req.(*CancelTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_OfferTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).OfferTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_OfferTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).OfferTicketTransfer(ctx, req.(*OfferTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_AcceptTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).AcceptTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_AcceptTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).AcceptTicketTransfer(ctx, req.(*AcceptTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ListTicketTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListTicketTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListTicketTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListTicketTransfers(ctx, req.(*ListTicketTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_CheckInTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).CheckInTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_CheckInTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).CheckInTicket(ctx, req.(*CheckInTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_GetScanBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).GetScanBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_GetScanBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).GetScanBundle(ctx, req.(*GetScanBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_UploadScans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadScansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).UploadScans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_UploadScans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).UploadScans(ctx, req.(*UploadScansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ListWaitlistEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListWaitlistEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListWaitlistEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListWaitlistEntries(ctx, req.(*ListWaitlistEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ApplyBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBallotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ApplyBallot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ApplyBallot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ApplyBallot(ctx, req.(*ApplyBallotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_WithdrawBallotApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawBallotApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).WithdrawBallotApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_WithdrawBallotApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).WithdrawBallotApplication(ctx, req.(*WithdrawBallotApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ListBallotApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBallotApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListBallotApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListBallotApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListBallotApplications(ctx, req.(*ListBallotApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_GetBallotDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).GetBallotDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_GetBallotDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).GetBallotDraw(ctx, req.(*GetBallotDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_DeactivatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).DeactivatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_DeactivatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).DeactivatePromotion(ctx, req.(*DeactivatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReservationService_GetReservationByStripeSessionID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReservationByStripeSessionIDRequest)
 	if err := dec(in); err != nil {
@@ -314,6 +1044,24 @@ func _ReservationService_GetEventSeats_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReservationService_GetZonePricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetZonePricingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).GetZonePricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_GetZonePricing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).GetZonePricing(ctx, req.(*GetZonePricingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReservationService_ServiceDesc is the grpc.ServiceDesc for ReservationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +1073,10 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateReservation",
 			Handler:    _ReservationService_CreateReservation_Handler,
 		},
+		{
+			MethodName: "ReserveBestAvailable",
+			Handler:    _ReservationService_ReserveBestAvailable_Handler,
+		},
 		{
 			MethodName: "DeleteReservation",
 			Handler:    _ReservationService_DeleteReservation_Handler,
@@ -341,6 +1093,86 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmReservation",
 			Handler:    _ReservationService_ConfirmReservation_Handler,
 		},
+		{
+			MethodName: "ExtendReservation",
+			Handler:    _ReservationService_ExtendReservation_Handler,
+		},
+		{
+			MethodName: "RefundReservation",
+			Handler:    _ReservationService_RefundReservation_Handler,
+		},
+		{
+			MethodName: "CancelTickets",
+			Handler:    _ReservationService_CancelTickets_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _ReservationService_ListTickets_Handler,
+		},
+		{
+			MethodName: "OfferTicketTransfer",
+			Handler:    _ReservationService_OfferTicketTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTicketTransfer",
+			Handler:    _ReservationService_AcceptTicketTransfer_Handler,
+		},
+		{
+			MethodName: "ListTicketTransfers",
+			Handler:    _ReservationService_ListTicketTransfers_Handler,
+		},
+		{
+			MethodName: "CheckInTicket",
+			Handler:    _ReservationService_CheckInTicket_Handler,
+		},
+		{
+			MethodName: "GetScanBundle",
+			Handler:    _ReservationService_GetScanBundle_Handler,
+		},
+		{
+			MethodName: "UploadScans",
+			Handler:    _ReservationService_UploadScans_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _ReservationService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _ReservationService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ListWaitlistEntries",
+			Handler:    _ReservationService_ListWaitlistEntries_Handler,
+		},
+		{
+			MethodName: "ApplyBallot",
+			Handler:    _ReservationService_ApplyBallot_Handler,
+		},
+		{
+			MethodName: "WithdrawBallotApplication",
+			Handler:    _ReservationService_WithdrawBallotApplication_Handler,
+		},
+		{
+			MethodName: "ListBallotApplications",
+			Handler:    _ReservationService_ListBallotApplications_Handler,
+		},
+		{
+			MethodName: "GetBallotDraw",
+			Handler:    _ReservationService_GetBallotDraw_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _ReservationService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _ReservationService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "DeactivatePromotion",
+			Handler:    _ReservationService_DeactivatePromotion_Handler,
+		},
 		{
 			MethodName: "GetReservationByStripeSessionID",
 			Handler:    _ReservationService_GetReservationByStripeSessionID_Handler,
@@ -349,6 +1181,10 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventSeats",
 			Handler:    _ReservationService_GetEventSeats_Handler,
 		},
+		{
+			MethodName: "GetZonePricing",
+			Handler:    _ReservationService_GetZonePricing_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reservation/reservation.proto",
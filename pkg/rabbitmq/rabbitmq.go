@@ -1,11 +1,18 @@
 package rabbitmq
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/streadway/amqp"
 )
 
+// HOW LONG A PUBLISH WAITS FOR THE BROKER TO CONFIRM IT
+const confirmTimeout = 10 * time.Second
+
 type Config struct {
 	URL string `env:"URL"`
 }
@@ -16,6 +23,11 @@ var RabbitMQClient *RabbitMQ
 type RabbitMQ struct {
 	Conn    *amqp.Connection
 	Channel *amqp.Channel
+
+	// PUBLISHES WAIT FOR THEIR CONFIRMATION ONE AT A TIME, DELIVERY TAGS COUNT THEM FROM 1
+	publishMu     sync.Mutex
+	confirms      chan amqp.Confirmation
+	lastDelivered uint64
 }
 
 // FUNCTION TO CREATE A NEW RABBITMQ CONNECTION AND CHANNEL
@@ -32,10 +44,16 @@ func NewRabbitMQConnection(url string) {
 		log.Fatalf("Failed to open a RabbitMQ channel: %s", err)
 	}
 
+	// PUT THE CHANNEL IN CONFIRM MODE SO A PUBLISH ONLY SUCCEEDS ONCE THE BROKER TOOK THE MESSAGE
+	if err := ch.Confirm(false); err != nil {
+		log.Fatalf("Failed to put the RabbitMQ channel in confirm mode: %s", err)
+	}
+
 	// STORE RABBITMQ CONNECTION AND CHANNEL
 	RabbitMQClient = &RabbitMQ{
-		Conn:    conn,
-		Channel: ch,
+		Conn:     conn,
+		Channel:  ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, 1)),
 	}
 }
 
@@ -77,6 +95,14 @@ func (r *RabbitMQ) ConsumeRabbitMQQueue(queue_name string) (<-chan amqp.Delivery
 }
 
 func (r *RabbitMQ) PublishToQueue(queue_name string, body []byte) error {
+	return r.PublishMessageToQueue(queue_name, "", body)
+}
+
+// FUNCTION TO PUBLISH A MESSAGE WITH AN ID CONSUMERS CAN DEDUPLICATE ON, IT RETURNS ONCE THE BROKER ACKED IT
+func (r *RabbitMQ) PublishMessageToQueue(queue_name string, message_id string, body []byte) error {
+	r.publishMu.Lock()
+	defer r.publishMu.Unlock()
+
 	// DECLARE QUEUE NAME (IF NOT EXISTS)
 	_, err := r.Channel.QueueDeclare(
 		queue_name, // name of the queue
@@ -99,8 +125,10 @@ func (r *RabbitMQ) PublishToQueue(queue_name string, body []byte) error {
 		false,      // mandatory
 		false,      // immediate
 		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        body,
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			MessageId:    message_id,
+			Body:         body,
 		},
 	)
 
@@ -108,8 +136,27 @@ func (r *RabbitMQ) PublishToQueue(queue_name string, body []byte) error {
 		log.Printf("Failed to publish a message to RabbitMQ: %s", err)
 		return err
 	}
-
-	return nil
+	r.lastDelivered++
+
+	// WAIT FOR THE ACK OF THIS PUBLISH, CONFIRMATIONS OF EARLIER ONES THAT TIMED OUT ARE SKIPPED
+	timeout := time.After(confirmTimeout)
+	for {
+		select {
+		case confirm, ok := <-r.confirms:
+			if !ok {
+				return errors.New("rabbitmq channel closed before the publish was confirmed")
+			}
+			if confirm.DeliveryTag < r.lastDelivered {
+				continue
+			}
+			if !confirm.Ack {
+				return fmt.Errorf("rabbitmq nacked message %q", message_id)
+			}
+			return nil
+		case <-timeout:
+			return fmt.Errorf("rabbitmq did not confirm message %q in %s", message_id, confirmTimeout)
+		}
+	}
 }
 
 // FUNCTION TO CLOSE THE RABBITMQ CONNECTION AND CHANNEL
@@ -19,6 +19,7 @@ type AuthDomain interface {
 	RefreshToken(ctx context.Context, token string) (entities.Token, error)
 	Logout(ctx context.Context, userID uuid.UUID) error
 	GetUser(ctx context.Context, userID uuid.UUID) (entities.User, error)
+	GetUserByEmail(ctx context.Context, email string) (entities.User, error)
 	UpdateProfile(ctx context.Context, userID uuid.UUID, firstname, lastname, profileImage string, birthdate time.Time, phone string) (entities.User, error)
 }
 
@@ -159,6 +160,16 @@ func (d *AuthDomainImpl) GetUser(ctx context.Context, userID uuid.UUID) (entitie
 	return user, nil
 }
 
+// GetUserByEmail finds a registered user, errs.ErrNotFound is returned if nobody signed up with the email.
+func (d *AuthDomainImpl) GetUserByEmail(ctx context.Context, email string) (entities.User, error) {
+	user, err := d.repo.GetUserByProviderEmail(ctx, entities.ProviderGoogle, email)
+	if err != nil {
+		return entities.User{}, errors.Wrap(err, "failed to get user by email")
+	}
+
+	return user, nil
+}
+
 func (d *AuthDomainImpl) UpdateProfile(ctx context.Context, userID uuid.UUID, firstname, lastname, profileImage string, birthdate time.Time, phone string) (entities.User, error) {
 	user, err := d.repo.UpdateUser(ctx, userID, entities.UpdateUserInput{
 		Firstname:    firstname,
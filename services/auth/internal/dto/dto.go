@@ -133,6 +133,29 @@ func (r *GetProfileRequest) Validate() error {
 	return errors.WithStack(v.Error())
 }
 
+type GetUserByEmailRequest struct {
+	Email string `query:"email" validate:"required"`
+}
+
+func (r *GetUserByEmailRequest) Parse(c *fiber.Ctx) error {
+	if err := c.QueryParser(r); err != nil {
+		return errors.Wrap(err, "failed to parse request")
+	}
+
+	if err := r.Validate(); err != nil {
+		return errors.Wrap(err, "failed to validate request")
+	}
+
+	return nil
+}
+
+func (r *GetUserByEmailRequest) Validate() error {
+	v := validator.New()
+	v.Must(r.Email != "", "email is required")
+
+	return errors.WithStack(v.Error())
+}
+
 type UpdateProfileRequest struct {
 	UserID       uuid.UUID `json:"userId" validate:"required"`
 	Firstname    string    `json:"firstname" validate:"required"`
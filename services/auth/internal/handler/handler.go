@@ -4,6 +4,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/cp-rektmart/aconcert-microservice/auth/internal/domain"
 	"github.com/cp-rektmart/aconcert-microservice/auth/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/auth/internal/errs"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -24,6 +25,7 @@ func (h *handler) Mount(r fiber.Router) {
 	userGroup.Post("/logout", h.Logout)
 	userGroup.Get("/me", h.GetProfile)
 	userGroup.Patch("/me", h.UpdateProfile)
+	userGroup.Get("/users", h.GetUserByEmail)
 }
 
 func (h *handler) LoginWithProvider(c *fiber.Ctx) error {
@@ -115,3 +117,22 @@ func (h *handler) UpdateProfile(c *fiber.Ctx) error {
 
 	return c.JSON(dto.UserEntityToDTO(user))
 }
+
+func (h *handler) GetUserByEmail(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.GetUserByEmailRequest
+	if err := req.Parse(c); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	user, err := h.domain.GetUserByEmail(ctx, req.Email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get user by email")
+	}
+
+	return c.JSON(dto.UserEntityToDTO(user))
+}
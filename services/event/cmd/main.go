@@ -16,6 +16,7 @@ import (
 	eventService "github.com/cp-rektmart/aconcert-microservice/event/internal/service"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/grpclogger"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/postgres"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
@@ -63,9 +64,11 @@ func main() {
 		grpc.UnaryInterceptor(grpclogger.LoggingUnaryInterceptor),
 	)
 
-	eventServ := eventService.NewEventService(queries)
+	eventServ := eventService.NewEventService(queries, pgConn)
 	eventpb.RegisterEventServiceServer(grpcServer, eventServ)
 
+	go outbox.NewRelay(eventService.NewOutboxStore(queries), rabbitmq.RabbitMQClient.PublishMessageToQueue).Start(ctx)
+
 	go func() {
 		logger.InfoContext(ctx, "starting gRPC server", slog.String("port", strconv.Itoa(conf.Port)))
 		if err := grpcServer.Serve(lis); err != nil {
@@ -13,22 +13,37 @@ import (
 
 const createEvent = `-- name: CreateEvent :one
 INSERT INTO events (
-    id, name, description, location_id, artist, event_date, thumbnail, images
+    id, name, description, location_id, artist, event_date, thumbnail, images, max_tickets_per_user, max_hold_extension_seconds,
+    refund_deadline_hours, refund_percentage, transfer_cutoff_hours, ballot_opens_at, ballot_closes_at, ballot_payment_hours, currency,
+    booking_fee, processing_fee, vat_rate, vat_inclusive
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
 )
 RETURNING id
 `
 
 type CreateEventParams struct {
-	ID          pgtype.UUID        `json:"id"`
-	Name        string             `json:"name"`
-	Description pgtype.Text        `json:"description"`
-	LocationID  string             `json:"location_id"`
-	Artist      []string           `json:"artist"`
-	EventDate   pgtype.Timestamptz `json:"event_date"`
-	Thumbnail   pgtype.Text        `json:"thumbnail"`
-	Images      []string           `json:"images"`
+	ID                      pgtype.UUID        `json:"id"`
+	Name                    string             `json:"name"`
+	Description             pgtype.Text        `json:"description"`
+	LocationID              string             `json:"location_id"`
+	Artist                  []string           `json:"artist"`
+	EventDate               pgtype.Timestamptz `json:"event_date"`
+	Thumbnail               pgtype.Text        `json:"thumbnail"`
+	Images                  []string           `json:"images"`
+	MaxTicketsPerUser       int32              `json:"max_tickets_per_user"`
+	MaxHoldExtensionSeconds int32              `json:"max_hold_extension_seconds"`
+	RefundDeadlineHours     int32              `json:"refund_deadline_hours"`
+	RefundPercentage        int32              `json:"refund_percentage"`
+	TransferCutoffHours     int32              `json:"transfer_cutoff_hours"`
+	BallotOpensAt           pgtype.Timestamptz `json:"ballot_opens_at"`
+	BallotClosesAt          pgtype.Timestamptz `json:"ballot_closes_at"`
+	BallotPaymentHours      int32              `json:"ballot_payment_hours"`
+	Currency                string             `json:"currency"`
+	BookingFee              pgtype.Int8        `json:"booking_fee"`
+	ProcessingFee           pgtype.Int8        `json:"processing_fee"`
+	VatRate                 pgtype.Float8      `json:"vat_rate"`
+	VatInclusive            pgtype.Bool        `json:"vat_inclusive"`
 }
 
 // Insert a new event
@@ -42,6 +57,19 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (pgtyp
 		arg.EventDate,
 		arg.Thumbnail,
 		arg.Images,
+		arg.MaxTicketsPerUser,
+		arg.MaxHoldExtensionSeconds,
+		arg.RefundDeadlineHours,
+		arg.RefundPercentage,
+		arg.TransferCutoffHours,
+		arg.BallotOpensAt,
+		arg.BallotClosesAt,
+		arg.BallotPaymentHours,
+		arg.Currency,
+		arg.BookingFee,
+		arg.ProcessingFee,
+		arg.VatRate,
+		arg.VatInclusive,
 	)
 	var id pgtype.UUID
 	err := row.Scan(&id)
@@ -50,9 +78,9 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (pgtyp
 
 const createEventZone = `-- name: CreateEventZone :one
 INSERT INTO event_zones (
-    event_id, location_id, zone_number, price, color, name, description
+    event_id, location_id, zone_number, price, color, name, description, zone_type, capacity, currency
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING event_id
 `
 
@@ -60,10 +88,13 @@ type CreateEventZoneParams struct {
 	EventID     pgtype.UUID `json:"event_id"`
 	LocationID  string      `json:"location_id"`
 	ZoneNumber  int32       `json:"zone_number"`
-	Price       float64     `json:"price"`
+	Price       int64       `json:"price"`
 	Color       string      `json:"color"`
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
+	ZoneType    string      `json:"zone_type"`
+	Capacity    int32       `json:"capacity"`
+	Currency    string      `json:"currency"`
 }
 
 func (q *Queries) CreateEventZone(ctx context.Context, arg CreateEventZoneParams) (pgtype.UUID, error) {
@@ -75,6 +106,9 @@ func (q *Queries) CreateEventZone(ctx context.Context, arg CreateEventZoneParams
 		arg.Color,
 		arg.Name,
 		arg.Description,
+		arg.ZoneType,
+		arg.Capacity,
+		arg.Currency,
 	)
 	var event_id pgtype.UUID
 	err := row.Scan(&event_id)
@@ -111,7 +145,7 @@ func (q *Queries) DeleteEventZone(ctx context.Context, id pgtype.UUID) (interfac
 }
 
 const getEventByID = `-- name: GetEventByID :one
-SELECT id, created_at, updated_at, deleted_at, name, description, location_id, artist, event_date, thumbnail, images
+SELECT id, created_at, updated_at, deleted_at, name, description, location_id, artist, event_date, thumbnail, images, max_tickets_per_user, max_hold_extension_seconds, refund_deadline_hours, refund_percentage, transfer_cutoff_hours, ballot_opens_at, ballot_closes_at, ballot_payment_hours, currency, booking_fee, processing_fee, vat_rate, vat_inclusive
 FROM events
 WHERE id = $1
   AND deleted_at IS NULL
@@ -133,12 +167,25 @@ func (q *Queries) GetEventByID(ctx context.Context, id pgtype.UUID) (Event, erro
 		&i.EventDate,
 		&i.Thumbnail,
 		&i.Images,
+		&i.MaxTicketsPerUser,
+		&i.MaxHoldExtensionSeconds,
+		&i.RefundDeadlineHours,
+		&i.RefundPercentage,
+		&i.TransferCutoffHours,
+		&i.BallotOpensAt,
+		&i.BallotClosesAt,
+		&i.BallotPaymentHours,
+		&i.Currency,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.VatRate,
+		&i.VatInclusive,
 	)
 	return i, err
 }
 
 const getEventZoneByID = `-- name: GetEventZoneByID :one
-SELECT id, event_id, location_id, zone_number, price, color, name, description, is_sold_out, created_at, updated_at, deleted_at
+SELECT id, event_id, location_id, zone_number, price, color, name, description, is_sold_out, created_at, updated_at, deleted_at, zone_type, capacity, currency
 FROM event_zones
 WHERE id = $1
   AND deleted_at IS NULL
@@ -160,12 +207,15 @@ func (q *Queries) GetEventZoneByID(ctx context.Context, id pgtype.UUID) (EventZo
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ZoneType,
+		&i.Capacity,
+		&i.Currency,
 	)
 	return i, err
 }
 
 const getEventZonesByEventID = `-- name: GetEventZonesByEventID :many
-SELECT id, event_id, location_id, zone_number, price, color, name, description, is_sold_out, created_at, updated_at, deleted_at
+SELECT id, event_id, location_id, zone_number, price, color, name, description, is_sold_out, created_at, updated_at, deleted_at, zone_type, capacity, currency
 FROM event_zones
 WHERE event_id = $1
   AND deleted_at IS NULL
@@ -193,6 +243,9 @@ func (q *Queries) GetEventZonesByEventID(ctx context.Context, eventID pgtype.UUI
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ZoneType,
+			&i.Capacity,
+			&i.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -219,7 +272,7 @@ func (q *Queries) HardDeleteEvent(ctx context.Context, id pgtype.UUID) (interfac
 }
 
 const listEvents = `-- name: ListEvents :many
-SELECT id, created_at, updated_at, deleted_at, name, description, location_id, artist, event_date, thumbnail, images
+SELECT id, created_at, updated_at, deleted_at, name, description, location_id, artist, event_date, thumbnail, images, max_tickets_per_user, max_hold_extension_seconds, refund_deadline_hours, refund_percentage, transfer_cutoff_hours, ballot_opens_at, ballot_closes_at, ballot_payment_hours, currency, booking_fee, processing_fee, vat_rate, vat_inclusive
 FROM events
 WHERE
   deleted_at IS NULL
@@ -260,6 +313,19 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]Event
 			&i.EventDate,
 			&i.Thumbnail,
 			&i.Images,
+			&i.MaxTicketsPerUser,
+			&i.MaxHoldExtensionSeconds,
+			&i.RefundDeadlineHours,
+			&i.RefundPercentage,
+			&i.TransferCutoffHours,
+			&i.BallotOpensAt,
+			&i.BallotClosesAt,
+			&i.BallotPaymentHours,
+			&i.Currency,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.VatRate,
+			&i.VatInclusive,
 		); err != nil {
 			return nil, err
 		}
@@ -281,6 +347,19 @@ SET
     event_date = $6,
     thumbnail = $7,
     images = $8,
+    max_tickets_per_user = $9,
+    max_hold_extension_seconds = $10,
+    refund_deadline_hours = $11,
+    refund_percentage = $12,
+    transfer_cutoff_hours = $13,
+    ballot_opens_at = $14,
+    ballot_closes_at = $15,
+    ballot_payment_hours = $16,
+    currency = $17,
+    booking_fee = $18,
+    processing_fee = $19,
+    vat_rate = $20,
+    vat_inclusive = $21,
     updated_at = NOW()
 WHERE id = $1
   AND deleted_at IS NULL
@@ -288,14 +367,27 @@ RETURNING id
 `
 
 type UpdateEventParams struct {
-	ID          pgtype.UUID        `json:"id"`
-	Name        string             `json:"name"`
-	Description pgtype.Text        `json:"description"`
-	LocationID  string             `json:"location_id"`
-	Artist      []string           `json:"artist"`
-	EventDate   pgtype.Timestamptz `json:"event_date"`
-	Thumbnail   pgtype.Text        `json:"thumbnail"`
-	Images      []string           `json:"images"`
+	ID                      pgtype.UUID        `json:"id"`
+	Name                    string             `json:"name"`
+	Description             pgtype.Text        `json:"description"`
+	LocationID              string             `json:"location_id"`
+	Artist                  []string           `json:"artist"`
+	EventDate               pgtype.Timestamptz `json:"event_date"`
+	Thumbnail               pgtype.Text        `json:"thumbnail"`
+	Images                  []string           `json:"images"`
+	MaxTicketsPerUser       int32              `json:"max_tickets_per_user"`
+	MaxHoldExtensionSeconds int32              `json:"max_hold_extension_seconds"`
+	RefundDeadlineHours     int32              `json:"refund_deadline_hours"`
+	RefundPercentage        int32              `json:"refund_percentage"`
+	TransferCutoffHours     int32              `json:"transfer_cutoff_hours"`
+	BallotOpensAt           pgtype.Timestamptz `json:"ballot_opens_at"`
+	BallotClosesAt          pgtype.Timestamptz `json:"ballot_closes_at"`
+	BallotPaymentHours      int32              `json:"ballot_payment_hours"`
+	Currency                string             `json:"currency"`
+	BookingFee              pgtype.Int8        `json:"booking_fee"`
+	ProcessingFee           pgtype.Int8        `json:"processing_fee"`
+	VatRate                 pgtype.Float8      `json:"vat_rate"`
+	VatInclusive            pgtype.Bool        `json:"vat_inclusive"`
 }
 
 // Update an existing event
@@ -309,6 +401,19 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (pgtyp
 		arg.EventDate,
 		arg.Thumbnail,
 		arg.Images,
+		arg.MaxTicketsPerUser,
+		arg.MaxHoldExtensionSeconds,
+		arg.RefundDeadlineHours,
+		arg.RefundPercentage,
+		arg.TransferCutoffHours,
+		arg.BallotOpensAt,
+		arg.BallotClosesAt,
+		arg.BallotPaymentHours,
+		arg.Currency,
+		arg.BookingFee,
+		arg.ProcessingFee,
+		arg.VatRate,
+		arg.VatInclusive,
 	)
 	var id pgtype.UUID
 	err := row.Scan(&id)
@@ -326,8 +431,11 @@ SET
     name = $6,
     description = $7,
     is_sold_out = $8,
+    zone_type = $9,
+    capacity = $10,
+    currency = $11,
     updated_at = NOW()
-WHERE id = $9
+WHERE id = $12
   AND deleted_at IS NULL
 RETURNING event_id
 `
@@ -336,11 +444,14 @@ type UpdateEventZoneParams struct {
 	EventID     pgtype.UUID `json:"event_id"`
 	LocationID  string      `json:"location_id"`
 	ZoneNumber  int32       `json:"zone_number"`
-	Price       float64     `json:"price"`
+	Price       int64       `json:"price"`
 	Color       string      `json:"color"`
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
 	IsSoldOut   bool        `json:"is_sold_out"`
+	ZoneType    string      `json:"zone_type"`
+	Capacity    int32       `json:"capacity"`
+	Currency    string      `json:"currency"`
 	ID          pgtype.UUID `json:"id"`
 }
 
@@ -354,6 +465,9 @@ func (q *Queries) UpdateEventZone(ctx context.Context, arg UpdateEventZoneParams
 		arg.Name,
 		arg.Description,
 		arg.IsSoldOut,
+		arg.ZoneType,
+		arg.Capacity,
+		arg.Currency,
 		arg.ID,
 	)
 	var event_id pgtype.UUID
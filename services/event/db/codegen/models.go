@@ -9,17 +9,30 @@ import (
 )
 
 type Event struct {
-	ID          pgtype.UUID        `json:"id"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
-	Name        string             `json:"name"`
-	Description pgtype.Text        `json:"description"`
-	LocationID  string             `json:"location_id"`
-	Artist      []string           `json:"artist"`
-	EventDate   pgtype.Timestamptz `json:"event_date"`
-	Thumbnail   pgtype.Text        `json:"thumbnail"`
-	Images      []string           `json:"images"`
+	ID                      pgtype.UUID        `json:"id"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt               pgtype.Timestamptz `json:"deleted_at"`
+	Name                    string             `json:"name"`
+	Description             pgtype.Text        `json:"description"`
+	LocationID              string             `json:"location_id"`
+	Artist                  []string           `json:"artist"`
+	EventDate               pgtype.Timestamptz `json:"event_date"`
+	Thumbnail               pgtype.Text        `json:"thumbnail"`
+	Images                  []string           `json:"images"`
+	MaxTicketsPerUser       int32              `json:"max_tickets_per_user"`
+	MaxHoldExtensionSeconds int32              `json:"max_hold_extension_seconds"`
+	RefundDeadlineHours     int32              `json:"refund_deadline_hours"`
+	RefundPercentage        int32              `json:"refund_percentage"`
+	TransferCutoffHours     int32              `json:"transfer_cutoff_hours"`
+	BallotOpensAt           pgtype.Timestamptz `json:"ballot_opens_at"`
+	BallotClosesAt          pgtype.Timestamptz `json:"ballot_closes_at"`
+	BallotPaymentHours      int32              `json:"ballot_payment_hours"`
+	Currency                string             `json:"currency"`
+	BookingFee              pgtype.Int8        `json:"booking_fee"`
+	ProcessingFee           pgtype.Int8        `json:"processing_fee"`
+	VatRate                 pgtype.Float8      `json:"vat_rate"`
+	VatInclusive            pgtype.Bool        `json:"vat_inclusive"`
 }
 
 type EventZone struct {
@@ -27,7 +40,7 @@ type EventZone struct {
 	EventID     pgtype.UUID        `json:"event_id"`
 	LocationID  string             `json:"location_id"`
 	ZoneNumber  int32              `json:"zone_number"`
-	Price       float64            `json:"price"`
+	Price       int64              `json:"price"`
 	Color       string             `json:"color"`
 	Name        string             `json:"name"`
 	Description string             `json:"description"`
@@ -35,4 +48,47 @@ type EventZone struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
+	ZoneType    string             `json:"zone_type"`
+	Capacity    int32              `json:"capacity"`
+	Currency    string             `json:"currency"`
+}
+
+type Outbox struct {
+	ID            pgtype.UUID        `json:"id"`
+	Queue         string             `json:"queue"`
+	Type          string             `json:"type"`
+	Payload       []byte             `json:"payload"`
+	Attempts      int32              `json:"attempts"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+}
+
+type PriceTier struct {
+	ID          pgtype.UUID        `json:"id"`
+	EventZoneID pgtype.UUID        `json:"event_zone_id"`
+	Name        string             `json:"name"`
+	Price       int64              `json:"price"`
+	Currency    string             `json:"currency"`
+	StartsAt    pgtype.Timestamptz `json:"starts_at"`
+	EndsAt      pgtype.Timestamptz `json:"ends_at"`
+	SellThrough int32              `json:"sell_through"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type TicketType struct {
+	ID                   pgtype.UUID        `json:"id"`
+	EventZoneID          pgtype.UUID        `json:"event_zone_id"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	Price                int64              `json:"price"`
+	Currency             string             `json:"currency"`
+	QuantityCap          int32              `json:"quantity_cap"`
+	RequiresVerification bool               `json:"requires_verification"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt            pgtype.Timestamptz `json:"deleted_at"`
 }
@@ -11,23 +11,41 @@ import (
 )
 
 type Querier interface {
+	// Claim due messages, hiding them from other relays until locked_until
+	ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]Outbox, error)
 	// Insert a new event
 	CreateEvent(ctx context.Context, arg CreateEventParams) (pgtype.UUID, error)
 	CreateEventZone(ctx context.Context, arg CreateEventZoneParams) (pgtype.UUID, error)
+	// Store a message in the same transaction as the change it describes
+	CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error
+	CreatePriceTier(ctx context.Context, arg CreatePriceTierParams) (pgtype.UUID, error)
+	CreateTicketType(ctx context.Context, arg CreateTicketTypeParams) (pgtype.UUID, error)
 	// Soft delete an event
 	DeleteEvent(ctx context.Context, id pgtype.UUID) (interface{}, error)
 	DeleteEventZone(ctx context.Context, id pgtype.UUID) (interface{}, error)
+	DeletePriceTier(ctx context.Context, id pgtype.UUID) (interface{}, error)
+	DeleteTicketType(ctx context.Context, id pgtype.UUID) (interface{}, error)
 	// Get a single event by ID
 	GetEventByID(ctx context.Context, id pgtype.UUID) (Event, error)
 	GetEventZoneByID(ctx context.Context, id pgtype.UUID) (EventZone, error)
 	GetEventZonesByEventID(ctx context.Context, eventID pgtype.UUID) ([]EventZone, error)
+	GetPriceTierByID(ctx context.Context, id pgtype.UUID) (PriceTier, error)
+	GetTicketTypeByID(ctx context.Context, id pgtype.UUID) (TicketType, error)
 	// Hard delete an event (for admin use)
 	HardDeleteEvent(ctx context.Context, id pgtype.UUID) (interface{}, error)
 	// List events with optional search and pagination
 	ListEvents(ctx context.Context, arg ListEventsParams) ([]Event, error)
+	// List the price tiers of every zone of an event in the order they start
+	ListPriceTiersByEventID(ctx context.Context, eventID pgtype.UUID) ([]ListPriceTiersByEventIDRow, error)
+	// List the ticket types of every zone of an event, cheapest first within a zone
+	ListTicketTypesByEventID(ctx context.Context, eventID pgtype.UUID) ([]ListTicketTypesByEventIDRow, error)
+	MarkOutboxMessageFailed(ctx context.Context, arg MarkOutboxMessageFailedParams) error
+	MarkOutboxMessagePublished(ctx context.Context, id pgtype.UUID) error
 	// Update an existing event
 	UpdateEvent(ctx context.Context, arg UpdateEventParams) (pgtype.UUID, error)
 	UpdateEventZone(ctx context.Context, arg UpdateEventZoneParams) (pgtype.UUID, error)
+	UpdatePriceTier(ctx context.Context, arg UpdatePriceTierParams) (pgtype.UUID, error)
+	UpdateTicketType(ctx context.Context, arg UpdateTicketTypeParams) (pgtype.UUID, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,6 @@
+-- migrate:up
+-- 0 means a user can buy any number of tickets
+ALTER TABLE events ADD COLUMN max_tickets_per_user INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS max_tickets_per_user;
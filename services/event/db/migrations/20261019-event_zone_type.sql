@@ -0,0 +1,8 @@
+-- migrate:up
+-- general admission zones are sold by quantity up to capacity instead of by seat
+ALTER TABLE event_zones ADD COLUMN zone_type TEXT NOT NULL DEFAULT 'SEATED';
+ALTER TABLE event_zones ADD COLUMN capacity INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE event_zones DROP COLUMN IF EXISTS capacity;
+ALTER TABLE event_zones DROP COLUMN IF EXISTS zone_type;
@@ -0,0 +1,6 @@
+-- migrate:up
+-- how far a buyer may extend a pending hold once, 0 disables extensions
+ALTER TABLE events ADD COLUMN max_hold_extension_seconds INT NOT NULL DEFAULT 300;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS max_hold_extension_seconds;
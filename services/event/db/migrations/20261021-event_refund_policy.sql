@@ -0,0 +1,9 @@
+-- migrate:up
+-- confirmed bookings can be refunded until refund_deadline_hours before the event,
+-- refund_percentage of the total is paid back and 0 disables refunds
+ALTER TABLE events ADD COLUMN refund_deadline_hours INT NOT NULL DEFAULT 0;
+ALTER TABLE events ADD COLUMN refund_percentage INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS refund_percentage;
+ALTER TABLE events DROP COLUMN IF EXISTS refund_deadline_hours;
@@ -0,0 +1,6 @@
+-- migrate:up
+-- tickets can be transferred to another user until transfer_cutoff_hours before the event
+ALTER TABLE events ADD COLUMN transfer_cutoff_hours INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS transfer_cutoff_hours;
@@ -0,0 +1,11 @@
+-- migrate:up
+-- an event with a ballot window takes applications between ballot_opens_at and ballot_closes_at instead of
+-- selling first come first served, winners of the draw have ballot_payment_hours to pay for their seats
+ALTER TABLE events ADD COLUMN ballot_opens_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN ballot_closes_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN ballot_payment_hours INT NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS ballot_payment_hours;
+ALTER TABLE events DROP COLUMN IF EXISTS ballot_closes_at;
+ALTER TABLE events DROP COLUMN IF EXISTS ballot_opens_at;
@@ -0,0 +1,13 @@
+-- migrate:up
+-- zone prices are kept in the minor unit of their currency, satang for THB, so totals add up exactly.
+-- Prices in baht are rounded half away from zero to the nearest satang
+ALTER TABLE event_zones ALTER COLUMN price DROP DEFAULT;
+ALTER TABLE event_zones ALTER COLUMN price TYPE BIGINT USING ROUND((price * 100)::NUMERIC)::BIGINT;
+ALTER TABLE event_zones ALTER COLUMN price SET DEFAULT 0;
+ALTER TABLE event_zones ADD COLUMN currency TEXT NOT NULL DEFAULT 'THB';
+
+-- migrate:down
+ALTER TABLE event_zones DROP COLUMN IF EXISTS currency;
+ALTER TABLE event_zones ALTER COLUMN price DROP DEFAULT;
+ALTER TABLE event_zones ALTER COLUMN price TYPE FLOAT USING price / 100.0;
+ALTER TABLE event_zones ALTER COLUMN price SET DEFAULT 0;
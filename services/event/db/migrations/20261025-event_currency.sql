@@ -0,0 +1,6 @@
+-- migrate:up
+-- every zone of an event is priced in the currency of the event, events sold so far are in THB
+ALTER TABLE events ADD COLUMN currency TEXT NOT NULL DEFAULT 'THB';
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS currency;
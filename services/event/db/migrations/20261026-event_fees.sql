@@ -0,0 +1,14 @@
+-- migrate:up
+-- the fees and VAT an event charges instead of the defaults of the reservation service, NULL keeps the
+-- default. Fees are in the minor unit of the currency of the event, booking_fee per ticket and
+-- processing_fee per order, vat_rate is in percent
+ALTER TABLE events ADD COLUMN booking_fee BIGINT;
+ALTER TABLE events ADD COLUMN processing_fee BIGINT;
+ALTER TABLE events ADD COLUMN vat_rate DOUBLE PRECISION;
+ALTER TABLE events ADD COLUMN vat_inclusive BOOLEAN;
+
+-- migrate:down
+ALTER TABLE events DROP COLUMN IF EXISTS vat_inclusive;
+ALTER TABLE events DROP COLUMN IF EXISTS vat_rate;
+ALTER TABLE events DROP COLUMN IF EXISTS processing_fee;
+ALTER TABLE events DROP COLUMN IF EXISTS booking_fee;
@@ -0,0 +1,21 @@
+-- migrate:up
+-- the kinds of ticket sold in a zone, each at its own price in the minor unit of its currency.
+-- quantity_cap caps the tickets of the type sold over all reservations, 0 leaves it uncapped
+CREATE TABLE ticket_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_zone_id UUID NOT NULL REFERENCES event_zones(id),
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    price BIGINT NOT NULL DEFAULT 0,
+    currency TEXT NOT NULL DEFAULT 'THB',
+    quantity_cap INT NOT NULL DEFAULT 0,
+    requires_verification BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX ticket_types_event_zone_id_idx ON ticket_types (event_zone_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS ticket_types;
@@ -0,0 +1,22 @@
+-- migrate:up
+-- scheduled prices of a zone, early-bird, regular and door pricing. A tier sells from starts_at until
+-- ends_at or until sell_through tickets of the zone were sold, whichever comes first, 0 leaves the
+-- count out. Prices are in the minor unit of their currency, the zone price applies when no tier sells
+CREATE TABLE price_tiers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_zone_id UUID NOT NULL REFERENCES event_zones(id),
+    name TEXT NOT NULL,
+    price BIGINT NOT NULL DEFAULT 0,
+    currency TEXT NOT NULL DEFAULT 'THB',
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    sell_through INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX price_tiers_event_zone_id_idx ON price_tiers (event_zone_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS price_tiers;
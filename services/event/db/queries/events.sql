@@ -22,9 +22,11 @@ OFFSET sqlc.arg('offset');
 -- Insert a new event
 -- name: CreateEvent :one
 INSERT INTO events (
-    id, name, description, location_id, artist, event_date, thumbnail, images
+    id, name, description, location_id, artist, event_date, thumbnail, images, max_tickets_per_user, max_hold_extension_seconds,
+    refund_deadline_hours, refund_percentage, transfer_cutoff_hours, ballot_opens_at, ballot_closes_at, ballot_payment_hours, currency,
+    booking_fee, processing_fee, vat_rate, vat_inclusive
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
 )
 RETURNING id;
 
@@ -39,6 +41,19 @@ SET
     event_date = $6,
     thumbnail = $7,
     images = $8,
+    max_tickets_per_user = $9,
+    max_hold_extension_seconds = $10,
+    refund_deadline_hours = $11,
+    refund_percentage = $12,
+    transfer_cutoff_hours = $13,
+    ballot_opens_at = $14,
+    ballot_closes_at = $15,
+    ballot_payment_hours = $16,
+    currency = $17,
+    booking_fee = $18,
+    processing_fee = $19,
+    vat_rate = $20,
+    vat_inclusive = $21,
     updated_at = NOW()
 WHERE id = $1
   AND deleted_at IS NULL
@@ -65,9 +80,9 @@ WHERE event_id = $1
 
 -- name: CreateEventZone :one
 INSERT INTO event_zones (
-    event_id, location_id, zone_number, price, color, name, description
+    event_id, location_id, zone_number, price, color, name, description, zone_type, capacity, currency
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING event_id;
 
 -- name: UpdateEventZone :one
@@ -81,8 +96,11 @@ SET
     name = $6,
     description = $7,
     is_sold_out = $8,
+    zone_type = $9,
+    capacity = $10,
+    currency = $11,
     updated_at = NOW()
-WHERE id = $9
+WHERE id = $12
   AND deleted_at IS NULL
 RETURNING event_id;
 
@@ -0,0 +1,30 @@
+-- Store a message in the same transaction as the change it describes
+-- name: CreateOutboxMessage :exec
+INSERT INTO outbox (
+    id, queue, type, payload
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- Claim due messages, hiding them from other relays until locked_until
+-- name: ClaimOutboxMessages :many
+UPDATE outbox
+SET next_attempt_at = sqlc.arg('locked_until')
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE published_at IS NULL AND next_attempt_at <= NOW()
+    ORDER BY created_at
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = NOW()
+WHERE id = $1;
+
+-- name: MarkOutboxMessageFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1;
@@ -0,0 +1,42 @@
+-- name: CreatePriceTier :one
+INSERT INTO price_tiers (
+    event_zone_id, name, price, currency, starts_at, ends_at, sell_through
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id;
+
+-- name: GetPriceTierByID :one
+SELECT *
+FROM price_tiers
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- List the price tiers of every zone of an event in the order they start
+-- name: ListPriceTiersByEventID :many
+SELECT price_tiers.*, event_zones.zone_number
+FROM price_tiers
+JOIN event_zones ON event_zones.id = price_tiers.event_zone_id
+WHERE event_zones.event_id = $1
+  AND event_zones.deleted_at IS NULL
+  AND price_tiers.deleted_at IS NULL
+ORDER BY event_zones.zone_number, price_tiers.starts_at, price_tiers.created_at;
+
+-- name: UpdatePriceTier :one
+UPDATE price_tiers
+SET
+    name = $1,
+    price = $2,
+    currency = $3,
+    starts_at = $4,
+    ends_at = $5,
+    sell_through = $6,
+    updated_at = NOW()
+WHERE id = $7
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- name: DeletePriceTier :one
+UPDATE price_tiers
+SET deleted_at = NOW()
+WHERE id = $1
+RETURNING $1;
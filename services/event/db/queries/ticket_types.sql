@@ -0,0 +1,42 @@
+-- name: CreateTicketType :one
+INSERT INTO ticket_types (
+    event_zone_id, name, description, price, currency, quantity_cap, requires_verification
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id;
+
+-- name: GetTicketTypeByID :one
+SELECT *
+FROM ticket_types
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- List the ticket types of every zone of an event, cheapest first within a zone
+-- name: ListTicketTypesByEventID :many
+SELECT ticket_types.*, event_zones.zone_number
+FROM ticket_types
+JOIN event_zones ON event_zones.id = ticket_types.event_zone_id
+WHERE event_zones.event_id = $1
+  AND event_zones.deleted_at IS NULL
+  AND ticket_types.deleted_at IS NULL
+ORDER BY event_zones.zone_number, ticket_types.price, ticket_types.name;
+
+-- name: UpdateTicketType :one
+UPDATE ticket_types
+SET
+    name = $1,
+    description = $2,
+    price = $3,
+    currency = $4,
+    quantity_cap = $5,
+    requires_verification = $6,
+    updated_at = NOW()
+WHERE id = $7
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- name: DeleteTicketType :one
+UPDATE ticket_types
+SET deleted_at = NOW()
+WHERE id = $1
+RETURNING $1;
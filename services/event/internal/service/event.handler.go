@@ -2,19 +2,24 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
 	"github.com/cp-rektmart/aconcert-microservice/event/internal/utils"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
-	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// defaultHoldExtensionSeconds is used when an event is created without its own maximum.
+const defaultHoldExtensionSeconds = 300
+
 // ListEvents Lists events with pagination
 func (s *EventService) ListEvents(ctx context.Context, req *eventpb.ListEventsRequest) (*eventpb.ListEventsResponse, error) {
 	s.mu.Lock()
@@ -52,16 +57,26 @@ func (s *EventService) ListEvents(ctx context.Context, req *eventpb.ListEventsRe
 	var eventList []*eventpb.Event
 	for _, event := range events {
 		eventeventproto := &eventpb.Event{
-			Id:          event.ID.String(),
-			CreatedAt:   event.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:   event.UpdatedAt.Time.Format(time.RFC3339),
-			Name:        event.Name,
-			Description: event.Description.String,
-			LocationId:  event.LocationID,
-			Artist:      event.Artist,
-			EventDate:   event.EventDate.Time.Format(time.RFC3339),
-			Thumbnail:   event.Thumbnail.String,
-			Images:      event.Images,
+			Id:                      event.ID.String(),
+			CreatedAt:               event.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:               event.UpdatedAt.Time.Format(time.RFC3339),
+			Name:                    event.Name,
+			Description:             event.Description.String,
+			LocationId:              event.LocationID,
+			Artist:                  event.Artist,
+			EventDate:               event.EventDate.Time.Format(time.RFC3339),
+			Thumbnail:               event.Thumbnail.String,
+			Images:                  event.Images,
+			MaxTicketsPerUser:       event.MaxTicketsPerUser,
+			MaxHoldExtensionSeconds: event.MaxHoldExtensionSeconds,
+			RefundDeadlineHours:     event.RefundDeadlineHours,
+			RefundPercentage:        event.RefundPercentage,
+			TransferCutoffHours:     event.TransferCutoffHours,
+			BallotOpensAt:           formatOptionalTime(event.BallotOpensAt),
+			BallotClosesAt:          formatOptionalTime(event.BallotClosesAt),
+			BallotPaymentHours:      event.BallotPaymentHours,
+			Currency:                event.Currency,
+			Fees:                    toEventFeesProto(event),
 		}
 		eventList = append(eventList, eventeventproto)
 	}
@@ -85,16 +100,26 @@ func (s *EventService) GetEvent(ctx context.Context, req *eventpb.GetEventReques
 	}
 
 	eventeventproto := &eventpb.Event{
-		Id:          event.ID.String(),
-		CreatedAt:   event.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:   event.UpdatedAt.Time.Format(time.RFC3339),
-		Name:        event.Name,
-		Description: event.Description.String,
-		LocationId:  event.LocationID,
-		Artist:      event.Artist,
-		EventDate:   event.EventDate.Time.Format(time.RFC3339),
-		Thumbnail:   event.Thumbnail.String,
-		Images:      event.Images,
+		Id:                      event.ID.String(),
+		CreatedAt:               event.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:               event.UpdatedAt.Time.Format(time.RFC3339),
+		Name:                    event.Name,
+		Description:             event.Description.String,
+		LocationId:              event.LocationID,
+		Artist:                  event.Artist,
+		EventDate:               event.EventDate.Time.Format(time.RFC3339),
+		Thumbnail:               event.Thumbnail.String,
+		Images:                  event.Images,
+		MaxTicketsPerUser:       event.MaxTicketsPerUser,
+		MaxHoldExtensionSeconds: event.MaxHoldExtensionSeconds,
+		RefundDeadlineHours:     event.RefundDeadlineHours,
+		RefundPercentage:        event.RefundPercentage,
+		TransferCutoffHours:     event.TransferCutoffHours,
+		BallotOpensAt:           formatOptionalTime(event.BallotOpensAt),
+		BallotClosesAt:          formatOptionalTime(event.BallotClosesAt),
+		BallotPaymentHours:      event.BallotPaymentHours,
+		Currency:                event.Currency,
+		Fees:                    toEventFeesProto(event),
 	}
 
 	return &eventpb.GetEventResponse{Event: eventeventproto}, nil
@@ -113,41 +138,102 @@ func (s *EventService) CreateEvent(ctx context.Context, req *eventpb.CreateEvent
 		return nil, errors.New("invalid eventDate format")
 	}
 
-	id, err := s.queries.CreateEvent(ctx, db.CreateEventParams{
-		ID:          pgtype.UUID{Bytes: newUUID, Valid: true},
-		Name:        req.GetName(),
-		Description: pgtype.Text{String: req.GetDescription(), Valid: true},
-		LocationID:  req.LocationId,
-		Artist:      req.GetArtist(),
-		EventDate:   pgtype.Timestamptz{Time: eventDate, Valid: true},
-		Thumbnail:   pgtype.Text{String: req.GetThumbnail(), Valid: true},
-		Images:      req.GetImages(),
-	})
-	if err != nil {
-		return nil, errors.New("failed to create event")
+	if req.GetMaxTicketsPerUser() < 0 {
+		return nil, errors.New("maxTicketsPerUser must not be negative")
 	}
 
-	event, err := s.queries.GetEventByID(ctx, id)
-	if err != nil {
-		return nil, errors.New("failed to retrieve created event")
+	maxHoldExtensionSeconds := int32(defaultHoldExtensionSeconds)
+	if req.MaxHoldExtensionSeconds != nil {
+		if req.GetMaxHoldExtensionSeconds() < 0 {
+			return nil, errors.New("maxHoldExtensionSeconds must not be negative")
+		}
+		maxHoldExtensionSeconds = req.GetMaxHoldExtensionSeconds()
+	}
+
+	refundDeadlineHours := req.GetRefundDeadlineHours()
+	refundPercentage := req.GetRefundPercentage()
+	if err := validateRefundPolicy(refundDeadlineHours, refundPercentage); err != nil {
+		return nil, err
 	}
 
-	data := struct {
-		Type string `json:"type"`
-		Data any    `json:"data"`
-	}{
+	transferCutoffHours := req.GetTransferCutoffHours()
+	if transferCutoffHours < 0 {
+		return nil, errors.New("transferCutoffHours must not be negative")
+	}
 
-		Type: "event.created",
-		Data: event,
+	ballotOpensAt, err := parseOptionalTime(req.GetBallotOpensAt(), "ballotOpensAt")
+	if err != nil {
+		return nil, err
+	}
+	ballotClosesAt, err := parseOptionalTime(req.GetBallotClosesAt(), "ballotClosesAt")
+	if err != nil {
+		return nil, err
+	}
+	ballotPaymentHours := req.GetBallotPaymentHours()
+	if err := validateBallot(ballotOpensAt, ballotClosesAt, eventDate, ballotPaymentHours); err != nil {
+		return nil, err
 	}
 
-	jsonData, err := json.Marshal(data)
+	currency, err := parseCurrency(req.GetCurrency())
 	if err != nil {
-		return nil, errors.New("failed to marshal event data")
+		return nil, err
 	}
 
-	if err := rabbitmq.RabbitMQClient.PublishToQueue("notifications", jsonData); err != nil {
-		return nil, errors.New("failed to publish event to RabbitMQ")
+	fees, err := parseEventFees(req.GetFees())
+	if err != nil {
+		return nil, err
+	}
+
+	var id pgtype.UUID
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		id, err = queries.CreateEvent(ctx, db.CreateEventParams{
+			ID:                      pgtype.UUID{Bytes: newUUID, Valid: true},
+			Name:                    req.GetName(),
+			Description:             pgtype.Text{String: req.GetDescription(), Valid: true},
+			LocationID:              req.LocationId,
+			Artist:                  req.GetArtist(),
+			EventDate:               pgtype.Timestamptz{Time: eventDate, Valid: true},
+			Thumbnail:               pgtype.Text{String: req.GetThumbnail(), Valid: true},
+			Images:                  req.GetImages(),
+			MaxTicketsPerUser:       req.GetMaxTicketsPerUser(),
+			MaxHoldExtensionSeconds: maxHoldExtensionSeconds,
+			RefundDeadlineHours:     refundDeadlineHours,
+			RefundPercentage:        refundPercentage,
+			TransferCutoffHours:     transferCutoffHours,
+			BallotOpensAt:           ballotOpensAt,
+			BallotClosesAt:          ballotClosesAt,
+			BallotPaymentHours:      ballotPaymentHours,
+			Currency:                currency,
+			BookingFee:              fees.bookingFee,
+			ProcessingFee:           fees.processingFee,
+			VatRate:                 fees.vatRate,
+			VatInclusive:            fees.vatInclusive,
+		})
+		if err != nil {
+			return errors.New("failed to create event")
+		}
+
+		event, err := queries.GetEventByID(ctx, id)
+		if err != nil {
+			return errors.New("failed to retrieve created event")
+		}
+
+		// published by the outbox relay once the event is committed
+		message, err := outbox.NewMessage(notificationsQueue, "event.created", event)
+		if err != nil {
+			return errors.New("failed to marshal event data")
+		}
+
+		if err := createOutboxMessage(ctx, queries, message); err != nil {
+			return errors.New("failed to store event message")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &eventpb.CreateEventResponse{Id: id.String()}, nil
@@ -204,15 +290,118 @@ func (s *EventService) UpdateEvent(ctx context.Context, req *eventpb.UpdateEvent
 		images = req.Images
 	}
 
+	maxTicketsPerUser := eventData.MaxTicketsPerUser
+	if req.MaxTicketsPerUser != nil {
+		if req.GetMaxTicketsPerUser() < 0 {
+			return nil, errors.New("maxTicketsPerUser must not be negative")
+		}
+		maxTicketsPerUser = req.GetMaxTicketsPerUser()
+	}
+
+	maxHoldExtensionSeconds := eventData.MaxHoldExtensionSeconds
+	if req.MaxHoldExtensionSeconds != nil {
+		if req.GetMaxHoldExtensionSeconds() < 0 {
+			return nil, errors.New("maxHoldExtensionSeconds must not be negative")
+		}
+		maxHoldExtensionSeconds = req.GetMaxHoldExtensionSeconds()
+	}
+
+	refundDeadlineHours := eventData.RefundDeadlineHours
+	if req.RefundDeadlineHours != nil {
+		refundDeadlineHours = req.GetRefundDeadlineHours()
+	}
+
+	refundPercentage := eventData.RefundPercentage
+	if req.RefundPercentage != nil {
+		refundPercentage = req.GetRefundPercentage()
+	}
+
+	if err := validateRefundPolicy(refundDeadlineHours, refundPercentage); err != nil {
+		return nil, err
+	}
+
+	transferCutoffHours := eventData.TransferCutoffHours
+	if req.TransferCutoffHours != nil {
+		transferCutoffHours = req.GetTransferCutoffHours()
+	}
+	if transferCutoffHours < 0 {
+		return nil, errors.New("transferCutoffHours must not be negative")
+	}
+
+	ballotOpensAt := eventData.BallotOpensAt
+	if req.BallotOpensAt != nil {
+		if ballotOpensAt, err = parseOptionalTime(req.GetBallotOpensAt(), "ballotOpensAt"); err != nil {
+			return nil, err
+		}
+	}
+
+	ballotClosesAt := eventData.BallotClosesAt
+	if req.BallotClosesAt != nil {
+		if ballotClosesAt, err = parseOptionalTime(req.GetBallotClosesAt(), "ballotClosesAt"); err != nil {
+			return nil, err
+		}
+	}
+
+	ballotPaymentHours := eventData.BallotPaymentHours
+	if req.BallotPaymentHours != nil {
+		ballotPaymentHours = req.GetBallotPaymentHours()
+	}
+
+	if err := validateBallot(ballotOpensAt, ballotClosesAt, eventDate.Time, ballotPaymentHours); err != nil {
+		return nil, err
+	}
+
+	currency := eventData.Currency
+	if req.Currency != nil {
+		if currency, err = parseCurrency(req.GetCurrency()); err != nil {
+			return nil, err
+		}
+	}
+	if currency != eventData.Currency {
+		// zones keep the currency they are priced in, the event cannot move away from it
+		zones, err := s.queries.GetEventZonesByEventID(ctx, eventData.ID)
+		if err != nil {
+			return nil, errors.New("failed to get event zones")
+		}
+		if len(zones) > 0 {
+			return nil, errors.New("currency cannot change once the event has zones")
+		}
+	}
+
+	fees := eventFees{
+		bookingFee:    eventData.BookingFee,
+		processingFee: eventData.ProcessingFee,
+		vatRate:       eventData.VatRate,
+		vatInclusive:  eventData.VatInclusive,
+	}
+	if req.Fees != nil {
+		if fees, err = parseEventFees(req.GetFees()); err != nil {
+			return nil, err
+		}
+	}
+
 	updateParams := db.UpdateEventParams{
-		ID:          utils.ParsedUUID(req.Id),
-		Name:        name,
-		Description: pgtype.Text{String: description, Valid: true},
-		LocationID:  locationID,
-		Artist:      artist,
-		EventDate:   eventDate,
-		Thumbnail:   pgtype.Text{String: thumbnail, Valid: true},
-		Images:      images,
+		ID:                      utils.ParsedUUID(req.Id),
+		Name:                    name,
+		Description:             pgtype.Text{String: description, Valid: true},
+		LocationID:              locationID,
+		Artist:                  artist,
+		EventDate:               eventDate,
+		Thumbnail:               pgtype.Text{String: thumbnail, Valid: true},
+		Images:                  images,
+		MaxTicketsPerUser:       maxTicketsPerUser,
+		MaxHoldExtensionSeconds: maxHoldExtensionSeconds,
+		RefundDeadlineHours:     refundDeadlineHours,
+		RefundPercentage:        refundPercentage,
+		TransferCutoffHours:     transferCutoffHours,
+		BallotOpensAt:           ballotOpensAt,
+		BallotClosesAt:          ballotClosesAt,
+		BallotPaymentHours:      ballotPaymentHours,
+		Currency:                currency,
+		BookingFee:              fees.bookingFee,
+		ProcessingFee:           fees.processingFee,
+		VatRate:                 fees.vatRate,
+		VatInclusive:            fees.vatInclusive,
 	}
 
 	eventID, err := s.queries.UpdateEvent(ctx, updateParams)
@@ -235,3 +424,132 @@ func (s *EventService) DeleteEvent(ctx context.Context, req *eventpb.DeleteEvent
 
 	return &eventpb.Empty{}, nil
 }
+
+// parseCurrency reads the currency of an event, THB when empty.
+func parseCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return money.DefaultCurrency, nil
+	}
+	if !money.Supported(currency) {
+		return "", fmt.Errorf("unsupported currency: %s", currency)
+	}
+	return currency, nil
+}
+
+// eventFees are the fee and VAT overrides of an event, an invalid field keeps the default.
+type eventFees struct {
+	bookingFee    pgtype.Int8
+	processingFee pgtype.Int8
+	vatRate       pgtype.Float8
+	vatInclusive  pgtype.Bool
+}
+
+// parseEventFees reads the fee and VAT overrides of an event, nil overrides nothing.
+func parseEventFees(fees *eventpb.EventFees) (eventFees, error) {
+	var parsed eventFees
+	if fees == nil {
+		return parsed, nil
+	}
+	if fees.BookingFee != nil {
+		if fees.GetBookingFee() < 0 {
+			return eventFees{}, errors.New("bookingFee must not be negative")
+		}
+		parsed.bookingFee = pgtype.Int8{Int64: fees.GetBookingFee(), Valid: true}
+	}
+	if fees.ProcessingFee != nil {
+		if fees.GetProcessingFee() < 0 {
+			return eventFees{}, errors.New("processingFee must not be negative")
+		}
+		parsed.processingFee = pgtype.Int8{Int64: fees.GetProcessingFee(), Valid: true}
+	}
+	if fees.VatRate != nil {
+		if fees.GetVatRate() < 0 || fees.GetVatRate() > 100 {
+			return eventFees{}, errors.New("vatRate must be between 0 and 100")
+		}
+		parsed.vatRate = pgtype.Float8{Float64: fees.GetVatRate(), Valid: true}
+	}
+	if fees.VatInclusive != nil {
+		parsed.vatInclusive = pgtype.Bool{Bool: fees.GetVatInclusive(), Valid: true}
+	}
+	return parsed, nil
+}
+
+// toEventFeesProto returns the overrides an event sets, nil when it charges the defaults.
+func toEventFeesProto(event db.Event) *eventpb.EventFees {
+	if !event.BookingFee.Valid && !event.ProcessingFee.Valid && !event.VatRate.Valid && !event.VatInclusive.Valid {
+		return nil
+	}
+
+	fees := &eventpb.EventFees{}
+	if event.BookingFee.Valid {
+		fees.BookingFee = &event.BookingFee.Int64
+	}
+	if event.ProcessingFee.Valid {
+		fees.ProcessingFee = &event.ProcessingFee.Int64
+	}
+	if event.VatRate.Valid {
+		fees.VatRate = &event.VatRate.Float64
+	}
+	if event.VatInclusive.Valid {
+		fees.VatInclusive = &event.VatInclusive.Bool
+	}
+	return fees
+}
+
+// parseOptionalTime reads an RFC3339 time, a bound of the ballot window or of a price tier. An empty value
+// gives a null time.
+func parseOptionalTime(value, field string) (pgtype.Timestamptz, error) {
+	if value == "" {
+		return pgtype.Timestamptz{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return pgtype.Timestamptz{}, fmt.Errorf("invalid %s format", field)
+	}
+	return pgtype.Timestamptz{Time: parsed, Valid: true}, nil
+}
+
+func formatOptionalTime(value pgtype.Timestamptz) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(time.RFC3339)
+}
+
+// maxBallotPaymentHours is bounded by the lifetime of a Stripe checkout session.
+const maxBallotPaymentHours = 24
+
+// validateBallot checks the ballot window lies before the event and winners get a payment deadline.
+func validateBallot(opensAt, closesAt pgtype.Timestamptz, eventDate time.Time, paymentHours int32) error {
+	if opensAt.Valid != closesAt.Valid {
+		return errors.New("ballotOpensAt and ballotClosesAt must be set together")
+	}
+	if paymentHours < 0 || paymentHours > maxBallotPaymentHours {
+		return fmt.Errorf("ballotPaymentHours must be between 0 and %d", maxBallotPaymentHours)
+	}
+	if !opensAt.Valid {
+		return nil
+	}
+	if !closesAt.Time.After(opensAt.Time) {
+		return errors.New("ballotClosesAt must be after ballotOpensAt")
+	}
+	if !closesAt.Time.Before(eventDate) {
+		return errors.New("ballot must close before the event")
+	}
+	if paymentHours == 0 {
+		return errors.New("ballotPaymentHours is required for a ballot")
+	}
+	return nil
+}
+
+// validateRefundPolicy checks the refund deadline and the percentage of the total paid back.
+func validateRefundPolicy(deadlineHours, percentage int32) error {
+	if deadlineHours < 0 {
+		return errors.New("refundDeadlineHours must not be negative")
+	}
+	if percentage < 0 || percentage > 100 {
+		return errors.New("refundPercentage must be between 0 and 100")
+	}
+	return nil
+}
@@ -7,10 +7,17 @@ import (
 	"github.com/cockroachdb/errors"
 	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
 	"github.com/cp-rektmart/aconcert-microservice/event/internal/utils"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
 	"github.com/google/uuid"
 )
 
+const (
+	ZoneTypeSeated           = "SEATED"
+	ZoneTypeGeneralAdmission = "GENERAL_ADMISSION"
+)
+
 func (s *EventService) GetEventZoneByEventId(ctx context.Context, req *eventpb.GetEventZoneByEventIdRequest) (*eventpb.GetEventZoneByEventIdResponse, error) {
 	eventZones, err := s.queries.GetEventZonesByEventID(ctx, utils.ParsedUUID(req.EventId))
 	if err != nil {
@@ -18,6 +25,26 @@ func (s *EventService) GetEventZoneByEventId(ctx context.Context, req *eventpb.G
 		return nil, errors.Wrap(err, "failed to get event zones by event ID")
 	}
 
+	ticketTypes, err := s.queries.ListTicketTypesByEventID(ctx, utils.ParsedUUID(req.EventId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ticket types by event ID")
+	}
+	zoneTicketTypes := make(map[string][]*eventpb.TicketType)
+	for _, ticketType := range ticketTypes {
+		zoneID := ticketType.EventZoneID.String()
+		zoneTicketTypes[zoneID] = append(zoneTicketTypes[zoneID], toTicketTypeProto(ticketType))
+	}
+
+	priceTiers, err := s.queries.ListPriceTiersByEventID(ctx, utils.ParsedUUID(req.EventId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get price tiers by event ID")
+	}
+	zonePriceTiers := make(map[string][]*eventpb.PriceTier)
+	for _, priceTier := range priceTiers {
+		zoneID := priceTier.EventZoneID.String()
+		zonePriceTiers[zoneID] = append(zonePriceTiers[zoneID], toPriceTierProto(priceTier))
+	}
+
 	var eventZoneList []*eventpb.EventZone
 	for _, zone := range eventZones {
 		eventZoneList = append(eventZoneList, &eventpb.EventZone{
@@ -25,11 +52,15 @@ func (s *EventService) GetEventZoneByEventId(ctx context.Context, req *eventpb.G
 			EventId:     zone.EventID.String(),
 			LocationId:  zone.LocationID,
 			ZoneNumber:  zone.ZoneNumber,
-			Price:       zone.Price,
+			Price:       money.ToProto(money.New(zone.Price, zone.Currency)),
 			Color:       zone.Color,
 			Name:        zone.Name,
 			Description: zone.Description,
 			IsSoldOut:   zone.IsSoldOut,
+			ZoneType:    zone.ZoneType,
+			Capacity:    zone.Capacity,
+			TicketTypes: zoneTicketTypes[zone.ID.String()],
+			PriceTiers:  zonePriceTiers[zone.ID.String()],
 		})
 	}
 
@@ -37,14 +68,33 @@ func (s *EventService) GetEventZoneByEventId(ctx context.Context, req *eventpb.G
 }
 
 func (s *EventService) CreateEventZone(ctx context.Context, req *eventpb.CreateEventZoneRequest) (*eventpb.CreateEventZoneResponse, error) {
+	zoneType := req.ZoneType
+	if zoneType == "" {
+		zoneType = ZoneTypeSeated
+	}
+	if err := validateZoneType(zoneType, req.Capacity); err != nil {
+		return nil, err
+	}
+	event, err := s.queries.GetEventByID(ctx, utils.ParsedUUID(req.EventId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+	price, err := zonePrice(req.Price, event.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := s.queries.CreateEventZone(ctx, db.CreateEventZoneParams{
 		EventID:     utils.ParsedUUID(req.EventId),
 		LocationID:  req.LocationId,
 		ZoneNumber:  req.ZoneNumber,
-		Price:       req.Price,
+		Price:       price.Amount,
 		Color:       req.Color,
 		Name:        req.Name,
 		Description: req.Description,
+		ZoneType:    zoneType,
+		Capacity:    req.Capacity,
+		Currency:    price.Currency,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create event zone")
@@ -71,6 +121,11 @@ func (s *EventService) UpdateEventZone(ctx context.Context, req *eventpb.UpdateE
 		params.EventID = eventZone.EventID
 	}
 
+	event, err := s.queries.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+
 	if req.LocationId != nil {
 		params.LocationID = *req.LocationId
 	} else {
@@ -84,9 +139,18 @@ func (s *EventService) UpdateEventZone(ctx context.Context, req *eventpb.UpdateE
 	}
 
 	if req.Price != nil {
-		params.Price = *req.Price
+		price, err := zonePrice(req.Price, event.Currency)
+		if err != nil {
+			return nil, err
+		}
+		params.Price = price.Amount
+		params.Currency = price.Currency
 	} else {
+		if eventZone.Currency != event.Currency {
+			return nil, errors.Newf("zone is priced in %s, give a price in %s to move it", eventZone.Currency, event.Currency)
+		}
 		params.Price = eventZone.Price
+		params.Currency = eventZone.Currency
 	}
 
 	if req.Color != nil {
@@ -113,6 +177,22 @@ func (s *EventService) UpdateEventZone(ctx context.Context, req *eventpb.UpdateE
 		params.IsSoldOut = eventZone.IsSoldOut
 	}
 
+	if req.ZoneType != nil {
+		params.ZoneType = *req.ZoneType
+	} else {
+		params.ZoneType = eventZone.ZoneType
+	}
+
+	if req.Capacity != nil {
+		params.Capacity = *req.Capacity
+	} else {
+		params.Capacity = eventZone.Capacity
+	}
+
+	if err := validateZoneType(params.ZoneType, params.Capacity); err != nil {
+		return nil, err
+	}
+
 	_, err = s.queries.UpdateEventZone(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update event zone")
@@ -131,3 +211,33 @@ func (s *EventService) DeleteEventZone(ctx context.Context, req *eventpb.DeleteE
 
 	return &eventpb.Empty{}, nil
 }
+
+func validateZoneType(zoneType string, capacity int32) error {
+	switch zoneType {
+	case ZoneTypeSeated:
+		return nil
+	case ZoneTypeGeneralAdmission:
+		if capacity <= 0 {
+			return errors.New("general admission zones need a capacity")
+		}
+		return nil
+	default:
+		return errors.Newf("invalid zone type: %s", zoneType)
+	}
+}
+
+// zonePrice reads the price of a zone or ticket type in minor units. It must be in the currency of the event, which is assumed
+// when the price has none.
+func zonePrice(price *moneypb.Money, currency string) (money.Money, error) {
+	m := money.FromProto(price)
+	if price.GetCurrency() == "" {
+		m.Currency = currency
+	}
+	if m.Currency != currency {
+		return money.Money{}, errors.Newf("price must be in %s, the currency of the event", currency)
+	}
+	if m.Amount < 0 {
+		return money.Money{}, errors.New("price cannot be negative")
+	}
+	return m, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/event/internal/utils"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const notificationsQueue = "notifications"
+
+// OutboxStore exposes the event outbox table to the relay.
+type OutboxStore struct {
+	queries *db.Queries
+}
+
+func NewOutboxStore(queries *db.Queries) *OutboxStore {
+	return &OutboxStore{
+		queries: queries,
+	}
+}
+
+func (s *OutboxStore) ClaimOutboxMessages(ctx context.Context, limit int32, lockedUntil time.Time) ([]outbox.Message, error) {
+	rows, err := s.queries.ClaimOutboxMessages(ctx, db.ClaimOutboxMessagesParams{
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]outbox.Message, len(rows))
+	for i, row := range rows {
+		messages[i] = outbox.Message{
+			ID:       row.ID.String(),
+			Queue:    row.Queue,
+			Type:     row.Type,
+			Payload:  row.Payload,
+			Attempts: row.Attempts,
+		}
+	}
+	return messages, nil
+}
+
+func (s *OutboxStore) MarkOutboxMessagePublished(ctx context.Context, id string) error {
+	return s.queries.MarkOutboxMessagePublished(ctx, utils.ParsedUUID(id))
+}
+
+func (s *OutboxStore) MarkOutboxMessageFailed(ctx context.Context, id string, nextAttemptAt time.Time, cause error) error {
+	return s.queries.MarkOutboxMessageFailed(ctx, db.MarkOutboxMessageFailedParams{
+		ID:            utils.ParsedUUID(id),
+		LastError:     pgtype.Text{String: cause.Error(), Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+	})
+}
+
+func createOutboxMessage(ctx context.Context, queries *db.Queries, message outbox.Message) error {
+	return queries.CreateOutboxMessage(ctx, db.CreateOutboxMessageParams{
+		ID:      utils.ParsedUUID(message.ID),
+		Queue:   message.Queue,
+		Type:    message.Type,
+		Payload: message.Payload,
+	})
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/event/internal/utils"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *EventService) CreatePriceTier(ctx context.Context, req *eventpb.CreatePriceTierRequest) (*eventpb.CreatePriceTierResponse, error) {
+	eventZone, err := s.queries.GetEventZoneByID(ctx, utils.ParsedUUID(req.EventZoneId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event zone by ID")
+	}
+	event, err := s.queries.GetEventByID(ctx, eventZone.EventID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+	price, err := zonePrice(req.Price, event.Currency)
+	if err != nil {
+		return nil, err
+	}
+	startsAt, err := parseOptionalTime(req.StartsAt, "starts_at")
+	if err != nil {
+		return nil, err
+	}
+	endsAt, err := parseOptionalTime(req.EndsAt, "ends_at")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePriceTier(req.Name, startsAt, endsAt, req.SellThrough); err != nil {
+		return nil, err
+	}
+
+	id, err := s.queries.CreatePriceTier(ctx, db.CreatePriceTierParams{
+		EventZoneID: eventZone.ID,
+		Name:        req.Name,
+		Price:       price.Amount,
+		Currency:    price.Currency,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		SellThrough: req.SellThrough,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create price tier")
+	}
+
+	return &eventpb.CreatePriceTierResponse{
+		Id: uuid.UUID(id.Bytes).String(),
+	}, nil
+}
+
+func (s *EventService) UpdatePriceTier(ctx context.Context, req *eventpb.UpdatePriceTierRequest) (*eventpb.UpdatePriceTierResponse, error) {
+	priceTier, err := s.queries.GetPriceTierByID(ctx, utils.ParsedUUID(req.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get price tier by ID")
+	}
+	eventZone, err := s.queries.GetEventZoneByID(ctx, priceTier.EventZoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event zone by ID")
+	}
+	event, err := s.queries.GetEventByID(ctx, eventZone.EventID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+
+	params := db.UpdatePriceTierParams{
+		ID:          priceTier.ID,
+		Name:        priceTier.Name,
+		Price:       priceTier.Price,
+		Currency:    priceTier.Currency,
+		StartsAt:    priceTier.StartsAt,
+		EndsAt:      priceTier.EndsAt,
+		SellThrough: priceTier.SellThrough,
+	}
+	if req.Name != nil {
+		params.Name = *req.Name
+	}
+	if req.Price != nil {
+		price, err := zonePrice(req.Price, event.Currency)
+		if err != nil {
+			return nil, err
+		}
+		params.Price = price.Amount
+		params.Currency = price.Currency
+	} else if priceTier.Currency != event.Currency {
+		return nil, errors.Newf("price tier is priced in %s, give a price in %s to keep selling it", priceTier.Currency, event.Currency)
+	}
+	if req.StartsAt != nil {
+		params.StartsAt, err = parseOptionalTime(*req.StartsAt, "starts_at")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.EndsAt != nil {
+		params.EndsAt, err = parseOptionalTime(*req.EndsAt, "ends_at")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.SellThrough != nil {
+		params.SellThrough = *req.SellThrough
+	}
+
+	if err := validatePriceTier(params.Name, params.StartsAt, params.EndsAt, params.SellThrough); err != nil {
+		return nil, err
+	}
+
+	_, err = s.queries.UpdatePriceTier(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update price tier")
+	}
+
+	return &eventpb.UpdatePriceTierResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *EventService) DeletePriceTier(ctx context.Context, req *eventpb.DeletePriceTierRequest) (*eventpb.Empty, error) {
+	_, err := s.queries.DeletePriceTier(ctx, utils.ParsedUUID(req.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete price tier")
+	}
+
+	return &eventpb.Empty{}, nil
+}
+
+// validatePriceTier makes sure a tier has a start and ends somehow, a tier without an end time or a
+// sell-through would shadow every tier after it
+func validatePriceTier(name string, startsAt, endsAt pgtype.Timestamptz, sellThrough int32) error {
+	if name == "" {
+		return errors.New("price tiers need a name")
+	}
+	if !startsAt.Valid {
+		return errors.New("price tiers need a start time")
+	}
+	if sellThrough < 0 {
+		return errors.New("sell-through cannot be negative")
+	}
+	if !endsAt.Valid && sellThrough == 0 {
+		return errors.New("price tiers need an end time or a sell-through")
+	}
+	if endsAt.Valid && !endsAt.Time.After(startsAt.Time) {
+		return errors.New("price tiers must end after they start")
+	}
+	return nil
+}
+
+func toPriceTierProto(priceTier db.ListPriceTiersByEventIDRow) *eventpb.PriceTier {
+	return &eventpb.PriceTier{
+		Id:          priceTier.ID.String(),
+		EventZoneId: priceTier.EventZoneID.String(),
+		ZoneNumber:  priceTier.ZoneNumber,
+		Name:        priceTier.Name,
+		Price:       money.ToProto(money.New(priceTier.Price, priceTier.Currency)),
+		StartsAt:    formatOptionalTime(priceTier.StartsAt),
+		EndsAt:      formatOptionalTime(priceTier.EndsAt),
+		SellThrough: priceTier.SellThrough,
+	}
+}
@@ -5,16 +5,19 @@ import (
 
 	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type EventService struct {
 	eventpb.UnimplementedEventServiceServer
 	mu      sync.Mutex
 	queries *db.Queries
+	pool    *pgxpool.Pool
 }
 
-func NewEventService(queries *db.Queries) *EventService {
+func NewEventService(queries *db.Queries, pool *pgxpool.Pool) *EventService {
 	return &EventService{
 		queries: queries,
+		pool:    pool,
 	}
 }
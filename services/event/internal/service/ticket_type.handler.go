@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	db "github.com/cp-rektmart/aconcert-microservice/event/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/event/internal/utils"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	"github.com/google/uuid"
+)
+
+func (s *EventService) CreateTicketType(ctx context.Context, req *eventpb.CreateTicketTypeRequest) (*eventpb.CreateTicketTypeResponse, error) {
+	if err := validateTicketType(req.Name, req.QuantityCap); err != nil {
+		return nil, err
+	}
+	eventZone, err := s.queries.GetEventZoneByID(ctx, utils.ParsedUUID(req.EventZoneId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event zone by ID")
+	}
+	event, err := s.queries.GetEventByID(ctx, eventZone.EventID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+	price, err := zonePrice(req.Price, event.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.queries.CreateTicketType(ctx, db.CreateTicketTypeParams{
+		EventZoneID:          eventZone.ID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Price:                price.Amount,
+		Currency:             price.Currency,
+		QuantityCap:          req.QuantityCap,
+		RequiresVerification: req.RequiresVerification,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ticket type")
+	}
+
+	return &eventpb.CreateTicketTypeResponse{
+		Id: uuid.UUID(id.Bytes).String(),
+	}, nil
+}
+
+func (s *EventService) UpdateTicketType(ctx context.Context, req *eventpb.UpdateTicketTypeRequest) (*eventpb.UpdateTicketTypeResponse, error) {
+	ticketType, err := s.queries.GetTicketTypeByID(ctx, utils.ParsedUUID(req.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ticket type by ID")
+	}
+	eventZone, err := s.queries.GetEventZoneByID(ctx, ticketType.EventZoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event zone by ID")
+	}
+	event, err := s.queries.GetEventByID(ctx, eventZone.EventID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event")
+	}
+
+	params := db.UpdateTicketTypeParams{
+		ID:                   ticketType.ID,
+		Name:                 ticketType.Name,
+		Description:          ticketType.Description,
+		Price:                ticketType.Price,
+		Currency:             ticketType.Currency,
+		QuantityCap:          ticketType.QuantityCap,
+		RequiresVerification: ticketType.RequiresVerification,
+	}
+	if req.Name != nil {
+		params.Name = *req.Name
+	}
+	if req.Description != nil {
+		params.Description = *req.Description
+	}
+	if req.Price != nil {
+		price, err := zonePrice(req.Price, event.Currency)
+		if err != nil {
+			return nil, err
+		}
+		params.Price = price.Amount
+		params.Currency = price.Currency
+	} else if ticketType.Currency != event.Currency {
+		return nil, errors.Newf("ticket type is priced in %s, give a price in %s to keep selling it", ticketType.Currency, event.Currency)
+	}
+	if req.QuantityCap != nil {
+		params.QuantityCap = *req.QuantityCap
+	}
+	if req.RequiresVerification != nil {
+		params.RequiresVerification = *req.RequiresVerification
+	}
+
+	if err := validateTicketType(params.Name, params.QuantityCap); err != nil {
+		return nil, err
+	}
+
+	_, err = s.queries.UpdateTicketType(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update ticket type")
+	}
+
+	return &eventpb.UpdateTicketTypeResponse{
+		Id: req.Id,
+	}, nil
+}
+
+func (s *EventService) DeleteTicketType(ctx context.Context, req *eventpb.DeleteTicketTypeRequest) (*eventpb.Empty, error) {
+	_, err := s.queries.DeleteTicketType(ctx, utils.ParsedUUID(req.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete ticket type")
+	}
+
+	return &eventpb.Empty{}, nil
+}
+
+func validateTicketType(name string, quantityCap int32) error {
+	if name == "" {
+		return errors.New("ticket types need a name")
+	}
+	if quantityCap < 0 {
+		return errors.New("quantity cap cannot be negative")
+	}
+	return nil
+}
+
+func toTicketTypeProto(ticketType db.ListTicketTypesByEventIDRow) *eventpb.TicketType {
+	return &eventpb.TicketType{
+		Id:                   ticketType.ID.String(),
+		EventZoneId:          ticketType.EventZoneID.String(),
+		ZoneNumber:           ticketType.ZoneNumber,
+		Name:                 ticketType.Name,
+		Description:          ticketType.Description,
+		Price:                money.ToProto(money.New(ticketType.Price, ticketType.Currency)),
+		QuantityCap:          ticketType.QuantityCap,
+		RequiresVerification: ticketType.RequiresVerification,
+	}
+}
@@ -13,14 +13,21 @@ import (
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/config"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/auth"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/ballot"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/event"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/location"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/promotion"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/reservation"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/ticket"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/waitingroom"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/waitlist"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/admission"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
 	locationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/location"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/realtime"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/redis"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/requestlogger"
 	"github.com/gofiber/fiber/v2"
@@ -60,6 +67,21 @@ func main() {
 		}
 	}()
 
+	waitingRoomRedisClient, err := redis.New(ctx, conf.WaitingRoomRedis)
+	if err != nil {
+		logger.PanicContext(ctx, "failed to connect to waiting room redis", slog.Any("error", err))
+	}
+	defer func() {
+		if err := waitingRoomRedisClient.Close(); err != nil {
+			logger.ErrorContext(ctx, "failed to close waiting room redis connection", slog.Any("error", err))
+		}
+	}()
+
+	passSigner, err := admission.NewSigner(conf.WaitingRoom.PassSecret)
+	if err != nil {
+		logger.PanicContext(ctx, "failed to load waiting room pass secret", slog.Any("error", err))
+	}
+
 	app := fiber.New(fiber.Config{
 		AppName:       conf.Name,
 		BodyLimit:     conf.MaxBodyLimit * 1024 * 1024,
@@ -83,6 +105,7 @@ func main() {
 		Use(requestlogger.New())
 
 	authMiddleware := authentication.NewAuthMiddleware(&conf.JWT, authRedisClient)
+	passMiddleware := admission.NewPassMiddleware(passSigner, waitingRoomRedisClient, authMiddleware)
 
 	authService := auth.NewService(conf.AuthClientBaseURL)
 	authHandler := auth.NewHandler(authService, authMiddleware)
@@ -109,13 +132,31 @@ func main() {
 	}
 	reservationClient := reservationpb.NewReservationServiceClient(reservationConn)
 	reservationService := reservation.NewService(reservationClient)
-	reservationHandler := reservation.NewHandler(reservationService, authMiddleware)
+	reservationHandler := reservation.NewHandler(reservationService, authMiddleware, passMiddleware)
+	ticketService := ticket.NewService(reservationClient, authService)
+	ticketHandler := ticket.NewHandler(ticketService, authMiddleware)
+	waitlistService := waitlist.NewService(reservationClient)
+	waitlistHandler := waitlist.NewHandler(waitlistService, authMiddleware)
+	ballotService := ballot.NewService(reservationClient)
+	ballotHandler := ballot.NewHandler(ballotService, authMiddleware)
+	promotionService := promotion.NewService(reservationClient, authService)
+	promotionHandler := promotion.NewHandler(promotionService, authMiddleware)
+	waitingRoomService := waitingroom.NewService(conf.WaitingRoom, waitingRoomRedisClient, passSigner, realtime.New(&conf.Realtime), authService)
+	waitingRoomHandler := waitingroom.NewHandler(waitingRoomService, authMiddleware)
 
 	v1 := app.Group("/v1")
 	authHandler.Mount(v1)
 	eventHandler.Mount(v1)
 	locationHandler.Mount(v1)
 	reservationHandler.Mount(v1)
+	ticketHandler.Mount(v1)
+	waitlistHandler.Mount(v1)
+	ballotHandler.Mount(v1)
+	promotionHandler.Mount(v1)
+	waitingRoomHandler.Mount(v1)
+
+	// Admit waiting room batches in background, only the elected replica does the work
+	go waitingRoomService.StartAdmitting(ctx)
 
 	swag.Register(docs.SwaggerInfo.InfoInstanceName, docs.SwaggerInfo)
 	if conf.Environment != "production" {
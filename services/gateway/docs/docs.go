@@ -6,10 +6,10 @@ import "github.com/swaggo/swag/v2"
 
 const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
-    "components": {"schemas":{"dto.ConfirmReservationResponse":{"properties":{"id":{"type":"string"},"message":{"type":"string"},"success":{"type":"boolean"}},"required":["id","message","success"],"type":"object"},"dto.CreateEventRequest":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"description":{"type":"string"},"eventDate":{"type":"string"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"name":{"type":"string"},"thumbnail":{"type":"string"}},"required":["artist","description","eventDate","images","locationId","name","thumbnail"],"type":"object"},"dto.CreateEventResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateEventZoneRequest":{"properties":{"color":{"type":"string"},"description":{"type":"string"},"eventID":{"type":"string"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"type":"number"},"zoneNumber":{"type":"integer"}},"required":["color","description","eventID","locationId","name","price","zoneNumber"],"type":"object"},"dto.CreateEventZoneResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateLocationRequest":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.CreateLocationZoneRequest"},"type":"array","uniqueItems":false}},"required":["city","country","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.CreateLocationResponse":{"properties":{"id":{"type":"string"}},"type":"object"},"dto.CreateLocationZoneRequest":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["capacity","numberOfRows","seatsPerRow","zoneName","zoneNumber"],"type":"object"},"dto.CreateReservationRequest":{"properties":{"eventId":{"type":"string"},"seats":{"items":{"$ref":"#/components/schemas/dto.CreateReservationSeatDTO"},"minItems":1,"type":"array","uniqueItems":false}},"required":["eventId","seats"],"type":"object"},"dto.CreateReservationResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateReservationSeatDTO":{"properties":{"column":{"type":"integer"},"row":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["column","row","zoneNumber"],"type":"object"},"dto.DeleteReservationResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.EventListResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.EventResponse"},"type":"array","uniqueItems":false}},"required":["list"],"type":"object"},"dto.EventResponse":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"createdAt":{"type":"string"},"description":{"type":"string"},"eventDate":{"type":"string"},"id":{"type":"string"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"name":{"type":"string"},"thumbnail":{"type":"string"},"updatedAt":{"type":"string"}},"required":["artist","createdAt","description","eventDate","id","images","locationId","name","thumbnail","updatedAt"],"type":"object"},"dto.EventZoneListResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.EventZoneResponse"},"type":"array","uniqueItems":false}},"required":["list"],"type":"object"},"dto.EventZoneResponse":{"properties":{"color":{"type":"string"},"description":{"type":"string"},"eventId":{"type":"string"},"id":{"type":"string"},"isSoldOut":{"type":"boolean"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"type":"number"},"zoneNumber":{"type":"integer"}},"required":["color","description","eventId","id","isSoldOut","locationId","name","price","zoneNumber"],"type":"object"},"dto.GetEventSeatsResponse":{"properties":{"seats":{"items":{"$ref":"#/components/schemas/dto.SeatStatusDTO"},"type":"array","uniqueItems":false}},"required":["seats"],"type":"object"},"dto.GetReservationResponse":{"properties":{"eventId":{"type":"string"},"id":{"type":"string"},"seats":{"items":{"$ref":"#/components/schemas/dto.SeatDTO"},"type":"array","uniqueItems":false},"status":{"type":"string"},"stripeClientSecret":{"type":"string"},"timeLeft":{"type":"number"},"totalPrice":{"type":"number"},"userId":{"type":"string"}},"required":["eventId","id","seats","status","stripeClientSecret","timeLeft","totalPrice","userId"],"type":"object"},"dto.HttpError":{"properties":{"error":{"type":"string"}},"required":["error"],"type":"object"},"dto.HttpResponse-dto_ConfirmReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ConfirmReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateEventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateEventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateEventZoneResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateEventZoneResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateLocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateLocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_DeleteReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.DeleteReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventListResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventListResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventZoneListResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventZoneListResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_GetEventSeatsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.GetEventSeatsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_GetReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.GetReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListLocationsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListLocationsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_LocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.LocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_LoginResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.LoginResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_RefreshTokenResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.RefreshTokenResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateEventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateEventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateEventZoneResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateEventZoneResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateLocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateLocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UserResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UserResponse"}},"required":["result"],"type":"object"},"dto.ListLocationsResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.LocationResponse"},"type":"array","uniqueItems":false}},"type":"object"},"dto.ListReservationResponse":{"properties":{"reservations":{"items":{"$ref":"#/components/schemas/dto.GetReservationResponse"},"type":"array","uniqueItems":false}},"required":["reservations"],"type":"object"},"dto.LocationResponse":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"id":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.ZoneResponse"},"type":"array","uniqueItems":false}},"required":["city","country","id","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.LoginRequest":{"properties":{"idToken":{"type":"string"},"provider":{"type":"string"}},"type":"object"},"dto.LoginResponse":{"properties":{"accessToken":{"type":"string"},"exp":{"type":"integer"},"isNewUser":{"type":"boolean"},"refreshToken":{"type":"string"},"user":{"$ref":"#/components/schemas/dto.UserResponse"}},"required":["accessToken","exp","isNewUser","refreshToken","user"],"type":"object"},"dto.RefreshTokenRequest":{"properties":{"refreshToken":{"type":"string"}},"required":["refreshToken"],"type":"object"},"dto.RefreshTokenResponse":{"properties":{"accessToken":{"type":"string"},"exp":{"type":"integer"},"refreshToken":{"type":"string"}},"required":["accessToken","exp","refreshToken"],"type":"object"},"dto.SeatDTO":{"properties":{"column":{"type":"integer"},"price":{"type":"number"},"row":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["column","row","zoneNumber"],"type":"object"},"dto.SeatStatusDTO":{"properties":{"column":{"type":"integer"},"row":{"type":"integer"},"status":{"description":"\"PENDING\" or \"RESERVED\"","type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","row","status","zoneNumber"],"type":"object"},"dto.UpdateEventRequest":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"description":{"type":"string"},"eventDate":{"type":"string"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"name":{"type":"string"},"thumbnail":{"type":"string"}},"type":"object"},"dto.UpdateEventResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UpdateEventZoneRequest":{"properties":{"color":{"type":"string"},"description":{"type":"string"},"eventId":{"type":"string"},"isSoldOut":{"type":"boolean"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"type":"number"},"zoneNumber":{"type":"integer"}},"type":"object"},"dto.UpdateEventZoneResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UpdateLocationRequest":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.UpdateLocationZoneRequest"},"type":"array","uniqueItems":false}},"required":["city","country","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.UpdateLocationResponse":{"properties":{"id":{"type":"string"}},"type":"object"},"dto.UpdateLocationZoneRequest":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["capacity","numberOfRows","seatsPerRow","zoneName","zoneNumber"],"type":"object"},"dto.UpdateProfileRequest":{"properties":{"birthdate":{"type":"string"},"firstname":{"type":"string"},"lastname":{"type":"string"},"phone":{"type":"string"},"profileImage":{"type":"string"}},"required":["birthdate","firstname","lastname","phone","profileImage"],"type":"object"},"dto.UserResponse":{"properties":{"birthdate":{"type":"string"},"createdAt":{"type":"string"},"deletedAt":{"type":"string"},"email":{"type":"string"},"firstname":{"type":"string"},"id":{"type":"string"},"lastname":{"type":"string"},"phone":{"type":"string"},"profileImage":{"type":"string"},"provider":{"type":"string"},"role":{"type":"string"},"updatedAt":{"type":"string"}},"required":["birthdate","createdAt","email","firstname","id","lastname","phone","profileImage","provider","role","updatedAt"],"type":"object"},"dto.ZoneResponse":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["capacity","numberOfRows","seatsPerRow","zoneName","zoneNumber"],"type":"object"}},"securitySchemes":{"ApiKeyAuth":{"in":"header","name":"Authorization","type":"apiKey"}}},
+    "components": {"schemas":{"dto.AcceptTicketTransferResponse":{"properties":{"ticket":{"$ref":"#/components/schemas/dto.TicketDTO"},"transfer":{"$ref":"#/components/schemas/dto.TicketTransferDTO"}},"required":["ticket","transfer"],"type":"object"},"dto.AppliedPromotionDTO":{"properties":{"amount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"code":{"type":"string"}},"required":["amount","code"],"type":"object"},"dto.ApplyBallotRequest":{"properties":{"eventId":{"type":"string"},"quantity":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["eventId","quantity","zoneNumber"],"type":"object"},"dto.BallotApplicationDTO":{"properties":{"createdAt":{"type":"string"},"drawPosition":{"description":"DrawPosition is the place of the application in the draw order","type":"integer"},"eventId":{"type":"string"},"id":{"type":"string"},"quantity":{"type":"integer"},"reservationId":{"description":"ReservationID is the reservation holding the seats won, to pay before the payment deadline","type":"string"},"status":{"description":"Status is APPLIED until the draw, then WON or LOST, or WITHDRAWN","type":"string"},"zoneNumber":{"type":"integer"}},"required":["createdAt","eventId","id","quantity","status","zoneNumber"],"type":"object"},"dto.BallotDrawDTO":{"properties":{"applicationIds":{"items":{"type":"string"},"type":"array","uniqueItems":false},"completedAt":{"type":"string"},"drawnAt":{"type":"string"},"eventId":{"type":"string"},"seed":{"description":"Seed is a decimal int64, kept as a string so it survives JSON number precision","type":"string"}},"required":["applicationIds","drawnAt","eventId","seed"],"type":"object"},"dto.CancelTicketsRequest":{"properties":{"ticketIds":{"items":{"type":"string"},"type":"array","uniqueItems":false}},"required":["ticketIds"],"type":"object"},"dto.CancelTicketsResponse":{"properties":{"id":{"type":"string"},"refundedAmount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"totalPrice":{"$ref":"#/components/schemas/dto.MoneyDTO"}},"required":["id","refundedAmount","totalPrice"],"type":"object"},"dto.CheckInTicketRequest":{"properties":{"credential":{"type":"string"},"eventId":{"type":"string"},"gateId":{"type":"string"}},"required":["credential","eventId","gateId"],"type":"object"},"dto.CheckInTicketResponse":{"properties":{"checkedInAt":{"type":"string"},"column":{"type":"integer"},"eventId":{"type":"string"},"gateId":{"type":"string"},"requiresVerification":{"description":"RequiresVerification asks staff to check the holder is eligible for the ticket type, a student card for example","type":"boolean"},"row":{"type":"integer"},"status":{"type":"string"},"ticketId":{"type":"string"},"ticketTypeId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["checkedInAt","column","eventId","gateId","row","status","ticketId","zoneNumber"],"type":"object"},"dto.ConfirmReservationResponse":{"properties":{"id":{"type":"string"},"message":{"type":"string"},"success":{"type":"boolean"}},"required":["id","message","success"],"type":"object"},"dto.CreateEventRequest":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"ballotClosesAt":{"type":"string"},"ballotOpensAt":{"type":"string"},"ballotPaymentHours":{"type":"integer"},"currency":{"enum":["THB","SGD","JPY"],"type":"string"},"description":{"type":"string"},"eventDate":{"type":"string"},"fees":{"$ref":"#/components/schemas/dto.EventFeesDTO"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"maxHoldExtensionSeconds":{"type":"integer"},"maxTicketsPerUser":{"type":"integer"},"name":{"type":"string"},"refundDeadlineHours":{"type":"integer"},"refundPercentage":{"type":"integer"},"thumbnail":{"type":"string"},"transferCutoffHours":{"type":"integer"}},"required":["artist","description","eventDate","images","locationId","name","thumbnail"],"type":"object"},"dto.CreateEventResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateEventZoneRequest":{"properties":{"capacity":{"type":"integer"},"color":{"type":"string"},"description":{"type":"string"},"eventID":{"type":"string"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"zoneNumber":{"type":"integer"},"zoneType":{"enum":["SEATED","GENERAL_ADMISSION"],"type":"string"}},"required":["color","description","eventID","locationId","name","price","zoneNumber"],"type":"object"},"dto.CreateEventZoneResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateLocationRequest":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.CreateLocationZoneRequest"},"type":"array","uniqueItems":false}},"required":["city","country","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.CreateLocationResponse":{"properties":{"id":{"type":"string"}},"type":"object"},"dto.CreateLocationZoneRequest":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"},"zoneType":{"enum":["SEATED","GENERAL_ADMISSION"],"type":"string"}},"required":["capacity","zoneName","zoneNumber"],"type":"object"},"dto.CreatePriceTierRequest":{"properties":{"endsAt":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"sellThrough":{"minimum":0,"type":"integer"},"startsAt":{"type":"string"}},"required":["name","price","startsAt"],"type":"object"},"dto.CreatePriceTierResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreatePromotionRequest":{"properties":{"amountOff":{"$ref":"#/components/schemas/dto.MoneyDTO"},"code":{"type":"string"},"discountType":{"description":"DiscountType is PERCENTAGE or FIXED, a fixed amount is taken off the reservation once","type":"string"},"endsAt":{"type":"string"},"eventId":{"type":"string"},"maxRedemptions":{"description":"MaxRedemptions and MaxRedemptionsPerUser are unlimited when 0","type":"integer"},"maxRedemptionsPerUser":{"type":"integer"},"percentOff":{"description":"PercentOff is required for PERCENTAGE codes, above 0 and at most 100","type":"number"},"stackable":{"description":"Stackable codes can be combined with other stackable codes","type":"boolean"},"startsAt":{"description":"StartsAt and EndsAt are RFC3339, the code is valid from and until when empty","type":"string"},"zoneNumber":{"type":"integer"}},"required":["code","discountType"],"type":"object"},"dto.CreateReservationRequest":{"properties":{"eventId":{"type":"string"},"generalAdmission":{"items":{"$ref":"#/components/schemas/dto.GeneralAdmissionDTO"},"type":"array","uniqueItems":false},"promoCodes":{"items":{"type":"string"},"maxItems":3,"type":"array","uniqueItems":false},"seats":{"items":{"$ref":"#/components/schemas/dto.CreateReservationSeatDTO"},"type":"array","uniqueItems":false}},"required":["eventId"],"type":"object"},"dto.CreateReservationResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.CreateReservationSeatDTO":{"properties":{"column":{"type":"integer"},"row":{"type":"integer"},"ticketTypeId":{"description":"a ticket type of the zone, the seat costs the zone price when it is empty","type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","row","zoneNumber"],"type":"object"},"dto.CreateTicketTypeRequest":{"properties":{"description":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"quantityCap":{"minimum":0,"type":"integer"},"requiresVerification":{"type":"boolean"}},"required":["name","price"],"type":"object"},"dto.CreateTicketTypeResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.DeactivatePromotionResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.DeleteReservationResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.EventFeesDTO":{"properties":{"bookingFee":{"type":"integer"},"processingFee":{"type":"integer"},"vatInclusive":{"type":"boolean"},"vatRate":{"type":"number"}},"type":"object"},"dto.EventListResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.EventResponse"},"type":"array","uniqueItems":false}},"required":["list"],"type":"object"},"dto.EventResponse":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"ballotClosesAt":{"type":"string"},"ballotOpensAt":{"type":"string"},"ballotPaymentHours":{"type":"integer"},"createdAt":{"type":"string"},"currency":{"type":"string"},"description":{"type":"string"},"eventDate":{"type":"string"},"fees":{"$ref":"#/components/schemas/dto.EventFeesDTO"},"id":{"type":"string"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"maxHoldExtensionSeconds":{"type":"integer"},"maxTicketsPerUser":{"type":"integer"},"name":{"type":"string"},"refundDeadlineHours":{"type":"integer"},"refundPercentage":{"type":"integer"},"thumbnail":{"type":"string"},"transferCutoffHours":{"type":"integer"},"updatedAt":{"type":"string"}},"required":["artist","createdAt","currency","description","eventDate","id","images","locationId","maxHoldExtensionSeconds","maxTicketsPerUser","name","refundDeadlineHours","refundPercentage","thumbnail","transferCutoffHours","updatedAt"],"type":"object"},"dto.EventZoneListResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.EventZoneResponse"},"type":"array","uniqueItems":false}},"required":["list"],"type":"object"},"dto.EventZoneResponse":{"properties":{"capacity":{"type":"integer"},"color":{"type":"string"},"description":{"type":"string"},"eventId":{"type":"string"},"id":{"type":"string"},"isSoldOut":{"type":"boolean"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"priceTiers":{"items":{"$ref":"#/components/schemas/dto.PriceTierResponse"},"type":"array","uniqueItems":false},"ticketTypes":{"items":{"$ref":"#/components/schemas/dto.TicketTypeResponse"},"type":"array","uniqueItems":false},"zoneNumber":{"type":"integer"},"zoneType":{"type":"string"}},"required":["color","description","eventId","id","isSoldOut","locationId","name","price","zoneNumber","zoneType"],"type":"object"},"dto.ExtendReservationRequest":{"properties":{"seconds":{"type":"integer"}},"type":"object"},"dto.ExtendReservationResponse":{"properties":{"id":{"type":"string"},"stripeClientSecret":{"description":"StripeClientSecret opens the new checkout session the extended hold is paid on","type":"string"},"timeLeft":{"type":"number"}},"required":["id","stripeClientSecret","timeLeft"],"type":"object"},"dto.GeneralAdmissionDTO":{"properties":{"quantity":{"minimum":1,"type":"integer"},"ticketTypeId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["quantity","zoneNumber"],"type":"object"},"dto.GeneralAdmissionStatusDTO":{"properties":{"capacity":{"type":"integer"},"taken":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["capacity","taken","zoneNumber"],"type":"object"},"dto.GetEventPricingResponse":{"properties":{"resolvedAt":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.ZonePricingDTO"},"type":"array","uniqueItems":false}},"required":["resolvedAt","zones"],"type":"object"},"dto.GetEventSeatsResponse":{"properties":{"generalAdmission":{"items":{"$ref":"#/components/schemas/dto.GeneralAdmissionStatusDTO"},"type":"array","uniqueItems":false},"seats":{"items":{"$ref":"#/components/schemas/dto.SeatStatusDTO"},"type":"array","uniqueItems":false}},"required":["generalAdmission","seats"],"type":"object"},"dto.GetReservationResponse":{"properties":{"breakdown":{"$ref":"#/components/schemas/dto.PriceBreakdownDTO"},"currency":{"type":"string"},"eventId":{"type":"string"},"history":{"items":{"$ref":"#/components/schemas/dto.TicketHistoryDTO"},"type":"array","uniqueItems":false},"id":{"type":"string"},"lineItems":{"items":{"$ref":"#/components/schemas/dto.LineItemDTO"},"type":"array","uniqueItems":false},"promotions":{"items":{"$ref":"#/components/schemas/dto.AppliedPromotionDTO"},"type":"array","uniqueItems":false},"seats":{"items":{"$ref":"#/components/schemas/dto.SeatDTO"},"type":"array","uniqueItems":false},"status":{"type":"string"},"stripeClientSecret":{"type":"string"},"timeLeft":{"type":"number"},"totalPrice":{"$ref":"#/components/schemas/dto.MoneyDTO"},"userId":{"type":"string"}},"required":["currency","eventId","id","seats","status","stripeClientSecret","timeLeft","totalPrice","userId"],"type":"object"},"dto.HttpError":{"properties":{"error":{"type":"string"}},"required":["error"],"type":"object"},"dto.HttpResponse-dto_AcceptTicketTransferResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.AcceptTicketTransferResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_BallotApplicationDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.BallotApplicationDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_BallotDrawDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.BallotDrawDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CancelTicketsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CancelTicketsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CheckInTicketResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CheckInTicketResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ConfirmReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ConfirmReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateEventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateEventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateEventZoneResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateEventZoneResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateLocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateLocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreatePriceTierResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreatePriceTierResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_CreateTicketTypeResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.CreateTicketTypeResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_DeactivatePromotionResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.DeactivatePromotionResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_DeleteReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.DeleteReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventListResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventListResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_EventZoneListResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.EventZoneListResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ExtendReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ExtendReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_GetEventPricingResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.GetEventPricingResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_GetEventSeatsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.GetEventSeatsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_GetReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.GetReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_LeaveWaitlistResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.LeaveWaitlistResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListBallotApplicationsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListBallotApplicationsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListLocationsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListLocationsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListPromotionsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListPromotionsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListTicketTransfersResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListTicketTransfersResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListTicketsResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListTicketsResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ListWaitlistEntriesResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ListWaitlistEntriesResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_LocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.LocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_LoginResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.LoginResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_PromotionDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.PromotionDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_RefreshTokenResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.RefreshTokenResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_RefundReservationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.RefundReservationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ReserveBestAvailableResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ReserveBestAvailableResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_ScanBundleResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.ScanBundleResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_TicketTransferDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.TicketTransferDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateEventResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateEventResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateEventZoneResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateEventZoneResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateLocationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateLocationResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdatePriceTierResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdatePriceTierResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UpdateTicketTypeResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UpdateTicketTypeResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UploadScansResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UploadScansResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_UserResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.UserResponse"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_WaitingRoomDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.WaitingRoomDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_WaitingRoomStatusDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.WaitingRoomStatusDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_WaitlistEntryDTO":{"properties":{"result":{"$ref":"#/components/schemas/dto.WaitlistEntryDTO"}},"required":["result"],"type":"object"},"dto.HttpResponse-dto_WithdrawBallotApplicationResponse":{"properties":{"result":{"$ref":"#/components/schemas/dto.WithdrawBallotApplicationResponse"}},"required":["result"],"type":"object"},"dto.JoinWaitlistRequest":{"properties":{"eventId":{"type":"string"},"quantity":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["eventId","quantity"],"type":"object"},"dto.LeaveWaitlistResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.LineItemDTO":{"properties":{"amount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"description":{"type":"string"},"kind":{"enum":["TICKET","DISCOUNT","FEE","TAX"],"type":"string"},"name":{"type":"string"},"quantity":{"type":"integer"},"unitAmount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"zoneNumber":{"type":"integer"}},"required":["amount","kind","name","quantity","unitAmount"],"type":"object"},"dto.ListBallotApplicationsResponse":{"properties":{"applications":{"items":{"$ref":"#/components/schemas/dto.BallotApplicationDTO"},"type":"array","uniqueItems":false}},"required":["applications"],"type":"object"},"dto.ListLocationsResponse":{"properties":{"list":{"items":{"$ref":"#/components/schemas/dto.LocationResponse"},"type":"array","uniqueItems":false}},"type":"object"},"dto.ListPromotionsResponse":{"properties":{"promotions":{"items":{"$ref":"#/components/schemas/dto.PromotionDTO"},"type":"array","uniqueItems":false}},"required":["promotions"],"type":"object"},"dto.ListReservationResponse":{"properties":{"reservations":{"items":{"$ref":"#/components/schemas/dto.GetReservationResponse"},"type":"array","uniqueItems":false}},"required":["reservations"],"type":"object"},"dto.ListTicketTransfersResponse":{"properties":{"transfers":{"items":{"$ref":"#/components/schemas/dto.TicketTransferDTO"},"type":"array","uniqueItems":false}},"required":["transfers"],"type":"object"},"dto.ListTicketsResponse":{"properties":{"tickets":{"items":{"$ref":"#/components/schemas/dto.TicketDTO"},"type":"array","uniqueItems":false}},"required":["tickets"],"type":"object"},"dto.ListWaitlistEntriesResponse":{"properties":{"entries":{"items":{"$ref":"#/components/schemas/dto.WaitlistEntryDTO"},"type":"array","uniqueItems":false}},"required":["entries"],"type":"object"},"dto.LocationResponse":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"id":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.ZoneResponse"},"type":"array","uniqueItems":false}},"required":["city","country","id","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.LoginRequest":{"properties":{"idToken":{"type":"string"},"provider":{"type":"string"}},"type":"object"},"dto.LoginResponse":{"properties":{"accessToken":{"type":"string"},"exp":{"type":"integer"},"isNewUser":{"type":"boolean"},"refreshToken":{"type":"string"},"user":{"$ref":"#/components/schemas/dto.UserResponse"}},"required":["accessToken","exp","isNewUser","refreshToken","user"],"type":"object"},"dto.MoneyDTO":{"description":"AmountOff is the amount a FIXED code takes off","properties":{"amount":{"type":"integer"},"currency":{"type":"string"}},"required":["amount","currency"],"type":"object"},"dto.OfferTicketTransferRequest":{"properties":{"email":{"type":"string"}},"required":["email"],"type":"object"},"dto.OfflineScanDTO":{"properties":{"credential":{"type":"string"},"gateId":{"type":"string"},"scannedAt":{"type":"string"}},"required":["credential","gateId","scannedAt"],"type":"object"},"dto.OpenWaitingRoomRequest":{"properties":{"batchSize":{"description":"BatchSize is the number of users admitted on every admission round","minimum":1,"type":"integer"},"maxAdmitted":{"description":"MaxAdmitted caps the users holding a live pass at once, 0 for no cap","type":"integer"},"opensAt":{"description":"OpensAt is when the sale opens and admission starts, RFC3339","type":"string"},"order":{"description":"Order is RANDOM to draw the positions of users who joined before the sale opened, or FIRST_COME","enum":["RANDOM","FIRST_COME"],"type":"string"}},"required":["batchSize","opensAt","order"],"type":"object"},"dto.PriceBreakdownDTO":{"properties":{"bookingFee":{"$ref":"#/components/schemas/dto.MoneyDTO"},"discount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"processingFee":{"$ref":"#/components/schemas/dto.MoneyDTO"},"subtotal":{"$ref":"#/components/schemas/dto.MoneyDTO"},"tax":{"$ref":"#/components/schemas/dto.MoneyDTO"},"total":{"$ref":"#/components/schemas/dto.MoneyDTO"},"vatInclusive":{"type":"boolean"},"vatRate":{"type":"number"}},"required":["bookingFee","discount","processingFee","subtotal","tax","total","vatInclusive","vatRate"],"type":"object"},"dto.PriceTierResponse":{"properties":{"endsAt":{"type":"string"},"eventZoneId":{"type":"string"},"id":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"sellThrough":{"type":"integer"},"startsAt":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["eventZoneId","id","name","price","startsAt","zoneNumber"],"type":"object"},"dto.PromotionDTO":{"properties":{"active":{"type":"boolean"},"amountOff":{"$ref":"#/components/schemas/dto.MoneyDTO"},"code":{"type":"string"},"createdAt":{"type":"string"},"discountType":{"type":"string"},"endsAt":{"type":"string"},"eventId":{"description":"EventID limits the code to one event, every event when empty","type":"string"},"id":{"type":"string"},"maxRedemptions":{"description":"MaxRedemptions and MaxRedemptionsPerUser are unlimited when 0","type":"integer"},"maxRedemptionsPerUser":{"type":"integer"},"percentOff":{"description":"PercentOff is the rate of a PERCENTAGE code","type":"number"},"redemptionCount":{"type":"integer"},"stackable":{"type":"boolean"},"startsAt":{"type":"string"},"zoneNumber":{"description":"ZoneNumber limits the code to one zone of its event","type":"integer"}},"required":["code","createdAt","discountType","id"],"type":"object"},"dto.RefreshTokenRequest":{"properties":{"refreshToken":{"type":"string"}},"required":["refreshToken"],"type":"object"},"dto.RefreshTokenResponse":{"properties":{"accessToken":{"type":"string"},"exp":{"type":"integer"},"refreshToken":{"type":"string"}},"required":["accessToken","exp","refreshToken"],"type":"object"},"dto.RefundReservationResponse":{"properties":{"id":{"type":"string"},"refundedAmount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"status":{"type":"string"}},"required":["id","refundedAmount","status"],"type":"object"},"dto.ReserveBestAvailableRequest":{"properties":{"contiguous":{"type":"boolean"},"eventId":{"type":"string"},"promoCodes":{"items":{"type":"string"},"maxItems":3,"type":"array","uniqueItems":false},"quantity":{"minimum":1,"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["eventId","quantity","zoneNumber"],"type":"object"},"dto.ReserveBestAvailableResponse":{"properties":{"id":{"type":"string"},"seats":{"items":{"$ref":"#/components/schemas/dto.CreateReservationSeatDTO"},"type":"array","uniqueItems":false}},"required":["id","seats"],"type":"object"},"dto.ScanBundleResponse":{"properties":{"eventId":{"type":"string"},"generatedAt":{"type":"string"},"publicKey":{"description":"PublicKey is the base64 encoded Ed25519 key that verifies ticket credentials","type":"string"},"tickets":{"items":{"$ref":"#/components/schemas/dto.ScanBundleTicketDTO"},"type":"array","uniqueItems":false}},"required":["eventId","generatedAt","publicKey","tickets"],"type":"object"},"dto.ScanBundleTicketDTO":{"properties":{"checkedInAt":{"type":"string"},"credentialVersion":{"type":"integer"},"requiresVerification":{"type":"boolean"},"revoked":{"type":"boolean"},"ticketId":{"type":"string"}},"required":["credentialVersion","ticketId"],"type":"object"},"dto.ScanConflictDTO":{"properties":{"admittedAt":{"type":"string"},"admittedGateId":{"type":"string"},"rejectedAt":{"type":"string"},"rejectedGateId":{"type":"string"},"ticketId":{"type":"string"}},"required":["admittedAt","admittedGateId","rejectedAt","rejectedGateId","ticketId"],"type":"object"},"dto.ScanResultDTO":{"properties":{"gateId":{"type":"string"},"scannedAt":{"type":"string"},"status":{"type":"string"},"ticketId":{"type":"string"}},"required":["gateId","scannedAt","status"],"type":"object"},"dto.SeatConflictError":{"properties":{"error":{"type":"string"},"seats":{"items":{"$ref":"#/components/schemas/dto.CreateReservationSeatDTO"},"type":"array","uniqueItems":false}},"required":["error","seats"],"type":"object"},"dto.SeatDTO":{"properties":{"column":{"type":"integer"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"row":{"type":"integer"},"ticketId":{"type":"string"},"ticketTypeId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","row","zoneNumber"],"type":"object"},"dto.SeatStatusDTO":{"properties":{"column":{"type":"integer"},"row":{"type":"integer"},"status":{"description":"\"PENDING\" or \"RESERVED\"","type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","row","status","zoneNumber"],"type":"object"},"dto.TicketDTO":{"properties":{"checkedInAt":{"type":"string"},"column":{"type":"integer"},"credential":{"description":"Credential is the signed token to render as the QR code of the ticket","type":"string"},"credentialVersion":{"type":"integer"},"eventId":{"type":"string"},"id":{"type":"string"},"requiresVerification":{"description":"RequiresVerification tells the holder to bring proof they are eligible for the ticket type","type":"boolean"},"reservationId":{"type":"string"},"row":{"type":"integer"},"ticketTypeId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","credential","credentialVersion","eventId","id","reservationId","row","zoneNumber"],"type":"object"},"dto.TicketHistoryDTO":{"properties":{"action":{"type":"string"},"column":{"type":"integer"},"createdAt":{"type":"string"},"refundedAmount":{"$ref":"#/components/schemas/dto.MoneyDTO"},"row":{"type":"integer"},"ticketId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["action","column","createdAt","refundedAmount","row","ticketId","zoneNumber"],"type":"object"},"dto.TicketTransferDTO":{"properties":{"column":{"type":"integer"},"createdAt":{"type":"string"},"eventId":{"type":"string"},"fromUserId":{"type":"string"},"id":{"type":"string"},"row":{"type":"integer"},"status":{"type":"string"},"ticketId":{"type":"string"},"toUserId":{"type":"string"},"zoneNumber":{"type":"integer"}},"required":["column","createdAt","eventId","fromUserId","id","row","status","ticketId","toUserId","zoneNumber"],"type":"object"},"dto.TicketTypeResponse":{"properties":{"description":{"type":"string"},"eventZoneId":{"type":"string"},"id":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"quantityCap":{"type":"integer"},"requiresVerification":{"type":"boolean"},"zoneNumber":{"type":"integer"}},"required":["eventZoneId","id","name","price","zoneNumber"],"type":"object"},"dto.UpdateEventRequest":{"properties":{"artist":{"items":{"type":"string"},"type":"array","uniqueItems":false},"ballotClosesAt":{"type":"string"},"ballotOpensAt":{"type":"string"},"ballotPaymentHours":{"type":"integer"},"currency":{"enum":["THB","SGD","JPY"],"type":"string"},"description":{"type":"string"},"eventDate":{"type":"string"},"fees":{"$ref":"#/components/schemas/dto.EventFeesDTO"},"images":{"items":{"type":"string"},"type":"array","uniqueItems":false},"locationId":{"type":"string"},"maxHoldExtensionSeconds":{"type":"integer"},"maxTicketsPerUser":{"type":"integer"},"name":{"type":"string"},"refundDeadlineHours":{"type":"integer"},"refundPercentage":{"type":"integer"},"thumbnail":{"type":"string"},"transferCutoffHours":{"type":"integer"}},"type":"object"},"dto.UpdateEventResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UpdateEventZoneRequest":{"properties":{"capacity":{"type":"integer"},"color":{"type":"string"},"description":{"type":"string"},"eventId":{"type":"string"},"isSoldOut":{"type":"boolean"},"locationId":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"zoneNumber":{"type":"integer"},"zoneType":{"enum":["SEATED","GENERAL_ADMISSION"],"type":"string"}},"type":"object"},"dto.UpdateEventZoneResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UpdateLocationRequest":{"properties":{"city":{"type":"string"},"country":{"type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"stateProvince":{"type":"string"},"venueName":{"type":"string"},"zones":{"items":{"$ref":"#/components/schemas/dto.UpdateLocationZoneRequest"},"type":"array","uniqueItems":false}},"required":["city","country","latitude","longitude","stateProvince","venueName","zones"],"type":"object"},"dto.UpdateLocationResponse":{"properties":{"id":{"type":"string"}},"type":"object"},"dto.UpdateLocationZoneRequest":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"},"zoneType":{"enum":["SEATED","GENERAL_ADMISSION"],"type":"string"}},"required":["capacity","zoneName","zoneNumber"],"type":"object"},"dto.UpdatePriceTierRequest":{"properties":{"endsAt":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"sellThrough":{"type":"integer"},"startsAt":{"type":"string"}},"type":"object"},"dto.UpdatePriceTierResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UpdateProfileRequest":{"properties":{"birthdate":{"type":"string"},"firstname":{"type":"string"},"lastname":{"type":"string"},"phone":{"type":"string"},"profileImage":{"type":"string"}},"required":["birthdate","firstname","lastname","phone","profileImage"],"type":"object"},"dto.UpdateTicketTypeRequest":{"properties":{"description":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"quantityCap":{"type":"integer"},"requiresVerification":{"type":"boolean"}},"type":"object"},"dto.UpdateTicketTypeResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.UploadScansRequest":{"properties":{"eventId":{"type":"string"},"scans":{"items":{"$ref":"#/components/schemas/dto.OfflineScanDTO"},"maxItems":1000,"minItems":1,"type":"array","uniqueItems":false}},"required":["eventId","scans"],"type":"object"},"dto.UploadScansResponse":{"properties":{"conflicts":{"items":{"$ref":"#/components/schemas/dto.ScanConflictDTO"},"type":"array","uniqueItems":false},"results":{"items":{"$ref":"#/components/schemas/dto.ScanResultDTO"},"type":"array","uniqueItems":false}},"required":["conflicts","results"],"type":"object"},"dto.UserResponse":{"properties":{"birthdate":{"type":"string"},"createdAt":{"type":"string"},"deletedAt":{"type":"string"},"email":{"type":"string"},"firstname":{"type":"string"},"id":{"type":"string"},"lastname":{"type":"string"},"phone":{"type":"string"},"profileImage":{"type":"string"},"provider":{"type":"string"},"role":{"type":"string"},"updatedAt":{"type":"string"}},"required":["birthdate","createdAt","email","firstname","id","lastname","phone","profileImage","provider","role","updatedAt"],"type":"object"},"dto.WaitingRoomDTO":{"properties":{"batchSize":{"type":"integer"},"eventId":{"type":"string"},"maxAdmitted":{"type":"integer"},"opensAt":{"type":"string"},"order":{"type":"string"}},"required":["batchSize","eventId","opensAt","order"],"type":"object"},"dto.WaitingRoomStatusDTO":{"properties":{"eventId":{"type":"string"},"opensAt":{"type":"string"},"pass":{"description":"Pass is sent in the X-Waiting-Room-Pass header of reservation requests once ADMITTED","type":"string"},"passExpiresAt":{"type":"string"},"position":{"description":"Position is the place in the queue while WAITING, 1 is admitted next","type":"integer"},"status":{"description":"Status is NOT_JOINED, LOBBY until positions are drawn, WAITING or ADMITTED","type":"string"}},"required":["eventId","opensAt","status"],"type":"object"},"dto.WaitlistEntryDTO":{"properties":{"createdAt":{"type":"string"},"eventId":{"type":"string"},"id":{"type":"string"},"offerExpiresAt":{"type":"string"},"quantity":{"type":"integer"},"reservationId":{"description":"ReservationID is the reservation holding the offered seats, to pay before OfferExpiresAt","type":"string"},"status":{"type":"string"},"zoneNumber":{"description":"ZoneNumber is 0 when any zone will do","type":"integer"}},"required":["createdAt","eventId","id","quantity","status"],"type":"object"},"dto.WithdrawBallotApplicationResponse":{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"},"dto.ZonePriceTierDTO":{"properties":{"endsAt":{"type":"string"},"id":{"type":"string"},"name":{"type":"string"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"remaining":{"type":"integer"},"startsAt":{"type":"string"}},"required":["id","name","price","startsAt"],"type":"object"},"dto.ZonePricingDTO":{"properties":{"currentTier":{"$ref":"#/components/schemas/dto.ZonePriceTierDTO"},"nextTier":{"$ref":"#/components/schemas/dto.ZonePriceTierDTO"},"price":{"$ref":"#/components/schemas/dto.MoneyDTO"},"sold":{"type":"integer"},"zoneNumber":{"type":"integer"}},"required":["price","sold","zoneNumber"],"type":"object"},"dto.ZoneResponse":{"properties":{"capacity":{"type":"integer"},"numberOfRows":{"type":"integer"},"seatsPerRow":{"type":"integer"},"zoneName":{"type":"string"},"zoneNumber":{"type":"integer"},"zoneType":{"type":"string"}},"required":["capacity","numberOfRows","seatsPerRow","zoneName","zoneNumber","zoneType"],"type":"object"}},"securitySchemes":{"ApiKeyAuth":{"in":"header","name":"Authorization","type":"apiKey"}}},
     "info": {"description":"{{escape .Description}}","title":"{{.Title}}","version":"{{.Version}}"},
     "externalDocs": {"description":"","url":""},
-    "paths": {"/v1/auth/login":{"post":{"description":"Login","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LoginRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_LoginResponse"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Login","tags":["auth"]}},"/v1/auth/logout":{"post":{"description":"Logout","responses":{"204":{"description":"No Content"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Logout","tags":["auth"]}},"/v1/auth/me":{"get":{"description":"Get Profile","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UserResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Profile","tags":["auth"]},"patch":{"description":"Update Profile","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateProfileRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UserResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Profile","tags":["auth"]}},"/v1/auth/refresh":{"post":{"description":"Refresh Token","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.RefreshTokenRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_RefreshTokenResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Refresh Token","tags":["auth"]}},"/v1/events":{"get":{"description":"List Events","parameters":[{"description":"query","in":"query","name":"query","schema":{"type":"integer"}},{"description":"sortBy","in":"query","name":"sortBy","schema":{"type":"integer"}},{"description":"order","in":"query","name":"order","schema":{"type":"string"}},{"description":"page","in":"query","name":"page","schema":{"type":"string"}},{"description":"limit","in":"query","name":"limit","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventListResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"List Events","tags":["events"]},"post":{"description":"Create Event","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateEventRequest"}}},"description":"Create event request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateEventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Event","tags":["events"]}},"/v1/events/event-zones/{id}":{"delete":{"description":"Delete Event Zone","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Event Zone","tags":["event-zones"]},"put":{"description":"Update Event Zone","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateEventZoneRequest"}}},"description":"Update event zone request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateEventZoneResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Event Zone","tags":["event-zones"]}},"/v1/events/{eventId}/seats":{"get":{"description":"Get all reserved/pending seats for an event","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_GetEventSeatsResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event Seats","tags":["events"]}},"/v1/events/{id}":{"delete":{"description":"Delete Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Event","tags":["events"]},"get":{"description":"Get Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event","tags":["events"]},"put":{"description":"Update Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateEventRequest"}}},"description":"Update event request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateEventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Event","tags":["events"]}},"/v1/events/{id}/event-zones":{"get":{"description":"Get Event Zones by Event ID","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventZoneListResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event Zones by Event ID","tags":["event-zones"]},"post":{"description":"Create Event Zone","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateEventZoneRequest"}}},"description":"Create event zone request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateEventZoneResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Event Zone","tags":["event-zones"]}},"/v1/locations":{"get":{"description":"List Locations","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListLocationsResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"List Locations","tags":["locations"]},"post":{"description":"Create Location","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateLocationRequest"}}},"description":"Create location request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateLocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Location","tags":["locations"]}},"/v1/locations/{id}":{"delete":{"description":"Delete Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Location","tags":["locations"]},"get":{"description":"Get Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_LocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Location","tags":["locations"]},"put":{"description":"Update Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateLocationRequest"}}},"description":"Update location request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateLocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Location","tags":["locations"]}},"/v1/reservations":{"get":{"description":"List all reservations for a user","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Reservations","tags":["reservations"]},"post":{"description":"Create a new reservation","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateReservationRequest"}}},"description":"Create reservation request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Reservation","tags":["reservations"]}},"/v1/reservations/{id}":{"delete":{"description":"Delete a reservation","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_DeleteReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Reservation","tags":["reservations"]},"get":{"description":"Get a reservation by ID","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_GetReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Reservation","tags":["reservations"]}},"/v1/reservations/{id}/confirm":{"post":{"description":"Confirm a reservation","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ConfirmReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Confirm Reservation","tags":["reservations"]}}},
+    "paths": {"/v1/auth/login":{"post":{"description":"Login","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.LoginRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_LoginResponse"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Login","tags":["auth"]}},"/v1/auth/logout":{"post":{"description":"Logout","responses":{"204":{"description":"No Content"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Logout","tags":["auth"]}},"/v1/auth/me":{"get":{"description":"Get Profile","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UserResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Profile","tags":["auth"]},"patch":{"description":"Update Profile","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateProfileRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UserResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Profile","tags":["auth"]}},"/v1/auth/refresh":{"post":{"description":"Refresh Token","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.RefreshTokenRequest"}}},"description":"request request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_RefreshTokenResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Refresh Token","tags":["auth"]}},"/v1/ballot":{"get":{"description":"List the ballot applications of the user with their outcome, a won application links the reservation to pay","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListBallotApplicationsResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Ballot Applications","tags":["ballot"]},"post":{"description":"Apply for tickets in a zone of an event allocated by ballot, while its application window is open. After the window closes applications are drawn in a random order, winners get the best available seats of their zone held until the payment deadline of the event and are notified as ballot.won, the others as ballot.lost","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ApplyBallotRequest"}}},"description":"Apply to ballot request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_BallotApplicationDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Apply To Ballot","tags":["ballot"]}},"/v1/ballot/{id}":{"delete":{"description":"Withdraw a ballot application before it is drawn","parameters":[{"description":"Ballot application ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_WithdrawBallotApplicationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Withdraw Ballot Application","tags":["ballot"]}},"/v1/events":{"get":{"description":"List Events","parameters":[{"description":"query","in":"query","name":"query","schema":{"type":"integer"}},{"description":"sortBy","in":"query","name":"sortBy","schema":{"type":"integer"}},{"description":"order","in":"query","name":"order","schema":{"type":"string"}},{"description":"page","in":"query","name":"page","schema":{"type":"string"}},{"description":"limit","in":"query","name":"limit","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventListResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"List Events","tags":["events"]},"post":{"description":"Create Event","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateEventRequest"}}},"description":"Create event request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateEventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Event","tags":["events"]}},"/v1/events/event-zones/{id}":{"delete":{"description":"Delete Event Zone","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Event Zone","tags":["event-zones"]},"put":{"description":"Update Event Zone","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateEventZoneRequest"}}},"description":"Update event zone request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateEventZoneResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Event Zone","tags":["event-zones"]}},"/v1/events/event-zones/{id}/price-tiers":{"post":{"description":"Schedule a price tier, early-bird or door pricing, for an event zone. It sells from startsAt until endsAt or until sellThrough tickets of the zone are sold, whichever comes first","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreatePriceTierRequest"}}},"description":"Create price tier request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreatePriceTierResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Price Tier","tags":["price-tiers"]}},"/v1/events/event-zones/{id}/ticket-types":{"post":{"description":"Add a ticket type, adult, student or a VIP package, to an event zone","parameters":[{"description":"Event Zone ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateTicketTypeRequest"}}},"description":"Create ticket type request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateTicketTypeResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Ticket Type","tags":["ticket-types"]}},"/v1/events/price-tiers/{id}":{"delete":{"description":"Delete Price Tier","parameters":[{"description":"Price Tier ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Price Tier","tags":["price-tiers"]},"put":{"description":"Update Price Tier, an empty endsAt clears the end time","parameters":[{"description":"Price Tier ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdatePriceTierRequest"}}},"description":"Update price tier request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdatePriceTierResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Price Tier","tags":["price-tiers"]}},"/v1/events/ticket-types/{id}":{"delete":{"description":"Delete Ticket Type","parameters":[{"description":"Ticket Type ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Ticket Type","tags":["ticket-types"]},"put":{"description":"Update Ticket Type","parameters":[{"description":"Ticket Type ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateTicketTypeRequest"}}},"description":"Update ticket type request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateTicketTypeResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Ticket Type","tags":["ticket-types"]}},"/v1/events/{eventId}/ballot-draw":{"get":{"description":"Get the seed and order of the ballot draw of an event for audit. Sorting the application ids and shuffling them with Go's math/rand/v2 rand.New(rand.NewPCG(seed, 0)).Shuffle reproduces the order","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_BallotDrawDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Ballot Draw","tags":["ballot"]}},"/v1/events/{eventId}/pricing":{"get":{"description":"Get the price every zone of an event sells at now, with the current and next price tier so the countdown to the next price can be shown. A hold locks the price it was placed at","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_GetEventPricingResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event Pricing","tags":["events"]}},"/v1/events/{eventId}/seats":{"get":{"description":"Get all reserved/pending seats for an event","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}},{"description":"Pass from the waiting room, required while the event has one","in":"header","name":"X-Waiting-Room-Pass","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_GetEventSeatsResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event Seats","tags":["events"]}},"/v1/events/{eventId}/waiting-room":{"delete":{"description":"Remove the waiting room of an event, for admins. Reservations stop asking for a pass","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Close Waiting Room","tags":["waiting-room"]},"get":{"description":"Get the place of the user in the waiting room of an event, with the pass once admitted","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_WaitingRoomStatusDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Waiting Room Status","tags":["waiting-room"]},"put":{"description":"Put the on-sale of an event behind a waiting room, for admins. While it is open, reservations and the seat map of the event need a pass from the waiting room. Opening it again changes its settings and keeps the queue","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.OpenWaitingRoomRequest"}}},"description":"Open waiting room request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_WaitingRoomDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Open Waiting Room","tags":["waiting-room"]}},"/v1/events/{eventId}/waiting-room/join":{"post":{"description":"Join the waiting room of an event. Users joining before a RANDOM room opens get their positions drawn when the sale opens, later users queue in the order they join. Position updates and the pass are pushed over the realtime channel as waitingroom.position and waitingroom.admitted","parameters":[{"description":"Event ID","in":"path","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_WaitingRoomStatusDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Join Waiting Room","tags":["waiting-room"]}},"/v1/events/{id}":{"delete":{"description":"Delete Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Event","tags":["events"]},"get":{"description":"Get Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event","tags":["events"]},"put":{"description":"Update Event","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateEventRequest"}}},"description":"Update event request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateEventResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Event","tags":["events"]}},"/v1/events/{id}/event-zones":{"get":{"description":"Get Event Zones by Event ID","parameters":[{"description":"Event ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_EventZoneListResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Event Zones by Event ID","tags":["event-zones"]},"post":{"description":"Create Event Zone","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateEventZoneRequest"}}},"description":"Create event zone request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateEventZoneResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Event Zone","tags":["event-zones"]}},"/v1/locations":{"get":{"description":"List Locations","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListLocationsResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"List Locations","tags":["locations"]},"post":{"description":"Create Location","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateLocationRequest"}}},"description":"Create location request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateLocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Location","tags":["locations"]}},"/v1/locations/{id}":{"delete":{"description":"Delete Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Location","tags":["locations"]},"get":{"description":"Get Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_LocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"summary":"Get Location","tags":["locations"]},"put":{"description":"Update Location","parameters":[{"description":"Location ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UpdateLocationRequest"}}},"description":"Update location request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UpdateLocationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Update Location","tags":["locations"]}},"/v1/promotions":{"get":{"description":"List every promo code with its redemptions, for admins","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListPromotionsResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Promotions","tags":["promotions"]},"post":{"description":"Add a promo code, for admins. A PERCENTAGE code takes a share off every seat in its scope, a FIXED code takes an amount off the reservation once. Percentages are taken before fixed amounts and no seat goes below zero. Codes are case insensitive and stored upper case","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreatePromotionRequest"}}},"description":"Create promotion request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_PromotionDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Promotion","tags":["promotions"]}},"/v1/promotions/{id}":{"delete":{"description":"Stop a promo code from being redeemed, for admins. Reservations that already used it keep their discount","parameters":[{"description":"Promotion ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_DeactivatePromotionResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Deactivate Promotion","tags":["promotions"]}},"/v1/reservations":{"get":{"description":"List all reservations for a user","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Reservations","tags":["reservations"]},"post":{"description":"Create a new reservation. Events allocated by ballot refuse reservations with 409 until the draw is done. Up to 3 promo codes are taken off the total price, a code that is not valid for the seats is refused with 400 and a fully redeemed one with 409. Going over the ticket limit per user of the event is refused with 400","parameters":[{"description":"Retries with the same key return the first reservation","in":"header","name":"Idempotency-Key","schema":{"type":"string"}},{"description":"Pass from the waiting room, required while the event has one","in":"header","name":"X-Waiting-Room-Pass","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CreateReservationRequest"}}},"description":"Create reservation request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CreateReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.SeatConflictError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Create Reservation","tags":["reservations"]}},"/v1/reservations/best-available":{"post":{"description":"Hold the best free seats of a zone, front rows first, then central columns. Events allocated by ballot refuse reservations with 409 until the draw is done. Promo codes work as for Create Reservation","parameters":[{"description":"Pass from the waiting room, required while the event has one","in":"header","name":"X-Waiting-Room-Pass","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ReserveBestAvailableRequest"}}},"description":"Reserve best available request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ReserveBestAvailableResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Reserve Best Available","tags":["reservations"]}},"/v1/reservations/{id}":{"delete":{"description":"Delete a reservation","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_DeleteReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Delete Reservation","tags":["reservations"]},"get":{"description":"Get a reservation by ID","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_GetReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Reservation","tags":["reservations"]}},"/v1/reservations/{id}/confirm":{"post":{"description":"Confirm a reservation","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ConfirmReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Confirm Reservation","tags":["reservations"]}},"/v1/reservations/{id}/extend":{"post":{"description":"Extend a pending reservation hold once, up to the maximum of the event. The extended hold is paid on a new checkout session, open it with the returned client secret","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.ExtendReservationRequest"}}},"description":"Extend reservation request"},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ExtendReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Extend Reservation","tags":["reservations"]}},"/v1/reservations/{id}/refund":{"post":{"description":"Cancel a confirmed reservation and refund it under the refund policy of the event","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_RefundReservationResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Refund Reservation","tags":["reservations"]}},"/v1/reservations/{id}/tickets/cancel":{"post":{"description":"Cancel some tickets of a confirmed reservation and refund their zone prices under the refund policy of the event","parameters":[{"description":"Reservation ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CancelTicketsRequest"}}},"description":"Cancel tickets request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CancelTicketsResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Cancel Tickets","tags":["reservations"]}},"/v1/tickets":{"get":{"description":"List the live tickets of the user, including tickets transferred to them","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListTicketsResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Tickets","tags":["tickets"]}},"/v1/tickets/check-in":{"post":{"description":"Check in a ticket credential scanned at a gate, for door staff. A ticket is admitted once, later scans are refused with the gate and time of the first one","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.CheckInTicketRequest"}}},"description":"Check in ticket request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_CheckInTicketResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Check In Ticket","tags":["tickets"]}},"/v1/tickets/check-in/bundle":{"get":{"description":"Download what door devices need to check in the tickets of an event offline, for door staff: the key verifying credentials and the paid tickets with their credential version and revocation status","parameters":[{"description":"Event ID","in":"query","name":"eventId","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ScanBundleResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Get Scan Bundle","tags":["tickets"]}},"/v1/tickets/check-in/sync":{"post":{"description":"Upload the scan log of a door device that checked in tickets offline, for door staff. Scans of a ticket are resolved by their timestamp across gates, the earliest admits the ticket and the others are reported as conflicts","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.UploadScansRequest"}}},"description":"Upload scans request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_UploadScansResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Upload Offline Scans","tags":["tickets"]}},"/v1/tickets/transfers":{"get":{"description":"List the pending transfers offered by or to the user","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListTicketTransfersResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Ticket Transfers","tags":["tickets"]}},"/v1/tickets/transfers/{id}/accept":{"post":{"description":"Accept a transfer offered to the user, the ticket gets a new credential and the previous one stops being valid. A transfer that takes the user over the ticket limit per user of the event is refused with 400","parameters":[{"description":"Transfer ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_AcceptTicketTransferResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Accept Ticket Transfer","tags":["tickets"]}},"/v1/tickets/{id}/transfer":{"post":{"description":"Offer a ticket to another registered user by email, offering it again withdraws the previous offer","parameters":[{"description":"Ticket ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.OfferTicketTransferRequest"}}},"description":"Offer ticket transfer request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_TicketTransferDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Not Found"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Offer Ticket Transfer","tags":["tickets"]}},"/v1/waitlist":{"get":{"description":"List the waitlist entries of the user that still wait or hold an offer","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_ListWaitlistEntriesResponse"}}},"description":"OK"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"List Waitlist Entries","tags":["waitlist"]},"post":{"description":"Wait for seats given back in a zone of an event, zone 0 takes seats of any zone. Seats given back are first held for the next user in line, who is notified and has a limited time to pay before the offer moves on","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.JoinWaitlistRequest"}}},"description":"Join waitlist request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_WaitlistEntryDTO"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Join Waitlist","tags":["waitlist"]}},"/v1/waitlist/{id}":{"delete":{"description":"Leave the waitlist, an entry holding an offer is settled by paying or deleting its reservation","parameters":[{"description":"Waitlist entry ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpResponse-dto_LeaveWaitlistResponse"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Bad Request"},"401":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Unauthorized"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/dto.HttpError"}}},"description":"Internal Server Error"}},"security":[{"ApiKeyAuth":[]}],"summary":"Leave Waitlist","tags":["waitlist"]}}},
     "openapi": "3.1.0"
 }`
 
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v10"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/jwt"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/realtime"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/redis"
 	"github.com/joho/godotenv"
 )
@@ -15,19 +18,28 @@ type CorsConfig struct {
 	AllowCredentials bool   `env:"ALLOW_CREDENTIALS"`
 }
 
+type WaitingRoomConfig struct {
+	PassSecret    string        `env:"PASS_SECRET"`
+	PassTTL       time.Duration `env:"PASS_TTL" envDefault:"10m"`
+	AdmitInterval time.Duration `env:"ADMIT_INTERVAL" envDefault:"5s"`
+}
+
 type AppConfig struct {
-	Name                     string        `env:"NAME"`
-	Port                     int           `env:"PORT"`
-	Environment              string        `env:"ENVIRONMENT"`
-	MaxBodyLimit             int           `env:"MAX_BODY_LIMIT"`
-	Cors                     CorsConfig    `envPrefix:"CORS_"`
-	Logger                   logger.Config `envPrefix:"LOGGER_"`
-	JWT                      jwt.Config    `envPrefix:"JWT_"`
-	AuthRedis                redis.Config  `envPrefix:"AUTHREDIS_"`
-	AuthClientBaseURL        string        `env:"AUTH_CLIENT_BASE_URL"`
-	EventClientBaseURL       string        `env:"EVENT_CLIENT_BASE_URL"`
-	LocationClientBaseURL    string        `env:"LOCATION_CLIENT_BASE_URL"`
-	ReservationClientBaseURL string        `env:"RESERVATION_CLIENT_BASE_URL"`
+	Name                     string            `env:"NAME"`
+	Port                     int               `env:"PORT"`
+	Environment              string            `env:"ENVIRONMENT"`
+	MaxBodyLimit             int               `env:"MAX_BODY_LIMIT"`
+	Cors                     CorsConfig        `envPrefix:"CORS_"`
+	Logger                   logger.Config     `envPrefix:"LOGGER_"`
+	JWT                      jwt.Config        `envPrefix:"JWT_"`
+	AuthRedis                redis.Config      `envPrefix:"AUTHREDIS_"`
+	AuthClientBaseURL        string            `env:"AUTH_CLIENT_BASE_URL"`
+	EventClientBaseURL       string            `env:"EVENT_CLIENT_BASE_URL"`
+	LocationClientBaseURL    string            `env:"LOCATION_CLIENT_BASE_URL"`
+	ReservationClientBaseURL string            `env:"RESERVATION_CLIENT_BASE_URL"`
+	WaitingRoom              WaitingRoomConfig `envPrefix:"WAITINGROOM_"`
+	WaitingRoomRedis         redis.Config      `envPrefix:"WAITINGROOMREDIS_"`
+	Realtime                 realtime.Config   `envPrefix:"REALTIME_"`
 }
 
 func Load() *AppConfig {
@@ -0,0 +1,54 @@
+package dto
+
+// BallotApplicationDTO is an entry of the user in the ballot of an event
+type BallotApplicationDTO struct {
+	ID         string `json:"id" validate:"required"`
+	EventID    string `json:"eventId" validate:"required"`
+	ZoneNumber int32  `json:"zoneNumber" validate:"required"`
+	Quantity   int32  `json:"quantity" validate:"required"`
+	// Status is APPLIED until the draw, then WON or LOST, or WITHDRAWN
+	Status string `json:"status" validate:"required"`
+	// ReservationID is the reservation holding the seats won, to pay before the payment deadline
+	ReservationID string `json:"reservationId,omitempty"`
+	// DrawPosition is the place of the application in the draw order
+	DrawPosition int32  `json:"drawPosition,omitempty"`
+	CreatedAt    string `json:"createdAt" validate:"required"`
+}
+
+// ApplyBallotRequest is the request for applying to the ballot of an event
+type ApplyBallotRequest struct {
+	EventID    string `json:"eventId" validate:"required"`
+	ZoneNumber int32  `json:"zoneNumber" validate:"required"`
+	Quantity   int32  `json:"quantity" validate:"required"`
+}
+
+// WithdrawBallotApplicationRequest is the request for withdrawing a ballot application
+type WithdrawBallotApplicationRequest struct {
+	ID string `params:"id" validate:"required"`
+}
+
+// WithdrawBallotApplicationResponse is the response for withdrawing a ballot application
+type WithdrawBallotApplicationResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// ListBallotApplicationsResponse is the response for listing the ballot applications of the user
+type ListBallotApplicationsResponse struct {
+	Applications []BallotApplicationDTO `json:"applications" validate:"required"`
+}
+
+// GetBallotDrawRequest is the request for the draw of an event
+type GetBallotDrawRequest struct {
+	EventID string `params:"eventId" validate:"required"`
+}
+
+// BallotDrawDTO is the record of a ballot draw. The application ids, sorted, shuffled with a PCG
+// generator seeded by Seed give ApplicationIDs, which is the order the applications were served in
+type BallotDrawDTO struct {
+	EventID string `json:"eventId" validate:"required"`
+	// Seed is a decimal int64, kept as a string so it survives JSON number precision
+	Seed           string   `json:"seed" validate:"required"`
+	ApplicationIDs []string `json:"applicationIds" validate:"required"`
+	DrawnAt        string   `json:"drawnAt" validate:"required"`
+	CompletedAt    string   `json:"completedAt,omitempty"`
+}
@@ -13,13 +13,23 @@ type GetEventRequest struct {
 }
 
 type CreateEventRequest struct {
-	Name        string   `json:"name" validate:"required"`
-	Description string   `json:"description" validate:"required"`
-	LocationID  string   `json:"locationId" validate:"required"`
-	Artist      []string `json:"artist" validate:"required"`
-	EventDate   string   `json:"eventDate" validate:"required"`
-	Thumbnail   string   `json:"thumbnail" validate:"required"`
-	Images      []string `json:"images" validate:"required"`
+	Name                    string        `json:"name" validate:"required"`
+	Description             string        `json:"description" validate:"required"`
+	LocationID              string        `json:"locationId" validate:"required"`
+	Artist                  []string      `json:"artist" validate:"required"`
+	EventDate               string        `json:"eventDate" validate:"required"`
+	Thumbnail               string        `json:"thumbnail" validate:"required"`
+	Images                  []string      `json:"images" validate:"required"`
+	MaxTicketsPerUser       int32         `json:"maxTicketsPerUser"`
+	MaxHoldExtensionSeconds *int32        `json:"maxHoldExtensionSeconds"`
+	RefundDeadlineHours     int32         `json:"refundDeadlineHours"`
+	RefundPercentage        int32         `json:"refundPercentage"`
+	TransferCutoffHours     int32         `json:"transferCutoffHours"`
+	BallotOpensAt           string        `json:"ballotOpensAt"`
+	BallotClosesAt          string        `json:"ballotClosesAt"`
+	BallotPaymentHours      int32         `json:"ballotPaymentHours"`
+	Currency                string        `json:"currency" enums:"THB,SGD,JPY"`
+	Fees                    *EventFeesDTO `json:"fees"`
 }
 
 type CreateEventResponse struct {
@@ -27,14 +37,24 @@ type CreateEventResponse struct {
 }
 
 type UpdateEventRequest struct {
-	ID          string   `params:"id" swaggerignore:"true"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	LocationID  string   `json:"locationId"`
-	Artist      []string `json:"artist"`
-	EventDate   string   `json:"eventDate"`
-	Thumbnail   string   `json:"thumbnail"`
-	Images      []string `json:"images"`
+	ID                      string        `params:"id" swaggerignore:"true"`
+	Name                    string        `json:"name"`
+	Description             string        `json:"description"`
+	LocationID              string        `json:"locationId"`
+	Artist                  []string      `json:"artist"`
+	EventDate               string        `json:"eventDate"`
+	Thumbnail               string        `json:"thumbnail"`
+	Images                  []string      `json:"images"`
+	MaxTicketsPerUser       *int32        `json:"maxTicketsPerUser"`
+	MaxHoldExtensionSeconds *int32        `json:"maxHoldExtensionSeconds"`
+	RefundDeadlineHours     *int32        `json:"refundDeadlineHours"`
+	RefundPercentage        *int32        `json:"refundPercentage"`
+	TransferCutoffHours     *int32        `json:"transferCutoffHours"`
+	BallotOpensAt           *string       `json:"ballotOpensAt"`
+	BallotClosesAt          *string       `json:"ballotClosesAt"`
+	BallotPaymentHours      *int32        `json:"ballotPaymentHours"`
+	Currency                *string       `json:"currency" enums:"THB,SGD,JPY"`
+	Fees                    *EventFeesDTO `json:"fees"`
 }
 
 type UpdateEventResponse struct {
@@ -46,16 +66,26 @@ type DeleteEventRequest struct {
 }
 
 type EventResponse struct {
-	ID          string   `json:"id" validate:"required"`
-	Name        string   `json:"name" validate:"required"`
-	Description string   `json:"description" validate:"required"`
-	LocationID  string   `json:"locationId" validate:"required"`
-	Artist      []string `json:"artist" validate:"required"`
-	EventDate   string   `json:"eventDate" validate:"required"`
-	Thumbnail   string   `json:"thumbnail" validate:"required"`
-	Images      []string `json:"images" validate:"required"`
-	CreatedAt   string   `json:"createdAt" validate:"required"`
-	UpdatedAt   string   `json:"updatedAt" validate:"required"`
+	ID                      string        `json:"id" validate:"required"`
+	Name                    string        `json:"name" validate:"required"`
+	Description             string        `json:"description" validate:"required"`
+	LocationID              string        `json:"locationId" validate:"required"`
+	Artist                  []string      `json:"artist" validate:"required"`
+	EventDate               string        `json:"eventDate" validate:"required"`
+	Thumbnail               string        `json:"thumbnail" validate:"required"`
+	Images                  []string      `json:"images" validate:"required"`
+	CreatedAt               string        `json:"createdAt" validate:"required"`
+	UpdatedAt               string        `json:"updatedAt" validate:"required"`
+	MaxTicketsPerUser       int32         `json:"maxTicketsPerUser" validate:"required"`
+	MaxHoldExtensionSeconds int32         `json:"maxHoldExtensionSeconds" validate:"required"`
+	RefundDeadlineHours     int32         `json:"refundDeadlineHours" validate:"required"`
+	RefundPercentage        int32         `json:"refundPercentage" validate:"required"`
+	TransferCutoffHours     int32         `json:"transferCutoffHours" validate:"required"`
+	BallotOpensAt           string        `json:"ballotOpensAt"`
+	BallotClosesAt          string        `json:"ballotClosesAt"`
+	BallotPaymentHours      int32         `json:"ballotPaymentHours"`
+	Currency                string        `json:"currency" validate:"required"`
+	Fees                    *EventFeesDTO `json:"fees,omitempty"`
 }
 
 type EventListResponse struct {
@@ -63,15 +93,19 @@ type EventListResponse struct {
 }
 
 type EventZoneResponse struct {
-	ID          string  `json:"id" validate:"required"`
-	EventID     string  `json:"eventId" validate:"required"`
-	LocationID  string  `json:"locationId" validate:"required"`
-	ZoneNumber  int     `json:"zoneNumber" validate:"required"`
-	Price       float64 `json:"price" validate:"required"`
-	Color       string  `json:"color" validate:"required"`
-	Name        string  `json:"name" validate:"required"`
-	Description string  `json:"description" validate:"required"`
-	IsSoldOut   bool    `json:"isSoldOut" validate:"required"`
+	ID          string               `json:"id" validate:"required"`
+	EventID     string               `json:"eventId" validate:"required"`
+	LocationID  string               `json:"locationId" validate:"required"`
+	ZoneNumber  int                  `json:"zoneNumber" validate:"required"`
+	Price       MoneyDTO             `json:"price" validate:"required"`
+	Color       string               `json:"color" validate:"required"`
+	Name        string               `json:"name" validate:"required"`
+	Description string               `json:"description" validate:"required"`
+	IsSoldOut   bool                 `json:"isSoldOut" validate:"required"`
+	ZoneType    string               `json:"zoneType" validate:"required"`
+	Capacity    int                  `json:"capacity"`
+	TicketTypes []TicketTypeResponse `json:"ticketTypes"`
+	PriceTiers  []PriceTierResponse  `json:"priceTiers"`
 }
 
 type GetEventZoneByEventIDRequest struct {
@@ -83,13 +117,15 @@ type EventZoneListResponse struct {
 }
 
 type CreateEventZoneRequest struct {
-	EventID     string  `params:"id" validate:"required"`
-	LocationID  string  `json:"locationId" validate:"required"`
-	ZoneNumber  int     `json:"zoneNumber" validate:"required"`
-	Price       float64 `json:"price" validate:"required"`
-	Color       string  `json:"color" validate:"required"`
-	Name        string  `json:"name" validate:"required"`
-	Description string  `json:"description" validate:"required"`
+	EventID     string   `params:"id" validate:"required"`
+	LocationID  string   `json:"locationId" validate:"required"`
+	ZoneNumber  int      `json:"zoneNumber" validate:"required"`
+	Price       MoneyDTO `json:"price" validate:"required"`
+	Color       string   `json:"color" validate:"required"`
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description" validate:"required"`
+	ZoneType    string   `json:"zoneType" validate:"omitempty,oneof=SEATED GENERAL_ADMISSION"`
+	Capacity    int32    `json:"capacity" validate:"required_if=ZoneType GENERAL_ADMISSION"`
 }
 
 type CreateEventZoneResponse struct {
@@ -97,15 +133,17 @@ type CreateEventZoneResponse struct {
 }
 
 type UpdateEventZoneRequest struct {
-	ID          string  `params:"id" swaggerignore:"true"`
-	EventID     string  `json:"eventId"`
-	LocationID  string  `json:"locationId"`
-	ZoneNumber  int     `json:"zoneNumber"`
-	Price       float64 `json:"price"`
-	Color       string  `json:"color"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	IsSoldOut   bool    `json:"isSoldOut"`
+	ID          string    `params:"id" swaggerignore:"true"`
+	EventID     string    `json:"eventId"`
+	LocationID  string    `json:"locationId"`
+	ZoneNumber  int       `json:"zoneNumber"`
+	Price       *MoneyDTO `json:"price"`
+	Color       string    `json:"color"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IsSoldOut   bool      `json:"isSoldOut"`
+	ZoneType    *string   `json:"zoneType" validate:"omitempty,oneof=SEATED GENERAL_ADMISSION"`
+	Capacity    *int32    `json:"capacity"`
 }
 
 type UpdateEventZoneResponse struct {
@@ -115,3 +153,87 @@ type UpdateEventZoneResponse struct {
 type DeleteEventZoneRequest struct {
 	ID string `params:"id" swaggerignore:"true"`
 }
+
+type TicketTypeResponse struct {
+	ID                   string   `json:"id" validate:"required"`
+	EventZoneID          string   `json:"eventZoneId" validate:"required"`
+	ZoneNumber           int      `json:"zoneNumber" validate:"required"`
+	Name                 string   `json:"name" validate:"required"`
+	Description          string   `json:"description"`
+	Price                MoneyDTO `json:"price" validate:"required"`
+	QuantityCap          int32    `json:"quantityCap"`
+	RequiresVerification bool     `json:"requiresVerification"`
+}
+
+type CreateTicketTypeRequest struct {
+	EventZoneID          string   `params:"id" swaggerignore:"true"`
+	Name                 string   `json:"name" validate:"required"`
+	Description          string   `json:"description"`
+	Price                MoneyDTO `json:"price" validate:"required"`
+	QuantityCap          int32    `json:"quantityCap" validate:"gte=0"`
+	RequiresVerification bool     `json:"requiresVerification"`
+}
+
+type CreateTicketTypeResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type UpdateTicketTypeRequest struct {
+	ID                   string    `params:"id" swaggerignore:"true"`
+	Name                 *string   `json:"name"`
+	Description          *string   `json:"description"`
+	Price                *MoneyDTO `json:"price"`
+	QuantityCap          *int32    `json:"quantityCap"`
+	RequiresVerification *bool     `json:"requiresVerification"`
+}
+
+type UpdateTicketTypeResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type DeleteTicketTypeRequest struct {
+	ID string `params:"id" swaggerignore:"true"`
+}
+
+// PriceTierResponse is a scheduled price of a zone, it sells from startsAt until endsAt or until sellThrough
+// tickets of the zone are sold, whichever comes first
+type PriceTierResponse struct {
+	ID          string   `json:"id" validate:"required"`
+	EventZoneID string   `json:"eventZoneId" validate:"required"`
+	ZoneNumber  int      `json:"zoneNumber" validate:"required"`
+	Name        string   `json:"name" validate:"required"`
+	Price       MoneyDTO `json:"price" validate:"required"`
+	StartsAt    string   `json:"startsAt" validate:"required"`
+	EndsAt      string   `json:"endsAt"`
+	SellThrough int32    `json:"sellThrough"`
+}
+
+type CreatePriceTierRequest struct {
+	EventZoneID string   `params:"id" swaggerignore:"true"`
+	Name        string   `json:"name" validate:"required"`
+	Price       MoneyDTO `json:"price" validate:"required"`
+	StartsAt    string   `json:"startsAt" validate:"required"`
+	EndsAt      string   `json:"endsAt" validate:"required_without=SellThrough"`
+	SellThrough int32    `json:"sellThrough" validate:"gte=0"`
+}
+
+type CreatePriceTierResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type UpdatePriceTierRequest struct {
+	ID          string    `params:"id" swaggerignore:"true"`
+	Name        *string   `json:"name"`
+	Price       *MoneyDTO `json:"price"`
+	StartsAt    *string   `json:"startsAt"`
+	EndsAt      *string   `json:"endsAt"`
+	SellThrough *int32    `json:"sellThrough"`
+}
+
+type UpdatePriceTierResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type DeletePriceTierRequest struct {
+	ID string `params:"id" swaggerignore:"true"`
+}
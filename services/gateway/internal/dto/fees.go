@@ -0,0 +1,68 @@
+package dto
+
+import (
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+)
+
+// EventFeesDTO overrides the fees and VAT an event charges by default, a field left out keeps the default.
+// Fees are in the minor unit of the currency of the event, vatRate is in percent
+type EventFeesDTO struct {
+	BookingFee    *int64   `json:"bookingFee"`
+	ProcessingFee *int64   `json:"processingFee"`
+	VatRate       *float64 `json:"vatRate"`
+	VatInclusive  *bool    `json:"vatInclusive"`
+}
+
+func EventFeesFromProto(fees *eventpb.EventFees) *EventFeesDTO {
+	if fees == nil {
+		return nil
+	}
+	return &EventFeesDTO{
+		BookingFee:    fees.BookingFee,
+		ProcessingFee: fees.ProcessingFee,
+		VatRate:       fees.VatRate,
+		VatInclusive:  fees.VatInclusive,
+	}
+}
+
+func (f *EventFeesDTO) ToProto() *eventpb.EventFees {
+	if f == nil {
+		return nil
+	}
+	return &eventpb.EventFees{
+		BookingFee:    f.BookingFee,
+		ProcessingFee: f.ProcessingFee,
+		VatRate:       f.VatRate,
+		VatInclusive:  f.VatInclusive,
+	}
+}
+
+// PriceBreakdownDTO is what totalPrice is made of. The total is the subtotal less the discount plus the
+// fees, plus the tax unless vatInclusive, in which case the tax is the VAT inside it
+type PriceBreakdownDTO struct {
+	Subtotal      MoneyDTO `json:"subtotal" validate:"required"`
+	Discount      MoneyDTO `json:"discount" validate:"required"`
+	BookingFee    MoneyDTO `json:"bookingFee" validate:"required"`
+	ProcessingFee MoneyDTO `json:"processingFee" validate:"required"`
+	Tax           MoneyDTO `json:"tax" validate:"required"`
+	VatRate       float64  `json:"vatRate" validate:"required"`
+	VatInclusive  bool     `json:"vatInclusive" validate:"required"`
+	Total         MoneyDTO `json:"total" validate:"required"`
+}
+
+func PriceBreakdownFromProto(breakdown *reservationpb.PriceBreakdown) *PriceBreakdownDTO {
+	if breakdown == nil {
+		return nil
+	}
+	return &PriceBreakdownDTO{
+		Subtotal:      MoneyFromProto(breakdown.Subtotal),
+		Discount:      MoneyFromProto(breakdown.Discount),
+		BookingFee:    MoneyFromProto(breakdown.BookingFee),
+		ProcessingFee: MoneyFromProto(breakdown.ProcessingFee),
+		Tax:           MoneyFromProto(breakdown.Tax),
+		VatRate:       breakdown.VatRate,
+		VatInclusive:  breakdown.VatInclusive,
+		Total:         MoneyFromProto(breakdown.Total),
+	}
+}
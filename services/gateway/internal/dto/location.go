@@ -3,9 +3,10 @@ package dto
 type CreateLocationZoneRequest struct {
 	ZoneNumber   int    `json:"zoneNumber" validate:"required"`
 	ZoneName     string `json:"zoneName" validate:"required"`
+	ZoneType     string `json:"zoneType" validate:"omitempty,oneof=SEATED GENERAL_ADMISSION"`
 	Capacity     int    `json:"capacity" validate:"required"`
-	SeatsPerRow  int    `json:"seatsPerRow" validate:"required"`
-	NumberOfRows int    `json:"numberOfRows" validate:"required"`
+	SeatsPerRow  int    `json:"seatsPerRow" validate:"required_unless=ZoneType GENERAL_ADMISSION"`
+	NumberOfRows int    `json:"numberOfRows" validate:"required_unless=ZoneType GENERAL_ADMISSION"`
 }
 
 type CreateLocationRequest struct {
@@ -35,9 +36,10 @@ type ListLocationsResponse struct {
 type UpdateLocationZoneRequest struct {
 	ZoneNumber   int    `json:"zoneNumber" validate:"required"`
 	ZoneName     string `json:"zoneName" validate:"required"`
+	ZoneType     string `json:"zoneType" validate:"omitempty,oneof=SEATED GENERAL_ADMISSION"`
 	Capacity     int    `json:"capacity" validate:"required"`
-	SeatsPerRow  int    `json:"seatsPerRow" validate:"required"`
-	NumberOfRows int    `json:"numberOfRows" validate:"required"`
+	SeatsPerRow  int    `json:"seatsPerRow" validate:"required_unless=ZoneType GENERAL_ADMISSION"`
+	NumberOfRows int    `json:"numberOfRows" validate:"required_unless=ZoneType GENERAL_ADMISSION"`
 }
 
 type UpdateLocationRequest struct {
@@ -73,6 +75,7 @@ type LocationResponse struct {
 type ZoneResponse struct {
 	ZoneNumber   int    `json:"zoneNumber" validate:"required"`
 	ZoneName     string `json:"zoneName" validate:"required"`
+	ZoneType     string `json:"zoneType" validate:"required"`
 	Capacity     int    `json:"capacity" validate:"required"`
 	SeatsPerRow  int    `json:"seatsPerRow" validate:"required"`
 	NumberOfRows int    `json:"numberOfRows" validate:"required"`
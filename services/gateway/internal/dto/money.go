@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
+)
+
+// MoneyDTO is an amount in the minor unit of its currency, satang for THB, so 150000 THB is 1,500.00 baht
+type MoneyDTO struct {
+	Amount   int64  `json:"amount" validate:"required"`
+	Currency string `json:"currency" validate:"required"`
+}
+
+func MoneyFromProto(m *moneypb.Money) MoneyDTO {
+	return MoneyDTO(money.FromProto(m))
+}
+
+func (m MoneyDTO) ToProto() *moneypb.Money {
+	return money.ToProto(money.Money(m))
+}
@@ -0,0 +1,61 @@
+package dto
+
+// PromotionDTO is a promo code taking a PERCENTAGE or a FIXED amount off the seats in its scope
+type PromotionDTO struct {
+	ID           string `json:"id" validate:"required"`
+	Code         string `json:"code" validate:"required"`
+	DiscountType string `json:"discountType" validate:"required"`
+	// PercentOff is the rate of a PERCENTAGE code
+	PercentOff float64 `json:"percentOff,omitempty"`
+	// AmountOff is the amount a FIXED code takes off
+	AmountOff *MoneyDTO `json:"amountOff,omitempty"`
+	// EventID limits the code to one event, every event when empty
+	EventID string `json:"eventId,omitempty"`
+	// ZoneNumber limits the code to one zone of its event
+	ZoneNumber *int32 `json:"zoneNumber,omitempty"`
+	// MaxRedemptions and MaxRedemptionsPerUser are unlimited when 0
+	MaxRedemptions        int32  `json:"maxRedemptions"`
+	MaxRedemptionsPerUser int32  `json:"maxRedemptionsPerUser"`
+	RedemptionCount       int32  `json:"redemptionCount"`
+	Stackable             bool   `json:"stackable"`
+	StartsAt              string `json:"startsAt,omitempty"`
+	EndsAt                string `json:"endsAt,omitempty"`
+	Active                bool   `json:"active"`
+	CreatedAt             string `json:"createdAt" validate:"required"`
+}
+
+// CreatePromotionRequest is the request for creating a promo code
+type CreatePromotionRequest struct {
+	Code string `json:"code" validate:"required"`
+	// DiscountType is PERCENTAGE or FIXED, a fixed amount is taken off the reservation once
+	DiscountType string `json:"discountType" validate:"required"`
+	// PercentOff is required for PERCENTAGE codes, above 0 and at most 100
+	PercentOff float64 `json:"percentOff"`
+	// AmountOff is required for FIXED codes
+	AmountOff  *MoneyDTO `json:"amountOff"`
+	EventID    string    `json:"eventId"`
+	ZoneNumber *int32    `json:"zoneNumber"`
+	// MaxRedemptions and MaxRedemptionsPerUser are unlimited when 0
+	MaxRedemptions        int32 `json:"maxRedemptions"`
+	MaxRedemptionsPerUser int32 `json:"maxRedemptionsPerUser"`
+	// Stackable codes can be combined with other stackable codes
+	Stackable bool `json:"stackable"`
+	// StartsAt and EndsAt are RFC3339, the code is valid from and until when empty
+	StartsAt string `json:"startsAt"`
+	EndsAt   string `json:"endsAt"`
+}
+
+// ListPromotionsResponse is the response for listing promo codes
+type ListPromotionsResponse struct {
+	Promotions []PromotionDTO `json:"promotions" validate:"required"`
+}
+
+// DeactivatePromotionRequest is the request for deactivating a promo code
+type DeactivatePromotionRequest struct {
+	ID string `params:"id" validate:"required"`
+}
+
+// DeactivatePromotionResponse is the response for deactivating a promo code
+type DeactivatePromotionResponse struct {
+	ID string `json:"id" validate:"required"`
+}
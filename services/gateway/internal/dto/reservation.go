@@ -2,22 +2,52 @@ package dto
 
 // SeatDTO represents a seat in a reservation
 type SeatDTO struct {
-	ZoneNumber int32   `json:"zoneNumber" validate:"required"`
-	Row        int32   `json:"row" validate:"required"`
-	Column     int32   `json:"column" validate:"required"`
-	Price      float64 `json:"price,omitempty"`
+	ZoneNumber   int32     `json:"zoneNumber" validate:"required"`
+	Row          int32     `json:"row" validate:"required"`
+	Column       int32     `json:"column" validate:"required"`
+	Price        *MoneyDTO `json:"price,omitempty"`
+	TicketID     string    `json:"ticketId,omitempty"`
+	TicketTypeID string    `json:"ticketTypeId,omitempty"`
+}
+
+// TicketHistoryDTO is one change of a ticket, ISSUED when it is sold, TRANSFERRED when it changes owner and CANCELLED or REFUNDED when it is given back
+type TicketHistoryDTO struct {
+	TicketID       string   `json:"ticketId" validate:"required"`
+	ZoneNumber     int32    `json:"zoneNumber" validate:"required"`
+	Row            int32    `json:"row" validate:"required"`
+	Column         int32    `json:"column" validate:"required"`
+	Action         string   `json:"action" validate:"required"`
+	RefundedAmount MoneyDTO `json:"refundedAmount" validate:"required"`
+	CreatedAt      string   `json:"createdAt" validate:"required"`
 }
 
 type CreateReservationSeatDTO struct {
 	ZoneNumber int32 `json:"zoneNumber" validate:"required"`
 	Row        int32 `json:"row" validate:"required"`
 	Column     int32 `json:"column" validate:"required"`
+	// a ticket type of the zone, the seat costs the zone price when it is empty
+	TicketTypeID string `json:"ticketTypeId,omitempty"`
+}
+
+// GeneralAdmissionDTO asks for unnumbered tickets in a general admission zone
+type GeneralAdmissionDTO struct {
+	ZoneNumber   int32  `json:"zoneNumber" validate:"required"`
+	Quantity     int32  `json:"quantity" validate:"required,min=1"`
+	TicketTypeID string `json:"ticketTypeId,omitempty"`
 }
 
 // CreateReservationRequest is the request body for creating a reservation
 type CreateReservationRequest struct {
-	EventID string                     `json:"eventId" validate:"required"`
-	Seats   []CreateReservationSeatDTO `json:"seats" validate:"required,min=1"`
+	EventID          string                     `json:"eventId" validate:"required"`
+	Seats            []CreateReservationSeatDTO `json:"seats" validate:"required_without=GeneralAdmission,dive"`
+	GeneralAdmission []GeneralAdmissionDTO      `json:"generalAdmission" validate:"required_without=Seats,dive"`
+	PromoCodes       []string                   `json:"promoCodes" validate:"max=3"`
+}
+
+// SeatConflictError is returned when some of the requested seats are already held or sold
+type SeatConflictError struct {
+	Error string                     `json:"error" validate:"required"`
+	Seats []CreateReservationSeatDTO `json:"seats" validate:"required"`
 }
 
 // CreateReservationResponse is the response for creating a reservation
@@ -25,6 +55,21 @@ type CreateReservationResponse struct {
 	ID string `json:"id" validate:"required"`
 }
 
+// ReserveBestAvailableRequest is the request body for reserving the best free seats of a zone
+type ReserveBestAvailableRequest struct {
+	EventID    string   `json:"eventId" validate:"required"`
+	ZoneNumber int32    `json:"zoneNumber" validate:"required"`
+	Quantity   int32    `json:"quantity" validate:"required,min=1"`
+	Contiguous bool     `json:"contiguous"`
+	PromoCodes []string `json:"promoCodes" validate:"max=3"`
+}
+
+// ReserveBestAvailableResponse is the response for reserving the best free seats of a zone
+type ReserveBestAvailableResponse struct {
+	ID    string                     `json:"id" validate:"required"`
+	Seats []CreateReservationSeatDTO `json:"seats" validate:"required"`
+}
+
 // DeleteReservationRequest is the request for deleting a reservation
 type DeleteReservationRequest struct {
 	ID string `params:"id" validate:"required"`
@@ -42,14 +87,37 @@ type GetReservationRequest struct {
 
 // GetReservationResponse is the response for getting a reservation
 type GetReservationResponse struct {
-	ID                 string    `json:"id" validate:"required"`
-	UserID             string    `json:"userId" validate:"required"`
-	EventID            string    `json:"eventId" validate:"required"`
-	TotalPrice         float64   `json:"totalPrice" validate:"required"`
-	Seats              []SeatDTO `json:"seats" validate:"required"`
-	StripeClientSecret string    `json:"stripeClientSecret" validate:"required"`
-	TimeLeft           float64   `json:"timeLeft" validate:"required"`
-	Status             string    `json:"status" validate:"required"`
+	ID                 string                `json:"id" validate:"required"`
+	UserID             string                `json:"userId" validate:"required"`
+	EventID            string                `json:"eventId" validate:"required"`
+	TotalPrice         MoneyDTO              `json:"totalPrice" validate:"required"`
+	Seats              []SeatDTO             `json:"seats" validate:"required"`
+	StripeClientSecret string                `json:"stripeClientSecret" validate:"required"`
+	TimeLeft           float64               `json:"timeLeft" validate:"required"`
+	Status             string                `json:"status" validate:"required"`
+	History            []TicketHistoryDTO    `json:"history,omitempty"`
+	Promotions         []AppliedPromotionDTO `json:"promotions,omitempty"`
+	Currency           string                `json:"currency" validate:"required"`
+	LineItems          []LineItemDTO         `json:"lineItems,omitempty"`
+	Breakdown          *PriceBreakdownDTO    `json:"breakdown,omitempty"`
+}
+
+// AppliedPromotionDTO is the discount a promo code gave a reservation, totalPrice is after it
+type AppliedPromotionDTO struct {
+	Code   string   `json:"code" validate:"required"`
+	Amount MoneyDTO `json:"amount" validate:"required"`
+}
+
+// LineItemDTO is one line of the checkout of a reservation, the lines add up to totalPrice. A TICKET line
+// is the seats of one zone, a DISCOUNT line has a negative amount. VAT included in the prices has no line
+type LineItemDTO struct {
+	Kind        string   `json:"kind" validate:"required" enums:"TICKET,DISCOUNT,FEE,TAX"`
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description,omitempty"`
+	ZoneNumber  *int32   `json:"zoneNumber,omitempty"`
+	Quantity    int32    `json:"quantity" validate:"required"`
+	UnitAmount  MoneyDTO `json:"unitAmount" validate:"required"`
+	Amount      MoneyDTO `json:"amount" validate:"required"`
 }
 
 // ListReservationResponse is the response for listing reservations
@@ -62,7 +130,7 @@ type ReservationDTO struct {
 	ID         string    `json:"id,omitempty"`
 	UserID     string    `json:"userId" validate:"required"`
 	EventID    string    `json:"eventId" validate:"required"`
-	TotalPrice float64   `json:"totalPrice"`
+	TotalPrice MoneyDTO  `json:"totalPrice"`
 	Seats      []SeatDTO `json:"seats"`
 }
 
@@ -78,6 +146,45 @@ type ConfirmReservationResponse struct {
 	Message string `json:"message" validate:"required"`
 }
 
+// ExtendReservationRequest is the request for extending a pending reservation hold
+type ExtendReservationRequest struct {
+	ID      string `params:"id" validate:"required" swaggerignore:"true"`
+	Seconds *int32 `json:"seconds"`
+}
+
+// ExtendReservationResponse is the response for extending a pending reservation hold
+type ExtendReservationResponse struct {
+	ID       string  `json:"id" validate:"required"`
+	TimeLeft float64 `json:"timeLeft" validate:"required"`
+	// StripeClientSecret opens the new checkout session the extended hold is paid on
+	StripeClientSecret string `json:"stripeClientSecret" validate:"required"`
+}
+
+// RefundReservationRequest is the request for refunding a confirmed reservation
+type RefundReservationRequest struct {
+	ID string `params:"id" validate:"required" swaggerignore:"true"`
+}
+
+// RefundReservationResponse is the response for refunding a confirmed reservation
+type RefundReservationResponse struct {
+	ID             string   `json:"id" validate:"required"`
+	RefundedAmount MoneyDTO `json:"refundedAmount" validate:"required"`
+	Status         string   `json:"status" validate:"required"`
+}
+
+// CancelTicketsRequest is the request for cancelling some tickets of a confirmed reservation
+type CancelTicketsRequest struct {
+	ID        string   `params:"id" validate:"required" swaggerignore:"true"`
+	TicketIDs []string `json:"ticketIds" validate:"required"`
+}
+
+// CancelTicketsResponse is the response for cancelling some tickets of a confirmed reservation
+type CancelTicketsResponse struct {
+	ID             string   `json:"id" validate:"required"`
+	RefundedAmount MoneyDTO `json:"refundedAmount" validate:"required"`
+	TotalPrice     MoneyDTO `json:"totalPrice" validate:"required"`
+}
+
 // SeatStatusDTO represents a seat with its current status
 type SeatStatusDTO struct {
 	ZoneNumber int32  `json:"zoneNumber" validate:"required"`
@@ -86,7 +193,41 @@ type SeatStatusDTO struct {
 	Status     string `json:"status" validate:"required"` // "PENDING" or "RESERVED"
 }
 
+// GeneralAdmissionStatusDTO counts the held and sold tickets of a general admission zone
+type GeneralAdmissionStatusDTO struct {
+	ZoneNumber int32 `json:"zoneNumber" validate:"required"`
+	Capacity   int32 `json:"capacity" validate:"required"`
+	Taken      int32 `json:"taken" validate:"required"`
+}
+
 // GetEventSeatsResponse is the response for getting all seats for an event
 type GetEventSeatsResponse struct {
-	Seats []SeatStatusDTO `json:"seats" validate:"required"`
+	Seats            []SeatStatusDTO             `json:"seats" validate:"required"`
+	GeneralAdmission []GeneralAdmissionStatusDTO `json:"generalAdmission" validate:"required"`
+}
+
+// ZonePriceTierDTO is a price tier of a zone, remaining counts the tickets left before it sells through
+type ZonePriceTierDTO struct {
+	ID        string   `json:"id" validate:"required"`
+	Name      string   `json:"name" validate:"required"`
+	Price     MoneyDTO `json:"price" validate:"required"`
+	StartsAt  string   `json:"startsAt" validate:"required"`
+	EndsAt    string   `json:"endsAt,omitempty"`
+	Remaining *int32   `json:"remaining,omitempty"`
+}
+
+// ZonePricingDTO is what a seat of a zone costs when it is held now, the price of currentTier or the zone
+// price when no tier is selling. nextTier takes over when currentTier ends
+type ZonePricingDTO struct {
+	ZoneNumber  int32             `json:"zoneNumber" validate:"required"`
+	Price       MoneyDTO          `json:"price" validate:"required"`
+	CurrentTier *ZonePriceTierDTO `json:"currentTier,omitempty"`
+	NextTier    *ZonePriceTierDTO `json:"nextTier,omitempty"`
+	Sold        int32             `json:"sold" validate:"required"`
+}
+
+// GetEventPricingResponse is the response for getting the current and next price tier of every zone of an event
+type GetEventPricingResponse struct {
+	Zones      []ZonePricingDTO `json:"zones" validate:"required"`
+	ResolvedAt string           `json:"resolvedAt" validate:"required"`
 }
@@ -0,0 +1,140 @@
+package dto
+
+// TicketDTO is a live ticket of the user, bought or transferred to them
+type TicketDTO struct {
+	ID                string `json:"id" validate:"required"`
+	EventID           string `json:"eventId" validate:"required"`
+	ReservationID     string `json:"reservationId" validate:"required"`
+	ZoneNumber        int32  `json:"zoneNumber" validate:"required"`
+	Row               int32  `json:"row" validate:"required"`
+	Column            int32  `json:"column" validate:"required"`
+	CredentialVersion int32  `json:"credentialVersion" validate:"required"`
+	// Credential is the signed token to render as the QR code of the ticket
+	Credential   string `json:"credential" validate:"required"`
+	CheckedInAt  string `json:"checkedInAt,omitempty"`
+	TicketTypeID string `json:"ticketTypeId,omitempty"`
+	// RequiresVerification tells the holder to bring proof they are eligible for the ticket type
+	RequiresVerification bool `json:"requiresVerification"`
+}
+
+// ListTicketsResponse is the response for listing the tickets of the user
+type ListTicketsResponse struct {
+	Tickets []TicketDTO `json:"tickets" validate:"required"`
+}
+
+// TicketTransferDTO is an offer of a ticket to another user
+type TicketTransferDTO struct {
+	ID         string `json:"id" validate:"required"`
+	TicketID   string `json:"ticketId" validate:"required"`
+	EventID    string `json:"eventId" validate:"required"`
+	FromUserID string `json:"fromUserId" validate:"required"`
+	ToUserID   string `json:"toUserId" validate:"required"`
+	Status     string `json:"status" validate:"required"`
+	ZoneNumber int32  `json:"zoneNumber" validate:"required"`
+	Row        int32  `json:"row" validate:"required"`
+	Column     int32  `json:"column" validate:"required"`
+	CreatedAt  string `json:"createdAt" validate:"required"`
+}
+
+// OfferTicketTransferRequest is the request for offering a ticket to another registered user
+type OfferTicketTransferRequest struct {
+	ID    string `params:"id" validate:"required" swaggerignore:"true"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// AcceptTicketTransferRequest is the request for accepting a transfer offered to the user
+type AcceptTicketTransferRequest struct {
+	ID string `params:"id" validate:"required"`
+}
+
+// AcceptTicketTransferResponse is the response for accepting a transfer, the ticket now belongs to the user
+type AcceptTicketTransferResponse struct {
+	Transfer TicketTransferDTO `json:"transfer" validate:"required"`
+	Ticket   TicketDTO         `json:"ticket" validate:"required"`
+}
+
+// ListTicketTransfersResponse is the response for listing the pending transfers offered by or to the user
+type ListTicketTransfersResponse struct {
+	Transfers []TicketTransferDTO `json:"transfers" validate:"required"`
+}
+
+// CheckInTicketRequest is the request for checking in a scanned ticket credential at a gate of the event
+type CheckInTicketRequest struct {
+	Credential string `json:"credential" validate:"required"`
+	EventID    string `json:"eventId" validate:"required"`
+	GateID     string `json:"gateId" validate:"required"`
+}
+
+// CheckInTicketResponse is the response for checking in a ticket, with the seat to direct the holder to
+type CheckInTicketResponse struct {
+	TicketID     string `json:"ticketId" validate:"required"`
+	EventID      string `json:"eventId" validate:"required"`
+	ZoneNumber   int32  `json:"zoneNumber" validate:"required"`
+	Row          int32  `json:"row" validate:"required"`
+	Column       int32  `json:"column" validate:"required"`
+	Status       string `json:"status" validate:"required"`
+	CheckedInAt  string `json:"checkedInAt" validate:"required"`
+	GateID       string `json:"gateId" validate:"required"`
+	TicketTypeID string `json:"ticketTypeId,omitempty"`
+	// RequiresVerification asks staff to check the holder is eligible for the ticket type, a student card for example
+	RequiresVerification bool `json:"requiresVerification"`
+}
+
+// GetScanBundleRequest is the request for downloading the scan bundle of an event
+type GetScanBundleRequest struct {
+	EventID string `query:"eventId" validate:"required"`
+}
+
+// ScanBundleTicketDTO is a ticket a door device admits offline, the seat is in its credential
+type ScanBundleTicketDTO struct {
+	TicketID             string `json:"ticketId" validate:"required"`
+	CredentialVersion    int32  `json:"credentialVersion" validate:"required"`
+	Revoked              bool   `json:"revoked"`
+	CheckedInAt          string `json:"checkedInAt,omitempty"`
+	RequiresVerification bool   `json:"requiresVerification"`
+}
+
+// ScanBundleResponse is what door devices need to check in the tickets of an event offline
+type ScanBundleResponse struct {
+	EventID string `json:"eventId" validate:"required"`
+	// PublicKey is the base64 encoded Ed25519 key that verifies ticket credentials
+	PublicKey   string                `json:"publicKey" validate:"required"`
+	GeneratedAt string                `json:"generatedAt" validate:"required"`
+	Tickets     []ScanBundleTicketDTO `json:"tickets" validate:"required"`
+}
+
+// OfflineScanDTO is a scan a door device recorded while offline
+type OfflineScanDTO struct {
+	Credential string `json:"credential" validate:"required"`
+	GateID     string `json:"gateId" validate:"required"`
+	ScannedAt  string `json:"scannedAt" validate:"required"`
+}
+
+// UploadScansRequest is the request for uploading the offline scan log of a door device
+type UploadScansRequest struct {
+	EventID string           `json:"eventId" validate:"required"`
+	Scans   []OfflineScanDTO `json:"scans" validate:"required,min=1,max=1000,dive"`
+}
+
+// ScanResultDTO is the outcome of an uploaded scan: ADMITTED, DUPLICATE, REVOKED, SUPERSEDED, UNPAID or INVALID
+type ScanResultDTO struct {
+	TicketID  string `json:"ticketId,omitempty"`
+	GateID    string `json:"gateId" validate:"required"`
+	ScannedAt string `json:"scannedAt" validate:"required"`
+	Status    string `json:"status" validate:"required"`
+}
+
+// ScanConflictDTO is a ticket admitted more than once, the earliest admission is kept
+type ScanConflictDTO struct {
+	TicketID       string `json:"ticketId" validate:"required"`
+	AdmittedGateID string `json:"admittedGateId" validate:"required"`
+	AdmittedAt     string `json:"admittedAt" validate:"required"`
+	RejectedGateID string `json:"rejectedGateId" validate:"required"`
+	RejectedAt     string `json:"rejectedAt" validate:"required"`
+}
+
+// UploadScansResponse is the response for uploading scans, results are in the order of the uploaded scans
+type UploadScansResponse struct {
+	Results   []ScanResultDTO   `json:"results" validate:"required"`
+	Conflicts []ScanConflictDTO `json:"conflicts" validate:"required"`
+}
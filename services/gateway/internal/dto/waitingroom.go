@@ -0,0 +1,54 @@
+package dto
+
+// OpenWaitingRoomRequest is the request for putting the on-sale of an event behind a waiting room
+type OpenWaitingRoomRequest struct {
+	EventID string `params:"eventId" validate:"required" swaggerignore:"true"`
+	// OpensAt is when the sale opens and admission starts, RFC3339
+	OpensAt string `json:"opensAt" validate:"required"`
+	// Order is RANDOM to draw the positions of users who joined before the sale opened, or FIRST_COME
+	Order string `json:"order" validate:"required,oneof=RANDOM FIRST_COME"`
+	// BatchSize is the number of users admitted on every admission round
+	BatchSize int32 `json:"batchSize" validate:"required,min=1"`
+	// MaxAdmitted caps the users holding a live pass at once, 0 for no cap
+	MaxAdmitted int32 `json:"maxAdmitted"`
+}
+
+// WaitingRoomDTO is the waiting room of an event
+type WaitingRoomDTO struct {
+	EventID     string `json:"eventId" validate:"required"`
+	OpensAt     string `json:"opensAt" validate:"required"`
+	Order       string `json:"order" validate:"required"`
+	BatchSize   int32  `json:"batchSize" validate:"required"`
+	MaxAdmitted int32  `json:"maxAdmitted"`
+}
+
+// WaitingRoomEventRequest is the request for an action on the waiting room of an event
+type WaitingRoomEventRequest struct {
+	EventID string `params:"eventId" validate:"required"`
+}
+
+// WaitingRoomStatusDTO is the place of the user in the waiting room of an event
+type WaitingRoomStatusDTO struct {
+	EventID string `json:"eventId" validate:"required"`
+	// Status is NOT_JOINED, LOBBY until positions are drawn, WAITING or ADMITTED
+	Status string `json:"status" validate:"required"`
+	// Position is the place in the queue while WAITING, 1 is admitted next
+	Position int64  `json:"position,omitempty"`
+	OpensAt  string `json:"opensAt" validate:"required"`
+	// Pass is sent in the X-Waiting-Room-Pass header of reservation requests once ADMITTED
+	Pass          string `json:"pass,omitempty"`
+	PassExpiresAt string `json:"passExpiresAt,omitempty"`
+}
+
+// WaitingRoomPositionMessage is pushed over the realtime channel when the position of the user changes
+type WaitingRoomPositionMessage struct {
+	EventID  string `json:"eventId"`
+	Position int64  `json:"position"`
+}
+
+// WaitingRoomAdmittedMessage is pushed over the realtime channel when the user is admitted
+type WaitingRoomAdmittedMessage struct {
+	EventID       string `json:"eventId"`
+	Pass          string `json:"pass"`
+	PassExpiresAt string `json:"passExpiresAt"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+// WaitlistEntryDTO is a place of the user in line for seats given back in a zone of an event
+type WaitlistEntryDTO struct {
+	ID      string `json:"id" validate:"required"`
+	EventID string `json:"eventId" validate:"required"`
+	// ZoneNumber is 0 when any zone will do
+	ZoneNumber int32  `json:"zoneNumber"`
+	Quantity   int32  `json:"quantity" validate:"required"`
+	Status     string `json:"status" validate:"required"`
+	// ReservationID is the reservation holding the offered seats, to pay before OfferExpiresAt
+	ReservationID  string `json:"reservationId,omitempty"`
+	OfferExpiresAt string `json:"offerExpiresAt,omitempty"`
+	CreatedAt      string `json:"createdAt" validate:"required"`
+}
+
+// JoinWaitlistRequest is the request for joining the waitlist of a zone, zone 0 takes seats of any zone
+type JoinWaitlistRequest struct {
+	EventID    string `json:"eventId" validate:"required"`
+	ZoneNumber int32  `json:"zoneNumber"`
+	Quantity   int32  `json:"quantity" validate:"required"`
+}
+
+// LeaveWaitlistRequest is the request for leaving the waitlist
+type LeaveWaitlistRequest struct {
+	ID string `params:"id" validate:"required"`
+}
+
+// LeaveWaitlistResponse is the response for leaving the waitlist
+type LeaveWaitlistResponse struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// ListWaitlistEntriesResponse is the response for listing the entries of the user that wait or hold an offer
+type ListWaitlistEntriesResponse struct {
+	Entries []WaitlistEntryDTO `json:"entries" validate:"required"`
+}
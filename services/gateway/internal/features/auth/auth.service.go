@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
 
 	"github.com/cockroachdb/errors"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
@@ -11,6 +12,9 @@ import (
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
 )
 
+// ErrUserNotFound is returned when nobody signed up with the email.
+var ErrUserNotFound = errors.New("user not found")
+
 type AuthService struct {
 	client *httpclient.Client
 }
@@ -105,6 +109,29 @@ func (s *AuthService) GetProfile(ctx context.Context, req *dto.GetProfileRequest
 	return *data, nil
 }
 
+// GetUserByEmail finds a registered user by email.
+func (s *AuthService) GetUserByEmail(ctx context.Context, email string) (dto.UserResponse, error) {
+	response, err := s.client.Get(ctx, "/v1/auth/users", httpclient.RequestOptions{
+		Query: url.Values{"email": {email}},
+	})
+	if err != nil {
+		return dto.UserResponse{}, errors.Wrap(err, "failed to get user by email")
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		return dto.UserResponse{}, ErrUserNotFound
+	}
+	if response.StatusCode() != http.StatusOK {
+		return dto.UserResponse{}, errors.Newf("failed to get user by email: status %d", response.StatusCode())
+	}
+
+	data := &dto.UserResponse{}
+	if err = json.Unmarshal(response.Body(), data); err != nil {
+		return dto.UserResponse{}, errors.Wrap(err, "failed to unmarshal user response")
+	}
+
+	return *data, nil
+}
+
 func (s *AuthService) UpdateProfile(ctx context.Context, req *dto.UpdateProfileDTO) (dto.UserResponse, error) {
 	marshalPayload, err := json.Marshal(req)
 	if err != nil {
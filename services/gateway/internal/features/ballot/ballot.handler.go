@@ -0,0 +1,191 @@
+package ballot
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Handler struct {
+	service        *BallotService
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewHandler(service *BallotService, authMiddleware authentication.AuthMiddleware) *Handler {
+	return &Handler{
+		service:        service,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (h *Handler) Mount(r fiber.Router) {
+	group := r.Group("/ballot")
+	group.Post("/", h.authMiddleware.Auth, h.ApplyBallot)
+	group.Get("/", h.authMiddleware.Auth, h.ListBallotApplications)
+	group.Delete("/:id", h.authMiddleware.Auth, h.WithdrawBallotApplication)
+
+	r.Get("/events/:eventId/ballot-draw", h.GetBallotDraw)
+}
+
+// @Summary      	Apply To Ballot
+// @Description  	Apply for tickets in a zone of an event allocated by ballot, while its application window is open. After the window closes applications are drawn in a random order, winners get the best available seats of their zone held until the payment deadline of the event and are notified as ballot.won, the others as ballot.lost
+// @Tags			ballot
+// @Router			/v1/ballot [POST]
+// @Security		ApiKeyAuth
+// @Param			body	body		dto.ApplyBallotRequest	true	"Apply to ballot request"
+// @Success			200 {object}	dto.HttpResponse[dto.BallotApplicationDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ApplyBallot(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ApplyBallotRequest
+	if err := c.BodyParser(&req); err != nil || req.EventID == "" || req.Quantity <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Apply(ctx, &req, userID)
+	if err != nil {
+		if h.service.TicketLimitExceeded(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.BallotConflict(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.BallotApplicationDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	List Ballot Applications
+// @Description  	List the ballot applications of the user with their outcome, a won application links the reservation to pay
+// @Tags			ballot
+// @Router			/v1/ballot [GET]
+// @Security		ApiKeyAuth
+// @Success			200 {object}	dto.HttpResponse[dto.ListBallotApplicationsResponse]
+// @Failure			401	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ListBallotApplications(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.List(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ListBallotApplicationsResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Withdraw Ballot Application
+// @Description  	Withdraw a ballot application before it is drawn
+// @Tags			ballot
+// @Router			/v1/ballot/{id} [DELETE]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Ballot application ID"
+// @Success			200 {object}	dto.HttpResponse[dto.WithdrawBallotApplicationResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) WithdrawBallotApplication(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.WithdrawBallotApplicationRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	id, err := h.service.Withdraw(ctx, &req, userID)
+	if err != nil {
+		if h.service.BallotConflict(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.WithdrawBallotApplicationResponse]{
+		Result: dto.WithdrawBallotApplicationResponse{
+			ID: id,
+		},
+	})
+}
+
+// @Summary      	Get Ballot Draw
+// @Description  	Get the seed and order of the ballot draw of an event for audit. Sorting the application ids and shuffling them with Go's math/rand/v2 rand.New(rand.NewPCG(seed, 0)).Shuffle reproduces the order
+// @Tags			ballot
+// @Router			/v1/events/{eventId}/ballot-draw [GET]
+// @Param			eventId	path		string	true	"Event ID"
+// @Success			200 {object}	dto.HttpResponse[dto.BallotDrawDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			404	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) GetBallotDraw(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.GetBallotDrawRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	result, err := h.service.GetDraw(ctx, req.EventID)
+	if err != nil {
+		if h.service.NotDrawn(err) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.BallotDrawDTO]{
+		Result: result,
+	})
+}
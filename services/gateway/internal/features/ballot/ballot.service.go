@@ -0,0 +1,127 @@
+package ballot
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BallotService struct {
+	client reservationpb.ReservationServiceClient
+}
+
+func NewService(client reservationpb.ReservationServiceClient) *BallotService {
+	return &BallotService{
+		client: client,
+	}
+}
+
+func (s *BallotService) TransformApplicationToDTO(application *reservationpb.BallotApplication) dto.BallotApplicationDTO {
+	return dto.BallotApplicationDTO{
+		ID:            application.Id,
+		EventID:       application.EventId,
+		ZoneNumber:    application.ZoneNumber,
+		Quantity:      application.Quantity,
+		Status:        application.Status,
+		ReservationID: application.ReservationId,
+		DrawPosition:  application.DrawPosition,
+		CreatedAt:     application.CreatedAt,
+	}
+}
+
+// Apply enters the user in the ballot of an event
+func (s *BallotService) Apply(ctx context.Context, req *dto.ApplyBallotRequest, userID uuid.UUID) (dto.BallotApplicationDTO, error) {
+	response, err := s.client.ApplyBallot(ctx, &reservationpb.ApplyBallotRequest{
+		UserId:     userID.String(),
+		EventId:    req.EventID,
+		ZoneNumber: req.ZoneNumber,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return dto.BallotApplicationDTO{}, errors.Wrap(err, "failed to apply to ballot")
+	}
+
+	return s.TransformApplicationToDTO(response.Application), nil
+}
+
+// Withdraw takes an application of the user out of the ballot before it is drawn
+func (s *BallotService) Withdraw(ctx context.Context, req *dto.WithdrawBallotApplicationRequest, userID uuid.UUID) (string, error) {
+	response, err := s.client.WithdrawBallotApplication(ctx, &reservationpb.WithdrawBallotApplicationRequest{
+		Id:     req.ID,
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to withdraw ballot application")
+	}
+
+	return response.Id, nil
+}
+
+// List lists the ballot applications of the user with their outcome
+func (s *BallotService) List(ctx context.Context, userID uuid.UUID) (dto.ListBallotApplicationsResponse, error) {
+	response, err := s.client.ListBallotApplications(ctx, &reservationpb.ListBallotApplicationsRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.ListBallotApplicationsResponse{}, errors.Wrap(err, "failed to list ballot applications")
+	}
+
+	applications := make([]dto.BallotApplicationDTO, 0, len(response.Applications))
+	for _, application := range response.Applications {
+		applications = append(applications, s.TransformApplicationToDTO(application))
+	}
+
+	return dto.ListBallotApplicationsResponse{
+		Applications: applications,
+	}, nil
+}
+
+// GetDraw returns the seed and order of the draw of an event
+func (s *BallotService) GetDraw(ctx context.Context, eventID string) (dto.BallotDrawDTO, error) {
+	response, err := s.client.GetBallotDraw(ctx, &reservationpb.GetBallotDrawRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return dto.BallotDrawDTO{}, errors.Wrap(err, "failed to get ballot draw")
+	}
+
+	return dto.BallotDrawDTO{
+		EventID:        response.EventId,
+		Seed:           strconv.FormatInt(response.Seed, 10),
+		ApplicationIDs: response.ApplicationIds,
+		DrawnAt:        response.DrawnAt,
+		CompletedAt:    response.CompletedAt,
+	}, nil
+}
+
+// BallotConflict reports a second application for the event, an application outside the window or one already drawn
+func (s *BallotService) BallotConflict(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && (st.Code() == codes.AlreadyExists || st.Code() == codes.FailedPrecondition)
+}
+
+// NotDrawn reports an event whose ballot was not drawn yet
+func (s *BallotService) NotDrawn(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.NotFound
+}
+
+// TicketLimitExceeded reports a request that would take the user over the ticket limit per user of the event
+func (s *BallotService) TicketLimitExceeded(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*reservationpb.TicketLimitExceeded); ok {
+			return true
+		}
+	}
+	return false
+}
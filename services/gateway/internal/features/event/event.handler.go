@@ -30,6 +30,13 @@ func (h *Handler) Mount(r fiber.Router) {
 	group.Post("/:id/event-zones", h.authentication.Auth, h.CreateEventZone)
 	group.Put("/event-zones/:id", h.authentication.Auth, h.UpdateEventZone)
 	group.Delete("/event-zones/:id", h.authentication.Auth, h.DeleteEventZone)
+
+	group.Post("/event-zones/:id/ticket-types", h.authentication.Auth, h.CreateTicketType)
+	group.Put("/ticket-types/:id", h.authentication.Auth, h.UpdateTicketType)
+	group.Delete("/ticket-types/:id", h.authentication.Auth, h.DeleteTicketType)
+	group.Post("/event-zones/:id/price-tiers", h.authentication.Auth, h.CreatePriceTier)
+	group.Put("/price-tiers/:id", h.authentication.Auth, h.UpdatePriceTier)
+	group.Delete("/price-tiers/:id", h.authentication.Auth, h.DeletePriceTier)
 }
 
 // @Summary      	List Events
@@ -293,3 +300,183 @@ func (h *Handler) DeleteEventZone(c *fiber.Ctx) error {
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// @Summary      	Create Ticket Type
+// @Description  	Add a ticket type, adult, student or a VIP package, to an event zone
+// @Tags			ticket-types
+// @Router			/v1/events/event-zones/{id}/ticket-types [POST]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Event Zone ID"
+// @Param			body	body		dto.CreateTicketTypeRequest	true	"Create ticket type request"
+// @Success			200 {object}	dto.HttpResponse[dto.CreateTicketTypeResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CreateTicketType(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CreateTicketTypeRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	id, err := h.service.CreateTicketType(ctx, &req)
+	if err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.CreateTicketTypeResponse]{
+		Result: dto.CreateTicketTypeResponse{
+			ID: id,
+		},
+	})
+}
+
+// @Summary      	Update Ticket Type
+// @Description  	Update Ticket Type
+// @Tags			ticket-types
+// @Router			/v1/events/ticket-types/{id} [PUT]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Ticket Type ID"
+// @Param			body	body		dto.UpdateTicketTypeRequest	true	"Update ticket type request"
+// @Success			200 {object}	dto.HttpResponse[dto.UpdateTicketTypeResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) UpdateTicketType(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.UpdateTicketTypeRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	id, err := h.service.UpdateTicketType(ctx, &req)
+	if err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.UpdateTicketTypeResponse]{
+		Result: dto.UpdateTicketTypeResponse{
+			ID: id,
+		},
+	})
+}
+
+// @Summary      	Delete Ticket Type
+// @Description  	Delete Ticket Type
+// @Tags			ticket-types
+// @Router			/v1/events/ticket-types/{id} [DELETE]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Ticket Type ID"
+// @Success			204
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) DeleteTicketType(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.DeleteTicketTypeRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	if err := h.service.DeleteTicketType(ctx, &req); err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary      	Create Price Tier
+// @Description  	Schedule a price tier, early-bird or door pricing, for an event zone. It sells from startsAt until endsAt or until sellThrough tickets of the zone are sold, whichever comes first
+// @Tags			price-tiers
+// @Router			/v1/events/event-zones/{id}/price-tiers [POST]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Event Zone ID"
+// @Param			body	body		dto.CreatePriceTierRequest	true	"Create price tier request"
+// @Success			200 {object}	dto.HttpResponse[dto.CreatePriceTierResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CreatePriceTier(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CreatePriceTierRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	id, err := h.service.CreatePriceTier(ctx, &req)
+	if err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.CreatePriceTierResponse]{
+		Result: dto.CreatePriceTierResponse{
+			ID: id,
+		},
+	})
+}
+
+// @Summary      	Update Price Tier
+// @Description  	Update Price Tier, an empty endsAt clears the end time
+// @Tags			price-tiers
+// @Router			/v1/events/price-tiers/{id} [PUT]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Price Tier ID"
+// @Param			body	body		dto.UpdatePriceTierRequest	true	"Update price tier request"
+// @Success			200 {object}	dto.HttpResponse[dto.UpdatePriceTierResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) UpdatePriceTier(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.UpdatePriceTierRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	id, err := h.service.UpdatePriceTier(ctx, &req)
+	if err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.UpdatePriceTierResponse]{
+		Result: dto.UpdatePriceTierResponse{
+			ID: id,
+		},
+	})
+}
+
+// @Summary      	Delete Price Tier
+// @Description  	Delete Price Tier
+// @Tags			price-tiers
+// @Router			/v1/events/price-tiers/{id} [DELETE]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Price Tier ID"
+// @Success			204
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) DeletePriceTier(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.DeletePriceTierRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	if err := h.service.DeletePriceTier(ctx, &req); err != nil {
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
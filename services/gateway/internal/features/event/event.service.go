@@ -6,6 +6,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
 )
 
 type EventService struct {
@@ -20,30 +21,80 @@ func NewService(client eventpb.EventServiceClient) *EventService {
 
 func (s *EventService) TransformEventResponse(event *eventpb.Event) dto.EventResponse {
 	return dto.EventResponse{
-		ID:          event.Id,
-		Name:        event.Name,
-		Description: event.Description,
-		LocationID:  event.LocationId,
-		Artist:      event.Artist,
-		EventDate:   event.EventDate,
-		Thumbnail:   event.Thumbnail,
-		Images:      event.Images,
-		CreatedAt:   event.CreatedAt,
-		UpdatedAt:   event.UpdatedAt,
+		ID:                      event.Id,
+		Name:                    event.Name,
+		Description:             event.Description,
+		LocationID:              event.LocationId,
+		Artist:                  event.Artist,
+		EventDate:               event.EventDate,
+		Thumbnail:               event.Thumbnail,
+		Images:                  event.Images,
+		CreatedAt:               event.CreatedAt,
+		UpdatedAt:               event.UpdatedAt,
+		MaxTicketsPerUser:       event.MaxTicketsPerUser,
+		MaxHoldExtensionSeconds: event.MaxHoldExtensionSeconds,
+		RefundDeadlineHours:     event.RefundDeadlineHours,
+		RefundPercentage:        event.RefundPercentage,
+		TransferCutoffHours:     event.TransferCutoffHours,
+		BallotOpensAt:           event.BallotOpensAt,
+		BallotClosesAt:          event.BallotClosesAt,
+		BallotPaymentHours:      event.BallotPaymentHours,
+		Currency:                event.Currency,
+		Fees:                    dto.EventFeesFromProto(event.Fees),
 	}
 }
 
 func (s *EventService) TransformEventZoneResponse(ez *eventpb.EventZone) dto.EventZoneResponse {
+	ticketTypes := make([]dto.TicketTypeResponse, 0, len(ez.TicketTypes))
+	for _, tt := range ez.TicketTypes {
+		ticketTypes = append(ticketTypes, s.TransformTicketTypeResponse(tt))
+	}
+
+	priceTiers := make([]dto.PriceTierResponse, 0, len(ez.PriceTiers))
+	for _, pt := range ez.PriceTiers {
+		priceTiers = append(priceTiers, s.TransformPriceTierResponse(pt))
+	}
+
 	return dto.EventZoneResponse{
 		ID:          ez.Id,
 		EventID:     ez.EventId,
 		LocationID:  ez.LocationId,
 		ZoneNumber:  int(ez.ZoneNumber),
-		Price:       ez.Price,
+		Price:       dto.MoneyFromProto(ez.Price),
 		Color:       ez.Color,
 		Name:        ez.Name,
 		Description: ez.Description,
 		IsSoldOut:   ez.IsSoldOut,
+		ZoneType:    ez.ZoneType,
+		Capacity:    int(ez.Capacity),
+		TicketTypes: ticketTypes,
+		PriceTiers:  priceTiers,
+	}
+}
+
+func (s *EventService) TransformTicketTypeResponse(tt *eventpb.TicketType) dto.TicketTypeResponse {
+	return dto.TicketTypeResponse{
+		ID:                   tt.Id,
+		EventZoneID:          tt.EventZoneId,
+		ZoneNumber:           int(tt.ZoneNumber),
+		Name:                 tt.Name,
+		Description:          tt.Description,
+		Price:                dto.MoneyFromProto(tt.Price),
+		QuantityCap:          tt.QuantityCap,
+		RequiresVerification: tt.RequiresVerification,
+	}
+}
+
+func (s *EventService) TransformPriceTierResponse(pt *eventpb.PriceTier) dto.PriceTierResponse {
+	return dto.PriceTierResponse{
+		ID:          pt.Id,
+		EventZoneID: pt.EventZoneId,
+		ZoneNumber:  int(pt.ZoneNumber),
+		Name:        pt.Name,
+		Price:       dto.MoneyFromProto(pt.Price),
+		StartsAt:    pt.StartsAt,
+		EndsAt:      pt.EndsAt,
+		SellThrough: pt.SellThrough,
 	}
 }
 
@@ -83,13 +134,23 @@ func (s *EventService) GetEvent(ctx context.Context, req *dto.GetEventRequest) (
 
 func (s *EventService) CreateEvent(ctx context.Context, req *dto.CreateEventRequest) (string, error) {
 	response, err := s.client.CreateEvent(ctx, &eventpb.CreateEventRequest{
-		Name:        req.Name,
-		Description: req.Description,
-		LocationId:  req.LocationID,
-		Artist:      req.Artist,
-		EventDate:   req.EventDate,
-		Thumbnail:   req.Thumbnail,
-		Images:      req.Images,
+		Name:                    req.Name,
+		Description:             req.Description,
+		LocationId:              req.LocationID,
+		Artist:                  req.Artist,
+		EventDate:               req.EventDate,
+		Thumbnail:               req.Thumbnail,
+		Images:                  req.Images,
+		MaxTicketsPerUser:       req.MaxTicketsPerUser,
+		MaxHoldExtensionSeconds: req.MaxHoldExtensionSeconds,
+		RefundDeadlineHours:     req.RefundDeadlineHours,
+		RefundPercentage:        req.RefundPercentage,
+		TransferCutoffHours:     req.TransferCutoffHours,
+		BallotOpensAt:           req.BallotOpensAt,
+		BallotClosesAt:          req.BallotClosesAt,
+		BallotPaymentHours:      req.BallotPaymentHours,
+		Currency:                req.Currency,
+		Fees:                    req.Fees.ToProto(),
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to create event")
@@ -100,14 +161,24 @@ func (s *EventService) CreateEvent(ctx context.Context, req *dto.CreateEventRequ
 
 func (s *EventService) UpdateEvent(ctx context.Context, req *dto.UpdateEventRequest) (string, error) {
 	response, err := s.client.UpdateEvent(ctx, &eventpb.UpdateEventRequest{
-		Id:          req.ID,
-		Name:        &req.Name,
-		Description: &req.Description,
-		LocationId:  &req.LocationID,
-		Artist:      req.Artist,
-		EventDate:   &req.EventDate,
-		Thumbnail:   &req.Thumbnail,
-		Images:      req.Images,
+		Id:                      req.ID,
+		Name:                    &req.Name,
+		Description:             &req.Description,
+		LocationId:              &req.LocationID,
+		Artist:                  req.Artist,
+		EventDate:               &req.EventDate,
+		Thumbnail:               &req.Thumbnail,
+		Images:                  req.Images,
+		MaxTicketsPerUser:       req.MaxTicketsPerUser,
+		MaxHoldExtensionSeconds: req.MaxHoldExtensionSeconds,
+		RefundDeadlineHours:     req.RefundDeadlineHours,
+		RefundPercentage:        req.RefundPercentage,
+		TransferCutoffHours:     req.TransferCutoffHours,
+		BallotOpensAt:           req.BallotOpensAt,
+		BallotClosesAt:          req.BallotClosesAt,
+		BallotPaymentHours:      req.BallotPaymentHours,
+		Currency:                req.Currency,
+		Fees:                    req.Fees.ToProto(),
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to update event")
@@ -148,10 +219,12 @@ func (s *EventService) CreateEventZone(ctx context.Context, req *dto.CreateEvent
 		EventId:     req.EventID,
 		LocationId:  req.LocationID,
 		ZoneNumber:  int32(req.ZoneNumber),
-		Price:       req.Price,
+		Price:       req.Price.ToProto(),
 		Color:       req.Color,
 		Name:        req.Name,
 		Description: req.Description,
+		ZoneType:    req.ZoneType,
+		Capacity:    req.Capacity,
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to create event zone")
@@ -162,17 +235,23 @@ func (s *EventService) CreateEventZone(ctx context.Context, req *dto.CreateEvent
 
 func (s *EventService) UpdateEventZone(ctx context.Context, req *dto.UpdateEventZoneRequest) (string, error) {
 	zoneNum := int32(req.ZoneNumber)
+	var price *moneypb.Money
+	if req.Price != nil {
+		price = req.Price.ToProto()
+	}
 
 	response, err := s.client.UpdateEventZone(ctx, &eventpb.UpdateEventZoneRequest{
 		Id:          req.ID,
 		EventId:     &req.EventID,
 		LocationId:  &req.LocationID,
 		ZoneNumber:  &zoneNum,
-		Price:       &req.Price,
+		Price:       price,
 		Color:       &req.Color,
 		Name:        &req.Name,
 		Description: &req.Description,
 		IsSoldOut:   &req.IsSoldOut,
+		ZoneType:    req.ZoneType,
+		Capacity:    req.Capacity,
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to update event zone")
@@ -191,3 +270,99 @@ func (s *EventService) DeleteEventZone(ctx context.Context, req *dto.DeleteEvent
 
 	return nil
 }
+
+func (s *EventService) CreateTicketType(ctx context.Context, req *dto.CreateTicketTypeRequest) (string, error) {
+	response, err := s.client.CreateTicketType(ctx, &eventpb.CreateTicketTypeRequest{
+		EventZoneId:          req.EventZoneID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Price:                req.Price.ToProto(),
+		QuantityCap:          req.QuantityCap,
+		RequiresVerification: req.RequiresVerification,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create ticket type")
+	}
+
+	return response.Id, nil
+}
+
+func (s *EventService) UpdateTicketType(ctx context.Context, req *dto.UpdateTicketTypeRequest) (string, error) {
+	var price *moneypb.Money
+	if req.Price != nil {
+		price = req.Price.ToProto()
+	}
+
+	response, err := s.client.UpdateTicketType(ctx, &eventpb.UpdateTicketTypeRequest{
+		Id:                   req.ID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Price:                price,
+		QuantityCap:          req.QuantityCap,
+		RequiresVerification: req.RequiresVerification,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to update ticket type")
+	}
+
+	return response.Id, nil
+}
+
+func (s *EventService) DeleteTicketType(ctx context.Context, req *dto.DeleteTicketTypeRequest) error {
+	_, err := s.client.DeleteTicketType(ctx, &eventpb.DeleteTicketTypeRequest{
+		Id: req.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete ticket type")
+	}
+
+	return nil
+}
+
+func (s *EventService) CreatePriceTier(ctx context.Context, req *dto.CreatePriceTierRequest) (string, error) {
+	response, err := s.client.CreatePriceTier(ctx, &eventpb.CreatePriceTierRequest{
+		EventZoneId: req.EventZoneID,
+		Name:        req.Name,
+		Price:       req.Price.ToProto(),
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		SellThrough: req.SellThrough,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create price tier")
+	}
+
+	return response.Id, nil
+}
+
+func (s *EventService) UpdatePriceTier(ctx context.Context, req *dto.UpdatePriceTierRequest) (string, error) {
+	var price *moneypb.Money
+	if req.Price != nil {
+		price = req.Price.ToProto()
+	}
+
+	response, err := s.client.UpdatePriceTier(ctx, &eventpb.UpdatePriceTierRequest{
+		Id:          req.ID,
+		Name:        req.Name,
+		Price:       price,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		SellThrough: req.SellThrough,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to update price tier")
+	}
+
+	return response.Id, nil
+}
+
+func (s *EventService) DeletePriceTier(ctx context.Context, req *dto.DeletePriceTierRequest) error {
+	_, err := s.client.DeletePriceTier(ctx, &eventpb.DeletePriceTierRequest{
+		Id: req.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete price tier")
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ func (s *LocationService) TransformZoneResponses(zone *locationpb.Zone) dto.Zone
 	return dto.ZoneResponse{
 		ZoneNumber:   int(zone.ZoneNumber),
 		ZoneName:     zone.ZoneName,
+		ZoneType:     zone.ZoneType,
 		Capacity:     int(zone.Capacity),
 		SeatsPerRow:  int(zone.SeatsPerRow),
 		NumberOfRows: int(zone.NumberOfRows),
@@ -79,6 +80,7 @@ func (s *LocationService) CreateLocation(ctx context.Context, req *dto.CreateLoc
 		zones = append(zones, &locationpb.Zone{
 			ZoneNumber:   int32(zoneReq.ZoneNumber),
 			ZoneName:     zoneReq.ZoneName,
+			ZoneType:     zoneReq.ZoneType,
 			Capacity:     int32(zoneReq.Capacity),
 			SeatsPerRow:  int32(zoneReq.SeatsPerRow),
 			NumberOfRows: int32(zoneReq.NumberOfRows),
@@ -109,6 +111,7 @@ func (s *LocationService) UpdateLocation(ctx context.Context, req *dto.UpdateLoc
 		zones = append(zones, &locationpb.Zone{
 			ZoneNumber:   int32(zoneReq.ZoneNumber),
 			ZoneName:     zoneReq.ZoneName,
+			ZoneType:     zoneReq.ZoneType,
 			Capacity:     int32(zoneReq.Capacity),
 			SeatsPerRow:  int32(zoneReq.SeatsPerRow),
 			NumberOfRows: int32(zoneReq.NumberOfRows),
@@ -0,0 +1,171 @@
+package promotion
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Handler struct {
+	service        *PromotionService
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewHandler(service *PromotionService, authMiddleware authentication.AuthMiddleware) *Handler {
+	return &Handler{
+		service:        service,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (h *Handler) Mount(r fiber.Router) {
+	group := r.Group("/promotions")
+	group.Post("/", h.authMiddleware.Auth, h.CreatePromotion)
+	group.Get("/", h.authMiddleware.Auth, h.ListPromotions)
+	group.Delete("/:id", h.authMiddleware.Auth, h.DeactivatePromotion)
+}
+
+// @Summary      	Create Promotion
+// @Description  	Add a promo code, for admins. A PERCENTAGE code takes a share off every seat in its scope, a FIXED code takes an amount off the reservation once. Percentages are taken before fixed amounts and no seat goes below zero. Codes are case insensitive and stored upper case
+// @Tags			promotions
+// @Router			/v1/promotions [POST]
+// @Security		ApiKeyAuth
+// @Param			body	body		dto.CreatePromotionRequest	true	"Create promotion request"
+// @Success			200 {object}	dto.HttpResponse[dto.PromotionDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CreatePromotion(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CreatePromotionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Create(ctx, &req, userID)
+	if err != nil {
+		if h.service.NotAdmin(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.InvalidPromotion(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.CodeTaken(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.PromotionDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	List Promotions
+// @Description  	List every promo code with its redemptions, for admins
+// @Tags			promotions
+// @Router			/v1/promotions [GET]
+// @Security		ApiKeyAuth
+// @Success			200 {object}	dto.HttpResponse[dto.ListPromotionsResponse]
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ListPromotions(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.List(ctx, userID)
+	if err != nil {
+		if h.service.NotAdmin(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ListPromotionsResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Deactivate Promotion
+// @Description  	Stop a promo code from being redeemed, for admins. Reservations that already used it keep their discount
+// @Tags			promotions
+// @Router			/v1/promotions/{id} [DELETE]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Promotion ID"
+// @Success			200 {object}	dto.HttpResponse[dto.DeactivatePromotionResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			404	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) DeactivatePromotion(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.DeactivatePromotionRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	id, err := h.service.Deactivate(ctx, &req, userID)
+	if err != nil {
+		if h.service.NotAdmin(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.NotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.DeactivatePromotionResponse]{
+		Result: dto.DeactivatePromotionResponse{
+			ID: id,
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package promotion
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/auth"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotAdmin is returned when a user who is not an admin tries to manage promo codes
+var ErrNotAdmin = errors.New("only admins can manage promo codes")
+
+type PromotionService struct {
+	client      reservationpb.ReservationServiceClient
+	authService *auth.AuthService
+}
+
+func NewService(client reservationpb.ReservationServiceClient, authService *auth.AuthService) *PromotionService {
+	return &PromotionService{
+		client:      client,
+		authService: authService,
+	}
+}
+
+// requireAdmin returns ErrNotAdmin unless the user is an admin
+func (s *PromotionService) requireAdmin(ctx context.Context, userID uuid.UUID) error {
+	profile, err := s.authService.GetProfile(ctx, &dto.GetProfileRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get profile")
+	}
+	if profile.Role != "ADMIN" {
+		return ErrNotAdmin
+	}
+	return nil
+}
+
+func (s *PromotionService) TransformPromotionToDTO(promotion *reservationpb.Promotion) dto.PromotionDTO {
+	promotionDTO := dto.PromotionDTO{
+		ID:                    promotion.Id,
+		Code:                  promotion.Code,
+		DiscountType:          promotion.DiscountType,
+		PercentOff:            promotion.PercentOff,
+		EventID:               promotion.EventId,
+		ZoneNumber:            promotion.ZoneNumber,
+		MaxRedemptions:        promotion.MaxRedemptions,
+		MaxRedemptionsPerUser: promotion.MaxRedemptionsPerUser,
+		RedemptionCount:       promotion.RedemptionCount,
+		Stackable:             promotion.Stackable,
+		StartsAt:              promotion.StartsAt,
+		EndsAt:                promotion.EndsAt,
+		Active:                promotion.Active,
+		CreatedAt:             promotion.CreatedAt,
+	}
+	if promotion.AmountOff.GetAmount() > 0 {
+		amountOff := dto.MoneyFromProto(promotion.AmountOff)
+		promotionDTO.AmountOff = &amountOff
+	}
+	return promotionDTO
+}
+
+// Create adds a promo code
+func (s *PromotionService) Create(ctx context.Context, req *dto.CreatePromotionRequest, userID uuid.UUID) (dto.PromotionDTO, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return dto.PromotionDTO{}, err
+	}
+
+	var amountOff *moneypb.Money
+	if req.AmountOff != nil {
+		amountOff = req.AmountOff.ToProto()
+	}
+
+	response, err := s.client.CreatePromotion(ctx, &reservationpb.CreatePromotionRequest{
+		Code:                  req.Code,
+		DiscountType:          req.DiscountType,
+		PercentOff:            req.PercentOff,
+		AmountOff:             amountOff,
+		EventId:               req.EventID,
+		ZoneNumber:            req.ZoneNumber,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+		Stackable:             req.Stackable,
+		StartsAt:              req.StartsAt,
+		EndsAt:                req.EndsAt,
+	})
+	if err != nil {
+		return dto.PromotionDTO{}, errors.Wrap(err, "failed to create promotion")
+	}
+
+	return s.TransformPromotionToDTO(response.Promotion), nil
+}
+
+// List returns every promo code, latest first
+func (s *PromotionService) List(ctx context.Context, userID uuid.UUID) (dto.ListPromotionsResponse, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return dto.ListPromotionsResponse{}, err
+	}
+
+	response, err := s.client.ListPromotions(ctx, &reservationpb.ListPromotionsRequest{})
+	if err != nil {
+		return dto.ListPromotionsResponse{}, errors.Wrap(err, "failed to list promotions")
+	}
+
+	promotions := make([]dto.PromotionDTO, 0, len(response.Promotions))
+	for _, promotion := range response.Promotions {
+		promotions = append(promotions, s.TransformPromotionToDTO(promotion))
+	}
+	return dto.ListPromotionsResponse{
+		Promotions: promotions,
+	}, nil
+}
+
+// Deactivate stops a promo code from being redeemed
+func (s *PromotionService) Deactivate(ctx context.Context, req *dto.DeactivatePromotionRequest, userID uuid.UUID) (string, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return "", err
+	}
+
+	response, err := s.client.DeactivatePromotion(ctx, &reservationpb.DeactivatePromotionRequest{
+		Id: req.ID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to deactivate promotion")
+	}
+
+	return response.Id, nil
+}
+
+// NotAdmin reports a promo code change by a user who is not an admin
+func (s *PromotionService) NotAdmin(err error) bool {
+	return errors.Is(err, ErrNotAdmin)
+}
+
+// InvalidPromotion reports promo code settings that are not valid
+func (s *PromotionService) InvalidPromotion(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.InvalidArgument
+}
+
+// CodeTaken reports a promo code that already exists
+func (s *PromotionService) CodeTaken(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.AlreadyExists
+}
+
+// NotFound reports a promo code that does not exist
+func (s *PromotionService) NotFound(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.NotFound
+}
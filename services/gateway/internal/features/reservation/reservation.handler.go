@@ -2,43 +2,57 @@ package reservation
 
 import (
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/admission"
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
 	"github.com/gofiber/fiber/v2"
 )
 
+const maxIdempotencyKeyLength = 255
+
 type Handler struct {
 	service        *ReservationService
 	authMiddleware authentication.AuthMiddleware
+	passMiddleware admission.PassMiddleware
 }
 
-func NewHandler(service *ReservationService, authMiddleware authentication.AuthMiddleware) *Handler {
+func NewHandler(service *ReservationService, authMiddleware authentication.AuthMiddleware, passMiddleware admission.PassMiddleware) *Handler {
 	return &Handler{
 		service:        service,
 		authMiddleware: authMiddleware,
+		passMiddleware: passMiddleware,
 	}
 }
 
 func (h *Handler) Mount(r fiber.Router) {
 	group := r.Group("/reservations")
-	group.Post("/", h.authMiddleware.Auth, h.CreateReservation)
+	group.Post("/", h.authMiddleware.Auth, h.passMiddleware.RequireReservationPass, h.CreateReservation)
+	group.Post("/best-available", h.authMiddleware.Auth, h.passMiddleware.RequireReservationPass, h.ReserveBestAvailable)
 	group.Delete("/:id", h.authMiddleware.Auth, h.DeleteReservation)
 	group.Get("/:id", h.authMiddleware.Auth, h.GetReservation)
 	group.Get("/", h.authMiddleware.Auth, h.ListReservation)
 	group.Post("/:id/confirm", h.authMiddleware.Auth, h.ConfirmReservation)
+	group.Post("/:id/extend", h.authMiddleware.Auth, h.ExtendReservation)
+	group.Post("/:id/refund", h.authMiddleware.Auth, h.RefundReservation)
+	group.Post("/:id/tickets/cancel", h.authMiddleware.Auth, h.CancelTickets)
 
 	// Event seats endpoint
 	eventGroup := r.Group("/events")
-	eventGroup.Get("/:eventId/seats", h.GetEventSeats)
+	eventGroup.Get("/:eventId/seats", h.passMiddleware.RequireSeatsPass, h.GetEventSeats)
+	eventGroup.Get("/:eventId/pricing", h.GetEventPricing)
 }
 
 // @Summary      	Create Reservation
-// @Description  	Create a new reservation
+// @Description  	Create a new reservation. Events allocated by ballot refuse reservations with 409 until the draw is done. Up to 3 promo codes are taken off the total price, a code that is not valid for the seats is refused with 400 and a fully redeemed one with 409. Going over the ticket limit per user of the event is refused with 400
 // @Tags			reservations
 // @Router			/v1/reservations [POST]
 // @Security		ApiKeyAuth
+// @Param			Idempotency-Key	header	string	false	"Retries with the same key return the first reservation"
+// @Param			X-Waiting-Room-Pass	header	string	false	"Pass from the waiting room, required while the event has one"
 // @Param			body	body		dto.CreateReservationRequest	true	"Create reservation request"
 // @Success			200 {object}	dto.HttpResponse[dto.CreateReservationResponse]
 // @Failure			400	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			409	{object}	dto.SeatConflictError
 // @Failure			500	{object}	dto.HttpError
 func (h *Handler) CreateReservation(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -57,8 +71,46 @@ func (h *Handler) CreateReservation(c *fiber.Ctx) error {
 		})
 	}
 
-	id, err := h.service.CreateReservation(ctx, &req, userID)
+	idempotencyKey := c.Get("Idempotency-Key")
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Idempotency-Key header is too long",
+		})
+	}
+
+	id, err := h.service.CreateReservation(ctx, &req, userID, idempotencyKey)
 	if err != nil {
+		if h.service.TicketLimitExceeded(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if seats, ok := h.service.SeatConflicts(err); ok {
+			return c.Status(fiber.StatusConflict).JSON(dto.SeatConflictError{
+				Error: "seat already reserved",
+				Seats: seats,
+			})
+		}
+		if h.service.IdempotencyKeyInUse(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: "a request with this Idempotency-Key is still in progress",
+			})
+		}
+		if h.service.SaleNotOpen(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.PromoCodeRejected(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.PromoCodeExhausted(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
 			Error: err.Error(),
 		})
@@ -71,6 +123,73 @@ func (h *Handler) CreateReservation(c *fiber.Ctx) error {
 	})
 }
 
+// @Summary      	Reserve Best Available
+// @Description  	Hold the best free seats of a zone, front rows first, then central columns. Events allocated by ballot refuse reservations with 409 until the draw is done. Promo codes work as for Create Reservation
+// @Tags			reservations
+// @Router			/v1/reservations/best-available [POST]
+// @Security		ApiKeyAuth
+// @Param			X-Waiting-Room-Pass	header	string	false	"Pass from the waiting room, required while the event has one"
+// @Param			body	body		dto.ReserveBestAvailableRequest	true	"Reserve best available request"
+// @Success			200 {object}	dto.HttpResponse[dto.ReserveBestAvailableResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ReserveBestAvailable(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ReserveBestAvailableRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	if req.Quantity <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "quantity must be positive",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.ReserveBestAvailable(ctx, &req, userID)
+	if err != nil {
+		if h.service.TicketLimitExceeded(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.SeatsTaken(err) || h.service.SaleNotOpen(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.PromoCodeRejected(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.PromoCodeExhausted(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ReserveBestAvailableResponse]{
+		Result: result,
+	})
+}
+
 // @Summary      	Delete Reservation
 // @Description  	Delete a reservation
 // @Tags			reservations
@@ -198,13 +317,163 @@ func (h *Handler) ConfirmReservation(c *fiber.Ctx) error {
 	})
 }
 
+// @Summary      	Extend Reservation
+// @Description  	Extend a pending reservation hold once, up to the maximum of the event. The extended hold is paid on a new checkout session, open it with the returned client secret
+// @Tags			reservations
+// @Router			/v1/reservations/{id}/extend [POST]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Reservation ID"
+// @Param			body	body		dto.ExtendReservationRequest	false	"Extend reservation request"
+// @Success			200 {object}	dto.HttpResponse[dto.ExtendReservationResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ExtendReservation(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ExtendReservationRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: "Invalid request body",
+			})
+		}
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.ExtendReservation(ctx, &req, userID)
+	if err != nil {
+		if h.service.HoldNotExtendable(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ExtendReservationResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Refund Reservation
+// @Description  	Cancel a confirmed reservation and refund it under the refund policy of the event
+// @Tags			reservations
+// @Router			/v1/reservations/{id}/refund [POST]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Reservation ID"
+// @Success			200 {object}	dto.HttpResponse[dto.RefundReservationResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) RefundReservation(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.RefundReservationRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.RefundReservation(ctx, &req, userID)
+	if err != nil {
+		if h.service.RefundNotAllowed(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.RefundReservationResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Cancel Tickets
+// @Description  	Cancel some tickets of a confirmed reservation and refund their zone prices under the refund policy of the event
+// @Tags			reservations
+// @Router			/v1/reservations/{id}/tickets/cancel [POST]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Reservation ID"
+// @Param			body	body		dto.CancelTicketsRequest	true	"Cancel tickets request"
+// @Success			200 {object}	dto.HttpResponse[dto.CancelTicketsResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CancelTickets(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CancelTicketsRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.CancelTickets(ctx, &req, userID)
+	if err != nil {
+		if h.service.RefundNotAllowed(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.CancelTicketsResponse]{
+		Result: result,
+	})
+}
+
 // @Summary      	Get Event Seats
 // @Description  	Get all reserved/pending seats for an event
 // @Tags			events
 // @Router			/v1/events/{eventId}/seats [GET]
 // @Param			eventId	path		string	true	"Event ID"
+// @Param			X-Waiting-Room-Pass	header	string	false	"Pass from the waiting room, required while the event has one"
 // @Success			200 {object}	dto.HttpResponse[dto.GetEventSeatsResponse]
 // @Failure			400	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
 // @Failure			500	{object}	dto.HttpError
 func (h *Handler) GetEventSeats(c *fiber.Ctx) error {
 	ctx := c.UserContext()
@@ -216,7 +485,7 @@ func (h *Handler) GetEventSeats(c *fiber.Ctx) error {
 		})
 	}
 
-	seats, err := h.service.GetEventSeats(ctx, eventID)
+	result, err := h.service.GetEventSeats(ctx, eventID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
 			Error: err.Error(),
@@ -224,8 +493,36 @@ func (h *Handler) GetEventSeats(c *fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.GetEventSeatsResponse]{
-		Result: dto.GetEventSeatsResponse{
-			Seats: seats,
-		},
+		Result: result,
+	})
+}
+
+// @Summary      	Get Event Pricing
+// @Description  	Get the price every zone of an event sells at now, with the current and next price tier so the countdown to the next price can be shown. A hold locks the price it was placed at
+// @Tags			events
+// @Router			/v1/events/{eventId}/pricing [GET]
+// @Param			eventId	path		string	true	"Event ID"
+// @Success			200 {object}	dto.HttpResponse[dto.GetEventPricingResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) GetEventPricing(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	eventID := c.Params("eventId")
+	if eventID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Event ID is required",
+		})
+	}
+
+	result, err := h.service.GetEventPricing(ctx, eventID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.GetEventPricingResponse]{
+		Result: result,
 	})
 }
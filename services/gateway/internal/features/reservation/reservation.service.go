@@ -8,6 +8,8 @@ import (
 	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ReservationService struct {
@@ -22,47 +24,73 @@ func NewService(client reservationpb.ReservationServiceClient) *ReservationServi
 
 // TransformSeatToDTO transforms a protobuf Seat to DTO
 func (s *ReservationService) TransformSeatToDTO(seat *reservationpb.Seat) dto.SeatDTO {
-	return dto.SeatDTO{
-		ZoneNumber: seat.ZoneNumber,
-		Row:        seat.Row,
-		Column:     seat.Column,
-		Price:      seat.Price,
+	seatDTO := dto.SeatDTO{
+		ZoneNumber:   seat.ZoneNumber,
+		Row:          seat.Row,
+		Column:       seat.Column,
+		TicketID:     seat.TicketId,
+		TicketTypeID: seat.TicketTypeId,
+	}
+	if seat.Price != nil {
+		price := dto.MoneyFromProto(seat.Price)
+		seatDTO.Price = &price
 	}
+	return seatDTO
 }
 
 // TransformSeatToProto transforms a DTO Seat to protobuf
 func (s *ReservationService) TransformSeatToProto(seat dto.SeatDTO) *reservationpb.Seat {
-	return &reservationpb.Seat{
+	seatProto := &reservationpb.Seat{
 		ZoneNumber: seat.ZoneNumber,
 		Row:        seat.Row,
 		Column:     seat.Column,
-		Price:      seat.Price,
 	}
+	if seat.Price != nil {
+		seatProto.Price = seat.Price.ToProto()
+	}
+	return seatProto
 }
 
 // TransformSeatToProto transforms a DTO Seat to protobuf
 func (s *ReservationService) TransformCreateReservationSeatToProto(seat dto.CreateReservationSeatDTO) *reservationpb.CreateReservationSeatRequest {
 	return &reservationpb.CreateReservationSeatRequest{
-		ZoneNumber: seat.ZoneNumber,
-		Row:        seat.Row,
-		Column:     seat.Column,
+		ZoneNumber:   seat.ZoneNumber,
+		Row:          seat.Row,
+		Column:       seat.Column,
+		TicketTypeId: seat.TicketTypeID,
 	}
 }
 
 // CreateReservation creates a new reservation
-func (s *ReservationService) CreateReservation(ctx context.Context, req *dto.CreateReservationRequest, userID uuid.UUID) (string, error) {
+func (s *ReservationService) CreateReservation(ctx context.Context, req *dto.CreateReservationRequest, userID uuid.UUID, idempotencyKey string) (string, error) {
 	seats := make([]*reservationpb.CreateReservationSeatRequest, 0, len(req.Seats))
 	for _, seat := range req.Seats {
 		seats = append(seats, s.TransformCreateReservationSeatToProto(seat))
 	}
 
+	generalAdmission := make([]*reservationpb.GeneralAdmissionRequest, 0, len(req.GeneralAdmission))
+	for _, ga := range req.GeneralAdmission {
+		generalAdmission = append(generalAdmission, &reservationpb.GeneralAdmissionRequest{
+			ZoneNumber:   ga.ZoneNumber,
+			Quantity:     ga.Quantity,
+			TicketTypeId: ga.TicketTypeID,
+		})
+	}
+
 	transUserID := userID.String()
 
-	response, err := s.client.CreateReservation(ctx, &reservationpb.CreateReservationRequest{
-		UserId:  transUserID,
-		EventId: req.EventID,
-		Seats:   seats,
-	})
+	request := &reservationpb.CreateReservationRequest{
+		UserId:           transUserID,
+		EventId:          req.EventID,
+		Seats:            seats,
+		GeneralAdmission: generalAdmission,
+		PromoCodes:       req.PromoCodes,
+	}
+	if idempotencyKey != "" {
+		request.IdempotencyKey = &idempotencyKey
+	}
+
+	response, err := s.client.CreateReservation(ctx, request)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to create reservation")
 	}
@@ -70,6 +98,92 @@ func (s *ReservationService) CreateReservation(ctx context.Context, req *dto.Cre
 	return response.Id, nil
 }
 
+// ReserveBestAvailable reserves the best free seats of a zone
+func (s *ReservationService) ReserveBestAvailable(ctx context.Context, req *dto.ReserveBestAvailableRequest, userID uuid.UUID) (dto.ReserveBestAvailableResponse, error) {
+	response, err := s.client.ReserveBestAvailable(ctx, &reservationpb.ReserveBestAvailableRequest{
+		UserId:     userID.String(),
+		EventId:    req.EventID,
+		ZoneNumber: req.ZoneNumber,
+		Quantity:   req.Quantity,
+		Contiguous: req.Contiguous,
+		PromoCodes: req.PromoCodes,
+	})
+	if err != nil {
+		return dto.ReserveBestAvailableResponse{}, errors.Wrap(err, "failed to reserve best available seats")
+	}
+
+	seats := make([]dto.CreateReservationSeatDTO, 0, len(response.Seats))
+	for _, seat := range response.Seats {
+		seats = append(seats, dto.CreateReservationSeatDTO{
+			ZoneNumber: seat.ZoneNumber,
+			Row:        seat.Row,
+			Column:     seat.Column,
+		})
+	}
+
+	return dto.ReserveBestAvailableResponse{
+		ID:    response.Id,
+		Seats: seats,
+	}, nil
+}
+
+// SeatConflicts extracts the seats that blocked a reservation, if the error carries them
+func (s *ReservationService) SeatConflicts(err error) ([]dto.CreateReservationSeatDTO, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		conflict, ok := detail.(*reservationpb.SeatConflict)
+		if !ok {
+			continue
+		}
+
+		seats := make([]dto.CreateReservationSeatDTO, 0, len(conflict.Seats))
+		for _, seat := range conflict.Seats {
+			seats = append(seats, dto.CreateReservationSeatDTO{
+				ZoneNumber: seat.ZoneNumber,
+				Row:        seat.Row,
+				Column:     seat.Column,
+			})
+		}
+		return seats, true
+	}
+
+	return nil, false
+}
+
+// SeatsTaken reports whether the seats were taken by other buyers while they were being held
+func (s *ReservationService) SeatsTaken(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.AlreadyExists
+}
+
+// IdempotencyKeyInUse reports whether the reservation was rejected because a request with the same idempotency key is still running
+func (s *ReservationService) IdempotencyKeyInUse(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Aborted
+}
+
+// SaleNotOpen reports a reservation for an event whose tickets are still being allocated by ballot
+func (s *ReservationService) SaleNotOpen(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.FailedPrecondition
+}
+
+// PromoCodeRejected reports promo codes that are unknown, expired, out of scope or not combinable
+func (s *ReservationService) PromoCodeRejected(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.InvalidArgument
+}
+
+// PromoCodeExhausted reports a promo code that reached its redemption cap, overall or for the user
+func (s *ReservationService) PromoCodeExhausted(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.ResourceExhausted
+}
+
 // DeleteReservation deletes a reservation
 func (s *ReservationService) DeleteReservation(ctx context.Context, req *dto.DeleteReservationRequest) (string, error) {
 	response, err := s.client.DeleteReservation(ctx, &reservationpb.DeleteReservationRequest{
@@ -96,15 +210,54 @@ func (s *ReservationService) GetReservation(ctx context.Context, req *dto.GetRes
 		seats = append(seats, s.TransformSeatToDTO(seat))
 	}
 
+	history := make([]dto.TicketHistoryDTO, 0, len(response.History))
+	for _, entry := range response.History {
+		history = append(history, dto.TicketHistoryDTO{
+			TicketID:       entry.TicketId,
+			ZoneNumber:     entry.ZoneNumber,
+			Row:            entry.Row,
+			Column:         entry.Column,
+			Action:         entry.Action,
+			RefundedAmount: dto.MoneyFromProto(entry.RefundedAmount),
+			CreatedAt:      entry.CreatedAt,
+		})
+	}
+
+	promotions := make([]dto.AppliedPromotionDTO, 0, len(response.Promotions))
+	for _, promotion := range response.Promotions {
+		promotions = append(promotions, dto.AppliedPromotionDTO{
+			Code:   promotion.Code,
+			Amount: dto.MoneyFromProto(promotion.Amount),
+		})
+	}
+
+	lineItems := make([]dto.LineItemDTO, 0, len(response.LineItems))
+	for _, item := range response.LineItems {
+		lineItems = append(lineItems, dto.LineItemDTO{
+			Kind:        item.Kind,
+			Name:        item.Name,
+			Description: item.Description,
+			ZoneNumber:  item.ZoneNumber,
+			Quantity:    item.Quantity,
+			UnitAmount:  dto.MoneyFromProto(item.UnitAmount),
+			Amount:      dto.MoneyFromProto(item.Amount),
+		})
+	}
+
 	return dto.GetReservationResponse{
 		ID:                 response.Id,
 		UserID:             response.UserId,
 		EventID:            response.EventId,
-		TotalPrice:         response.TotalPrice,
+		TotalPrice:         dto.MoneyFromProto(response.TotalPrice),
 		Seats:              seats,
 		StripeClientSecret: response.StripeClientSecret,
 		TimeLeft:           *response.TimeLeft,
 		Status:             response.Status,
+		History:            history,
+		Promotions:         promotions,
+		Currency:           response.Currency,
+		LineItems:          lineItems,
+		Breakdown:          dto.PriceBreakdownFromProto(response.Breakdown),
 	}, nil
 }
 
@@ -132,11 +285,13 @@ func (s *ReservationService) ListReservation(ctx context.Context, userID uuid.UU
 			ID:                 reservation.Id,
 			UserID:             reservation.UserId,
 			EventID:            reservation.EventId,
-			TotalPrice:         reservation.TotalPrice,
+			TotalPrice:         dto.MoneyFromProto(reservation.TotalPrice),
 			Seats:              seats,
 			StripeClientSecret: reservation.StripeClientSecret,
 			TimeLeft:           0, // HACK
-			Status:             reservation.Status})
+			Status:             reservation.Status,
+			Currency:           reservation.Currency,
+			Breakdown:          dto.PriceBreakdownFromProto(reservation.Breakdown)})
 	}
 
 	return reservations, nil
@@ -158,13 +313,78 @@ func (s *ReservationService) ConfirmReservation(ctx context.Context, req *dto.Co
 	}, nil
 }
 
+// ExtendReservation gives the owner of a pending reservation more time to pay
+func (s *ReservationService) ExtendReservation(ctx context.Context, req *dto.ExtendReservationRequest, userID uuid.UUID) (dto.ExtendReservationResponse, error) {
+	response, err := s.client.ExtendReservation(ctx, &reservationpb.ExtendReservationRequest{
+		Id:      req.ID,
+		UserId:  userID.String(),
+		Seconds: req.Seconds,
+	})
+	if err != nil {
+		return dto.ExtendReservationResponse{}, errors.Wrap(err, "failed to extend reservation")
+	}
+
+	return dto.ExtendReservationResponse{
+		ID:                 response.Id,
+		TimeLeft:           response.TimeLeft,
+		StripeClientSecret: response.StripeClientSecret,
+	}, nil
+}
+
+// HoldNotExtendable reports an extension refused because the hold expired or was extended already
+func (s *ReservationService) HoldNotExtendable(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.FailedPrecondition
+}
+
+// RefundReservation cancels a confirmed reservation of the user under the refund policy of its event
+func (s *ReservationService) RefundReservation(ctx context.Context, req *dto.RefundReservationRequest, userID uuid.UUID) (dto.RefundReservationResponse, error) {
+	response, err := s.client.RefundReservation(ctx, &reservationpb.RefundReservationRequest{
+		Id:     req.ID,
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.RefundReservationResponse{}, errors.Wrap(err, "failed to refund reservation")
+	}
+
+	return dto.RefundReservationResponse{
+		ID:             response.Id,
+		RefundedAmount: dto.MoneyFromProto(response.RefundedAmount),
+		Status:         response.Status,
+	}, nil
+}
+
+// CancelTickets cancels some tickets of a confirmed reservation of the user and refunds them
+func (s *ReservationService) CancelTickets(ctx context.Context, req *dto.CancelTicketsRequest, userID uuid.UUID) (dto.CancelTicketsResponse, error) {
+	response, err := s.client.CancelTickets(ctx, &reservationpb.CancelTicketsRequest{
+		Id:        req.ID,
+		UserId:    userID.String(),
+		TicketIds: req.TicketIDs,
+	})
+	if err != nil {
+		return dto.CancelTicketsResponse{}, errors.Wrap(err, "failed to cancel tickets")
+	}
+
+	return dto.CancelTicketsResponse{
+		ID:             response.Id,
+		RefundedAmount: dto.MoneyFromProto(response.RefundedAmount),
+		TotalPrice:     dto.MoneyFromProto(response.TotalPrice),
+	}, nil
+}
+
+// RefundNotAllowed reports a refund refused by the refund policy or the reservation status
+func (s *ReservationService) RefundNotAllowed(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.FailedPrecondition
+}
+
 // GetEventSeats gets all reserved/pending seats for an event
-func (s *ReservationService) GetEventSeats(ctx context.Context, eventID string) ([]dto.SeatStatusDTO, error) {
+func (s *ReservationService) GetEventSeats(ctx context.Context, eventID string) (dto.GetEventSeatsResponse, error) {
 	response, err := s.client.GetEventSeats(ctx, &reservationpb.GetEventSeatsRequest{
 		EventId: eventID,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get event seats")
+		return dto.GetEventSeatsResponse{}, errors.Wrap(err, "failed to get event seats")
 	}
 
 	seats := make([]dto.SeatStatusDTO, 0, len(response.Seats))
@@ -177,5 +397,71 @@ func (s *ReservationService) GetEventSeats(ctx context.Context, eventID string)
 		})
 	}
 
-	return seats, nil
+	generalAdmission := make([]dto.GeneralAdmissionStatusDTO, 0, len(response.GeneralAdmission))
+	for _, ga := range response.GeneralAdmission {
+		generalAdmission = append(generalAdmission, dto.GeneralAdmissionStatusDTO{
+			ZoneNumber: ga.ZoneNumber,
+			Capacity:   ga.Capacity,
+			Taken:      ga.Taken,
+		})
+	}
+
+	return dto.GetEventSeatsResponse{
+		Seats:            seats,
+		GeneralAdmission: generalAdmission,
+	}, nil
+}
+
+// GetEventPricing gets the price every zone of an event sells at now with its current and next tier
+func (s *ReservationService) GetEventPricing(ctx context.Context, eventID string) (dto.GetEventPricingResponse, error) {
+	response, err := s.client.GetZonePricing(ctx, &reservationpb.GetZonePricingRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return dto.GetEventPricingResponse{}, errors.Wrap(err, "failed to get event pricing")
+	}
+
+	zones := make([]dto.ZonePricingDTO, 0, len(response.Zones))
+	for _, zone := range response.Zones {
+		zones = append(zones, dto.ZonePricingDTO{
+			ZoneNumber:  zone.ZoneNumber,
+			Price:       dto.MoneyFromProto(zone.Price),
+			CurrentTier: zonePriceTierFromProto(zone.CurrentTier),
+			NextTier:    zonePriceTierFromProto(zone.NextTier),
+			Sold:        zone.Sold,
+		})
+	}
+
+	return dto.GetEventPricingResponse{
+		Zones:      zones,
+		ResolvedAt: response.ResolvedAt,
+	}, nil
+}
+
+func zonePriceTierFromProto(tier *reservationpb.ZonePriceTier) *dto.ZonePriceTierDTO {
+	if tier == nil {
+		return nil
+	}
+	return &dto.ZonePriceTierDTO{
+		ID:        tier.Id,
+		Name:      tier.Name,
+		Price:     dto.MoneyFromProto(tier.Price),
+		StartsAt:  tier.StartsAt,
+		EndsAt:    tier.EndsAt,
+		Remaining: tier.Remaining,
+	}
+}
+
+// TicketLimitExceeded reports a request that would take the user over the ticket limit per user of the event
+func (s *ReservationService) TicketLimitExceeded(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*reservationpb.TicketLimitExceeded); ok {
+			return true
+		}
+	}
+	return false
 }
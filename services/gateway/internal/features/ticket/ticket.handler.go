@@ -0,0 +1,344 @@
+package ticket
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Handler struct {
+	service        *TicketService
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewHandler(service *TicketService, authMiddleware authentication.AuthMiddleware) *Handler {
+	return &Handler{
+		service:        service,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (h *Handler) Mount(r fiber.Router) {
+	group := r.Group("/tickets")
+	group.Get("/", h.authMiddleware.Auth, h.ListTickets)
+	group.Post("/check-in", h.authMiddleware.Auth, h.CheckIn)
+	group.Get("/check-in/bundle", h.authMiddleware.Auth, h.GetScanBundle)
+	group.Post("/check-in/sync", h.authMiddleware.Auth, h.UploadScans)
+	group.Get("/transfers", h.authMiddleware.Auth, h.ListTransfers)
+	group.Post("/transfers/:id/accept", h.authMiddleware.Auth, h.AcceptTransfer)
+	group.Post("/:id/transfer", h.authMiddleware.Auth, h.OfferTransfer)
+}
+
+// @Summary      	List Tickets
+// @Description  	List the live tickets of the user, including tickets transferred to them
+// @Tags			tickets
+// @Router			/v1/tickets [GET]
+// @Security		ApiKeyAuth
+// @Success			200 {object}	dto.HttpResponse[dto.ListTicketsResponse]
+// @Failure			401	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ListTickets(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.ListTickets(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ListTicketsResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Offer Ticket Transfer
+// @Description  	Offer a ticket to another registered user by email, offering it again withdraws the previous offer
+// @Tags			tickets
+// @Router			/v1/tickets/{id}/transfer [POST]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Ticket ID"
+// @Param			body	body		dto.OfferTicketTransferRequest	true	"Offer ticket transfer request"
+// @Success			200 {object}	dto.HttpResponse[dto.TicketTransferDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			404	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) OfferTransfer(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.OfferTicketTransferRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	if err := c.BodyParser(&req); err != nil || req.Email == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.OfferTransfer(ctx, &req, userID)
+	if err != nil {
+		if h.service.RecipientNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.HttpError{
+				Error: "no user is registered with this email",
+			})
+		}
+		if h.service.TransferNotAllowed(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.TicketTransferDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	List Ticket Transfers
+// @Description  	List the pending transfers offered by or to the user
+// @Tags			tickets
+// @Router			/v1/tickets/transfers [GET]
+// @Security		ApiKeyAuth
+// @Success			200 {object}	dto.HttpResponse[dto.ListTicketTransfersResponse]
+// @Failure			401	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ListTransfers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.ListTransfers(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ListTicketTransfersResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Accept Ticket Transfer
+// @Description  	Accept a transfer offered to the user, the ticket gets a new credential and the previous one stops being valid. A transfer that takes the user over the ticket limit per user of the event is refused with 400
+// @Tags			tickets
+// @Router			/v1/tickets/transfers/{id}/accept [POST]
+// @Security		ApiKeyAuth
+// @Param			id		path		string	true	"Transfer ID"
+// @Success			200 {object}	dto.HttpResponse[dto.AcceptTicketTransferResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) AcceptTransfer(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.AcceptTicketTransferRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.AcceptTransfer(ctx, &req, userID)
+	if err != nil {
+		if h.service.TicketLimitExceeded(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.TransferNotAllowed(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.AcceptTicketTransferResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Check In Ticket
+// @Description  	Check in a ticket credential scanned at a gate, for door staff. A ticket is admitted once, later scans are refused with the gate and time of the first one
+// @Tags			tickets
+// @Router			/v1/tickets/check-in [POST]
+// @Security		ApiKeyAuth
+// @Param			body	body		dto.CheckInTicketRequest	true	"Check in ticket request"
+// @Success			200 {object}	dto.HttpResponse[dto.CheckInTicketResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CheckIn(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CheckInTicketRequest
+	if err := c.BodyParser(&req); err != nil || req.Credential == "" || req.EventID == "" || req.GateID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.CheckIn(ctx, &req, userID)
+	if err != nil {
+		if h.service.NotDoorStaff(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.InvalidCredential(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: "invalid credential",
+			})
+		}
+		if h.service.CheckInRefused(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.CheckInTicketResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Get Scan Bundle
+// @Description  	Download what door devices need to check in the tickets of an event offline, for door staff: the key verifying credentials and the paid tickets with their credential version and revocation status
+// @Tags			tickets
+// @Router			/v1/tickets/check-in/bundle [GET]
+// @Security		ApiKeyAuth
+// @Param			eventId	query		string	true	"Event ID"
+// @Success			200 {object}	dto.HttpResponse[dto.ScanBundleResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) GetScanBundle(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.GetScanBundleRequest
+	if err := c.QueryParser(&req); err != nil || req.EventID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.GetScanBundle(ctx, &req, userID)
+	if err != nil {
+		if h.service.NotDoorStaff(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ScanBundleResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Upload Offline Scans
+// @Description  	Upload the scan log of a door device that checked in tickets offline, for door staff. Scans of a ticket are resolved by their timestamp across gates, the earliest admits the ticket and the others are reported as conflicts
+// @Tags			tickets
+// @Router			/v1/tickets/check-in/sync [POST]
+// @Security		ApiKeyAuth
+// @Param			body	body		dto.UploadScansRequest	true	"Upload scans request"
+// @Success			200 {object}	dto.HttpResponse[dto.UploadScansResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) UploadScans(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.UploadScansRequest
+	if err := c.BodyParser(&req); err != nil || req.EventID == "" || len(req.Scans) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.UploadScans(ctx, &req, userID)
+	if err != nil {
+		if h.service.NotDoorStaff(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.UploadScansResponse]{
+		Result: result,
+	})
+}
@@ -0,0 +1,303 @@
+package ticket
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/auth"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotDoorStaff is returned when a user who is not door staff tries to check in or sync tickets
+var ErrNotDoorStaff = errors.New("only door staff can check in tickets")
+
+// roles allowed to check in tickets at the door
+var doorStaffRoles = []string{"STAFF", "ADMIN"}
+
+type TicketService struct {
+	client      reservationpb.ReservationServiceClient
+	authService *auth.AuthService
+}
+
+func NewService(client reservationpb.ReservationServiceClient, authService *auth.AuthService) *TicketService {
+	return &TicketService{
+		client:      client,
+		authService: authService,
+	}
+}
+
+func (s *TicketService) TransformTicketToDTO(ticket *reservationpb.Ticket) dto.TicketDTO {
+	return dto.TicketDTO{
+		ID:                   ticket.Id,
+		EventID:              ticket.EventId,
+		ReservationID:        ticket.ReservationId,
+		ZoneNumber:           ticket.ZoneNumber,
+		Row:                  ticket.Row,
+		Column:               ticket.Column,
+		CredentialVersion:    ticket.CredentialVersion,
+		Credential:           ticket.Credential,
+		CheckedInAt:          ticket.CheckedInAt,
+		TicketTypeID:         ticket.TicketTypeId,
+		RequiresVerification: ticket.RequiresVerification,
+	}
+}
+
+func (s *TicketService) TransformTransferToDTO(transfer *reservationpb.TicketTransfer) dto.TicketTransferDTO {
+	return dto.TicketTransferDTO{
+		ID:         transfer.Id,
+		TicketID:   transfer.TicketId,
+		EventID:    transfer.EventId,
+		FromUserID: transfer.FromUserId,
+		ToUserID:   transfer.ToUserId,
+		Status:     transfer.Status,
+		ZoneNumber: transfer.ZoneNumber,
+		Row:        transfer.Row,
+		Column:     transfer.Column,
+		CreatedAt:  transfer.CreatedAt,
+	}
+}
+
+// ListTickets lists the live tickets the user owns
+func (s *TicketService) ListTickets(ctx context.Context, userID uuid.UUID) (dto.ListTicketsResponse, error) {
+	response, err := s.client.ListTickets(ctx, &reservationpb.ListTicketsRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.ListTicketsResponse{}, errors.Wrap(err, "failed to list tickets")
+	}
+
+	tickets := make([]dto.TicketDTO, 0, len(response.Tickets))
+	for _, ticket := range response.Tickets {
+		tickets = append(tickets, s.TransformTicketToDTO(ticket))
+	}
+
+	return dto.ListTicketsResponse{
+		Tickets: tickets,
+	}, nil
+}
+
+// OfferTransfer offers a ticket of the user to the registered user with the given email
+func (s *TicketService) OfferTransfer(ctx context.Context, req *dto.OfferTicketTransferRequest, userID uuid.UUID) (dto.TicketTransferDTO, error) {
+	recipient, err := s.authService.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return dto.TicketTransferDTO{}, errors.Wrap(err, "failed to find recipient")
+	}
+
+	response, err := s.client.OfferTicketTransfer(ctx, &reservationpb.OfferTicketTransferRequest{
+		TicketId: req.ID,
+		UserId:   userID.String(),
+		ToUserId: recipient.ID.String(),
+	})
+	if err != nil {
+		return dto.TicketTransferDTO{}, errors.Wrap(err, "failed to offer ticket transfer")
+	}
+
+	return s.TransformTransferToDTO(response.Transfer), nil
+}
+
+// AcceptTransfer accepts a transfer offered to the user
+func (s *TicketService) AcceptTransfer(ctx context.Context, req *dto.AcceptTicketTransferRequest, userID uuid.UUID) (dto.AcceptTicketTransferResponse, error) {
+	response, err := s.client.AcceptTicketTransfer(ctx, &reservationpb.AcceptTicketTransferRequest{
+		Id:     req.ID,
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.AcceptTicketTransferResponse{}, errors.Wrap(err, "failed to accept ticket transfer")
+	}
+
+	return dto.AcceptTicketTransferResponse{
+		Transfer: s.TransformTransferToDTO(response.Transfer),
+		Ticket:   s.TransformTicketToDTO(response.Ticket),
+	}, nil
+}
+
+// ListTransfers lists the pending transfers offered by or to the user
+func (s *TicketService) ListTransfers(ctx context.Context, userID uuid.UUID) (dto.ListTicketTransfersResponse, error) {
+	response, err := s.client.ListTicketTransfers(ctx, &reservationpb.ListTicketTransfersRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.ListTicketTransfersResponse{}, errors.Wrap(err, "failed to list ticket transfers")
+	}
+
+	transfers := make([]dto.TicketTransferDTO, 0, len(response.Transfers))
+	for _, transfer := range response.Transfers {
+		transfers = append(transfers, s.TransformTransferToDTO(transfer))
+	}
+
+	return dto.ListTicketTransfersResponse{
+		Transfers: transfers,
+	}, nil
+}
+
+// requireDoorStaff returns ErrNotDoorStaff unless the user is door staff
+func (s *TicketService) requireDoorStaff(ctx context.Context, userID uuid.UUID) error {
+	profile, err := s.authService.GetProfile(ctx, &dto.GetProfileRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get profile")
+	}
+	if !slices.Contains(doorStaffRoles, profile.Role) {
+		return ErrNotDoorStaff
+	}
+	return nil
+}
+
+// CheckIn checks in a ticket credential scanned at a gate, the user must be door staff
+func (s *TicketService) CheckIn(ctx context.Context, req *dto.CheckInTicketRequest, userID uuid.UUID) (dto.CheckInTicketResponse, error) {
+	if err := s.requireDoorStaff(ctx, userID); err != nil {
+		return dto.CheckInTicketResponse{}, err
+	}
+
+	response, err := s.client.CheckInTicket(ctx, &reservationpb.CheckInTicketRequest{
+		Credential: req.Credential,
+		EventId:    req.EventID,
+		GateId:     req.GateID,
+	})
+	if err != nil {
+		return dto.CheckInTicketResponse{}, errors.Wrap(err, "failed to check in ticket")
+	}
+
+	return dto.CheckInTicketResponse{
+		TicketID:             response.TicketId,
+		EventID:              response.EventId,
+		ZoneNumber:           response.ZoneNumber,
+		Row:                  response.Row,
+		Column:               response.Column,
+		Status:               response.Status,
+		CheckedInAt:          response.CheckedInAt,
+		GateID:               response.GateId,
+		TicketTypeID:         response.TicketTypeId,
+		RequiresVerification: response.RequiresVerification,
+	}, nil
+}
+
+// GetScanBundle returns the scan bundle door devices use to check in the tickets of an event offline
+func (s *TicketService) GetScanBundle(ctx context.Context, req *dto.GetScanBundleRequest, userID uuid.UUID) (dto.ScanBundleResponse, error) {
+	if err := s.requireDoorStaff(ctx, userID); err != nil {
+		return dto.ScanBundleResponse{}, err
+	}
+
+	response, err := s.client.GetScanBundle(ctx, &reservationpb.GetScanBundleRequest{
+		EventId: req.EventID,
+	})
+	if err != nil {
+		return dto.ScanBundleResponse{}, errors.Wrap(err, "failed to get scan bundle")
+	}
+
+	tickets := make([]dto.ScanBundleTicketDTO, 0, len(response.Tickets))
+	for _, ticket := range response.Tickets {
+		tickets = append(tickets, dto.ScanBundleTicketDTO{
+			TicketID:             ticket.TicketId,
+			CredentialVersion:    ticket.CredentialVersion,
+			Revoked:              ticket.Revoked,
+			CheckedInAt:          ticket.CheckedInAt,
+			RequiresVerification: ticket.RequiresVerification,
+		})
+	}
+
+	return dto.ScanBundleResponse{
+		EventID:     response.EventId,
+		PublicKey:   response.PublicKey,
+		GeneratedAt: response.GeneratedAt,
+		Tickets:     tickets,
+	}, nil
+}
+
+// UploadScans uploads the offline scan log of a door device
+func (s *TicketService) UploadScans(ctx context.Context, req *dto.UploadScansRequest, userID uuid.UUID) (dto.UploadScansResponse, error) {
+	if err := s.requireDoorStaff(ctx, userID); err != nil {
+		return dto.UploadScansResponse{}, err
+	}
+
+	scans := make([]*reservationpb.OfflineScan, 0, len(req.Scans))
+	for _, scan := range req.Scans {
+		scans = append(scans, &reservationpb.OfflineScan{
+			Credential: scan.Credential,
+			GateId:     scan.GateID,
+			ScannedAt:  scan.ScannedAt,
+		})
+	}
+
+	response, err := s.client.UploadScans(ctx, &reservationpb.UploadScansRequest{
+		EventId: req.EventID,
+		Scans:   scans,
+	})
+	if err != nil {
+		return dto.UploadScansResponse{}, errors.Wrap(err, "failed to upload scans")
+	}
+
+	results := make([]dto.ScanResultDTO, 0, len(response.Results))
+	for _, result := range response.Results {
+		results = append(results, dto.ScanResultDTO{
+			TicketID:  result.TicketId,
+			GateID:    result.GateId,
+			ScannedAt: result.ScannedAt,
+			Status:    result.Status,
+		})
+	}
+
+	conflicts := make([]dto.ScanConflictDTO, 0, len(response.Conflicts))
+	for _, conflict := range response.Conflicts {
+		conflicts = append(conflicts, dto.ScanConflictDTO{
+			TicketID:       conflict.TicketId,
+			AdmittedGateID: conflict.AdmittedGateId,
+			AdmittedAt:     conflict.AdmittedAt,
+			RejectedGateID: conflict.RejectedGateId,
+			RejectedAt:     conflict.RejectedAt,
+		})
+	}
+
+	return dto.UploadScansResponse{
+		Results:   results,
+		Conflicts: conflicts,
+	}, nil
+}
+
+// NotDoorStaff reports a check in by a user who is not door staff
+func (s *TicketService) NotDoorStaff(err error) bool {
+	return errors.Is(err, ErrNotDoorStaff)
+}
+
+// InvalidCredential reports a scanned credential that is malformed or not signed by the reservation service
+func (s *TicketService) InvalidCredential(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.InvalidArgument
+}
+
+// CheckInRefused reports a ticket that was checked in before, given back or transferred
+func (s *TicketService) CheckInRefused(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && (st.Code() == codes.AlreadyExists || st.Code() == codes.FailedPrecondition)
+}
+
+// RecipientNotFound reports an offer to an email nobody signed up with
+func (s *TicketService) RecipientNotFound(err error) bool {
+	return errors.Is(err, auth.ErrUserNotFound)
+}
+
+// TransferNotAllowed reports a transfer refused by the cutoff of the event or the state of the ticket or offer
+func (s *TicketService) TransferNotAllowed(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.FailedPrecondition
+}
+
+// TicketLimitExceeded reports a request that would take the user over the ticket limit per user of the event
+func (s *TicketService) TicketLimitExceeded(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*reservationpb.TicketLimitExceeded); ok {
+			return true
+		}
+	}
+	return false
+}
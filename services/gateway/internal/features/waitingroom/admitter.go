@@ -0,0 +1,223 @@
+package waitingroom
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/admission"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	admitterLeaseKey = "waitingroom:admitter:leader"
+	// pushConcurrency bounds the realtime pushes in flight while positions are announced
+	pushConcurrency = 16
+)
+
+// renewLeaseScript extends a lease in KEYS[1] held by ARGV[1] or takes it if it is free.
+// ARGV[2] is the lease in milliseconds. It returns 1 when the caller holds the lease.
+var renewLeaseScript = redis.NewScript(`
+local owner = redis.call('GET', KEYS[1])
+if owner == ARGV[1] then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 1
+end
+if owner == false then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// drawScript gives the users in the lobby of KEYS the next positions in a random order seeded by
+// ARGV[1] and marks the room drawn, joins after it go straight to the queue. It returns the users drawn.
+var drawScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'drawn') == '1' then
+	return 0
+end
+local members = redis.call('SMEMBERS', KEYS[2])
+math.randomseed(tonumber(ARGV[1]))
+for i = #members, 2, -1 do
+	local j = math.random(i)
+	members[i], members[j] = members[j], members[i]
+end
+for _, member in ipairs(members) do
+	local seq = redis.call('HINCRBY', KEYS[1], 'seq', 1)
+	redis.call('ZADD', KEYS[3], 'NX', seq, member)
+end
+redis.call('DEL', KEYS[2])
+redis.call('HSET', KEYS[1], 'drawn', '1')
+return #members
+`)
+
+// admitScript moves up to ARGV[1] users from the head of the queue in KEYS[1] to the admitted set in
+// KEYS[2] with a pass expiring at ARGV[3], keeping at most ARGV[2] live passes unless it is 0.
+// ARGV[4] is now in milliseconds. It returns the users admitted.
+var admitScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', ARGV[4])
+local count = tonumber(ARGV[1])
+local maxAdmitted = tonumber(ARGV[2])
+if maxAdmitted > 0 then
+	count = math.min(count, maxAdmitted - redis.call('ZCARD', KEYS[2]))
+end
+if count <= 0 then
+	return {}
+end
+local popped = redis.call('ZPOPMIN', KEYS[1], count)
+local users = {}
+for i = 1, #popped, 2 do
+	redis.call('ZADD', KEYS[2], ARGV[3], popped[i])
+	table.insert(users, popped[i])
+end
+return users
+`)
+
+// StartAdmitting admits the next batch of every open waiting room on every interval until ctx is done.
+// Only the replica holding the admitter lease admits, the others stand by to take over.
+func (s *WaitingRoomService) StartAdmitting(ctx context.Context) {
+	ticker := time.NewTicker(s.config.AdmitInterval)
+	defer ticker.Stop()
+
+	instance := uuid.New().String()
+	logger.InfoContext(ctx, "waiting room admitter started", slog.String("instance", instance))
+
+	for {
+		select {
+		case <-ticker.C:
+			held, err := renewLeaseScript.Run(ctx, s.redisClient, []string{admitterLeaseKey}, instance, (2 * s.config.AdmitInterval).Milliseconds()).Int()
+			if err != nil {
+				logger.ErrorContext(ctx, "renew admitter lease failed", slog.Any("error", err))
+				continue
+			}
+			if held != 1 {
+				continue
+			}
+			s.admitAll(ctx)
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "waiting room admitter stopped")
+			return
+		}
+	}
+}
+
+func (s *WaitingRoomService) admitAll(ctx context.Context) {
+	eventIDs, err := s.redisClient.SMembers(ctx, roomsKey).Result()
+	if err != nil {
+		logger.ErrorContext(ctx, "list waiting rooms failed", slog.Any("error", err))
+		return
+	}
+
+	for _, eventID := range eventIDs {
+		if err := s.admit(ctx, eventID); err != nil {
+			logger.ErrorContext(ctx, "admit waiting room failed", slog.String("eventID", eventID), slog.Any("error", err))
+		}
+	}
+}
+
+// admit draws the positions of a RANDOM room once the sale opens, admits its next batch and tells
+// the admitted users their pass and everyone still waiting their new position.
+func (s *WaitingRoomService) admit(ctx context.Context, eventID string) error {
+	room, err := s.redisClient.HGetAll(ctx, admission.RoomKey(eventID)).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to get waiting room")
+	}
+	if len(room) == 0 {
+		// closed meanwhile
+		return s.redisClient.SRem(ctx, roomsKey, eventID).Err()
+	}
+
+	now := time.Now()
+	if now.Before(time.UnixMilli(parseRoomInt(room, "opens_at"))) {
+		return nil
+	}
+
+	moved := false
+	if room["order"] == OrderRandom && room["drawn"] != "1" {
+		drawn, err := drawScript.Run(ctx, s.redisClient, roomKeys(eventID), rand.Int64N(1<<31)).Int()
+		if err != nil {
+			return errors.Wrap(err, "failed to draw positions")
+		}
+		moved = drawn > 0
+	}
+
+	expiresAt := now.Add(s.config.PassTTL)
+	users, err := admitScript.Run(ctx, s.redisClient, []string{queueKey(eventID), admittedKey(eventID)},
+		parseRoomInt(room, "batch_size"), parseRoomInt(room, "max_admitted"), expiresAt.UnixMilli(), now.UnixMilli()).StringSlice()
+	if err != nil {
+		return errors.Wrap(err, "failed to admit users")
+	}
+
+	for _, user := range users {
+		userID, err := uuid.Parse(user)
+		if err != nil {
+			continue
+		}
+		pass, err := s.signer.Sign(admission.Pass{
+			UserID:    userID,
+			EventID:   eventID,
+			ExpiresAt: expiresAt.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.realtime.PushMessage(ctx, userID, "waitingroom.admitted", dto.WaitingRoomAdmittedMessage{
+			EventID:       eventID,
+			Pass:          pass,
+			PassExpiresAt: expiresAt.Format(time.RFC3339),
+		}); err != nil {
+			// the user still finds the pass by polling the status
+			logger.ErrorContext(ctx, "push waiting room admission failed", slog.Any("error", err))
+		}
+	}
+
+	if len(users) > 0 {
+		logger.InfoContext(ctx, "waiting room batch admitted", slog.String("eventID", eventID), slog.Int("users", len(users)))
+		moved = true
+	}
+	if moved {
+		return s.pushPositions(ctx, eventID)
+	}
+	return nil
+}
+
+// pushPositions tells every user in the queue their current position
+func (s *WaitingRoomService) pushPositions(ctx context.Context, eventID string) error {
+	users, err := s.redisClient.ZRange(ctx, queueKey(eventID), 0, -1).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to list waiting users")
+	}
+
+	sem := make(chan struct{}, pushConcurrency)
+	var wg sync.WaitGroup
+	for i, user := range users {
+		userID, err := uuid.Parse(user)
+		if err != nil {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(position int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := s.realtime.PushMessage(ctx, userID, "waitingroom.position", dto.WaitingRoomPositionMessage{
+				EventID:  eventID,
+				Position: position,
+			}); err != nil {
+				logger.ErrorContext(ctx, "push waiting room position failed", slog.Any("error", err))
+			}
+		}(int64(i + 1))
+	}
+	wg.Wait()
+
+	return nil
+}
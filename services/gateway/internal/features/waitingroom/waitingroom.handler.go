@@ -0,0 +1,231 @@
+package waitingroom
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Handler struct {
+	service        *WaitingRoomService
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewHandler(service *WaitingRoomService, authMiddleware authentication.AuthMiddleware) *Handler {
+	return &Handler{
+		service:        service,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (h *Handler) Mount(r fiber.Router) {
+	group := r.Group("/events/:eventId/waiting-room")
+	group.Put("/", h.authMiddleware.Auth, h.OpenWaitingRoom)
+	group.Delete("/", h.authMiddleware.Auth, h.CloseWaitingRoom)
+	group.Get("/", h.authMiddleware.Auth, h.GetWaitingRoomStatus)
+	group.Post("/join", h.authMiddleware.Auth, h.JoinWaitingRoom)
+}
+
+// @Summary      	Open Waiting Room
+// @Description  	Put the on-sale of an event behind a waiting room, for admins. While it is open, reservations and the seat map of the event need a pass from the waiting room. Opening it again changes its settings and keeps the queue
+// @Tags			waiting-room
+// @Router			/v1/events/{eventId}/waiting-room [PUT]
+// @Security		ApiKeyAuth
+// @Param			eventId	path		string	true	"Event ID"
+// @Param			body	body		dto.OpenWaitingRoomRequest	true	"Open waiting room request"
+// @Success			200 {object}	dto.HttpResponse[dto.WaitingRoomDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) OpenWaitingRoom(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.OpenWaitingRoomRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Open(ctx, &req, userID)
+	if err != nil {
+		if h.service.InvalidWaitingRoom(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.NotAdmin(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.WaitingRoomDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	Close Waiting Room
+// @Description  	Remove the waiting room of an event, for admins. Reservations stop asking for a pass
+// @Tags			waiting-room
+// @Router			/v1/events/{eventId}/waiting-room [DELETE]
+// @Security		ApiKeyAuth
+// @Param			eventId	path		string	true	"Event ID"
+// @Success			204
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			403	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) CloseWaitingRoom(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.WaitingRoomEventRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	if err := h.service.Close(ctx, req.EventID, userID); err != nil {
+		if h.service.InvalidWaitingRoom(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.NotAdmin(err) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary      	Join Waiting Room
+// @Description  	Join the waiting room of an event. Users joining before a RANDOM room opens get their positions drawn when the sale opens, later users queue in the order they join. Position updates and the pass are pushed over the realtime channel as waitingroom.position and waitingroom.admitted
+// @Tags			waiting-room
+// @Router			/v1/events/{eventId}/waiting-room/join [POST]
+// @Security		ApiKeyAuth
+// @Param			eventId	path		string	true	"Event ID"
+// @Success			200 {object}	dto.HttpResponse[dto.WaitingRoomStatusDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			404	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) JoinWaitingRoom(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.WaitingRoomEventRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Join(ctx, req.EventID, userID)
+	if err != nil {
+		if h.service.InvalidWaitingRoom(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.NoWaitingRoom(err) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.WaitingRoomStatusDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	Get Waiting Room Status
+// @Description  	Get the place of the user in the waiting room of an event, with the pass once admitted
+// @Tags			waiting-room
+// @Router			/v1/events/{eventId}/waiting-room [GET]
+// @Security		ApiKeyAuth
+// @Param			eventId	path		string	true	"Event ID"
+// @Success			200 {object}	dto.HttpResponse[dto.WaitingRoomStatusDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			404	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) GetWaitingRoomStatus(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.WaitingRoomEventRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Status(ctx, req.EventID, userID)
+	if err != nil {
+		if h.service.InvalidWaitingRoom(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.NoWaitingRoom(err) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.WaitingRoomStatusDTO]{
+		Result: result,
+	})
+}
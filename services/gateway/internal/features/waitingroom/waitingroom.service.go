@@ -0,0 +1,267 @@
+package waitingroom
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/config"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/features/auth"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/admission"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/realtime"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrNoWaitingRoom is returned when the event has no waiting room
+	ErrNoWaitingRoom = errors.New("event has no waiting room")
+	// ErrNotAdmin is returned when a user who is not an admin tries to open or close a waiting room
+	ErrNotAdmin = errors.New("only admins can manage waiting rooms")
+	// ErrInvalidWaitingRoom is returned when the settings of a waiting room are not valid
+	ErrInvalidWaitingRoom = errors.New("invalid waiting room")
+)
+
+const (
+	OrderRandom    = "RANDOM"
+	OrderFirstCome = "FIRST_COME"
+
+	StatusNotJoined = "NOT_JOINED"
+	StatusLobby     = "LOBBY"
+	StatusWaiting   = "WAITING"
+	StatusAdmitted  = "ADMITTED"
+
+	// roomsKey is the set of events with a waiting room, walked by the admitter
+	roomsKey = "waitingroom:rooms"
+)
+
+// users who joined a RANDOM room before the sale opened wait in the lobby until positions are drawn
+func lobbyKey(eventID string) string {
+	return admission.RoomKey(eventID) + ":lobby"
+}
+
+// the queue scores users by the order they were given, the rank is their position
+func queueKey(eventID string) string {
+	return admission.RoomKey(eventID) + ":queue"
+}
+
+// admitted users are scored by the expiry of their pass in milliseconds
+func admittedKey(eventID string) string {
+	return admission.RoomKey(eventID) + ":admitted"
+}
+
+func roomKeys(eventID string) []string {
+	return []string{admission.RoomKey(eventID), lobbyKey(eventID), queueKey(eventID), admittedKey(eventID)}
+}
+
+// placeScript finds the place of ARGV[1] in the waiting room of KEYS, joining it if ARGV[3] is 1.
+// ARGV[2] is now in milliseconds. It returns a code and a value followed by the opening time:
+// -1 no room, 0 admitted and the pass expiry, 1 waiting and the position, 2 in the lobby, 3 not joined.
+var placeScript = redis.NewScript(`
+local opensAt = redis.call('HGET', KEYS[1], 'opens_at')
+if not opensAt then
+	return {-1, 0, 0}
+end
+local expiry = redis.call('ZSCORE', KEYS[4], ARGV[1])
+if expiry and tonumber(expiry) > tonumber(ARGV[2]) then
+	return {0, tonumber(expiry), tonumber(opensAt)}
+end
+local rank = redis.call('ZRANK', KEYS[3], ARGV[1])
+if rank then
+	return {1, rank + 1, tonumber(opensAt)}
+end
+if redis.call('SISMEMBER', KEYS[2], ARGV[1]) == 1 then
+	return {2, 0, tonumber(opensAt)}
+end
+if ARGV[3] ~= '1' then
+	return {3, 0, tonumber(opensAt)}
+end
+redis.call('ZREM', KEYS[4], ARGV[1])
+local room = redis.call('HMGET', KEYS[1], 'order', 'drawn')
+if room[1] == 'RANDOM' and room[2] ~= '1' then
+	redis.call('SADD', KEYS[2], ARGV[1])
+	return {2, 0, tonumber(opensAt)}
+end
+local seq = redis.call('HINCRBY', KEYS[1], 'seq', 1)
+redis.call('ZADD', KEYS[3], seq, ARGV[1])
+return {1, redis.call('ZRANK', KEYS[3], ARGV[1]) + 1, tonumber(opensAt)}
+`)
+
+type WaitingRoomService struct {
+	config      config.WaitingRoomConfig
+	redisClient *redis.Client
+	signer      *admission.Signer
+	realtime    *realtime.Service
+	authService *auth.AuthService
+}
+
+func NewService(config config.WaitingRoomConfig, redisClient *redis.Client, signer *admission.Signer, realtime *realtime.Service, authService *auth.AuthService) *WaitingRoomService {
+	return &WaitingRoomService{
+		config:      config,
+		redisClient: redisClient,
+		signer:      signer,
+		realtime:    realtime,
+		authService: authService,
+	}
+}
+
+// requireAdmin returns ErrNotAdmin unless the user is an admin
+func (s *WaitingRoomService) requireAdmin(ctx context.Context, userID uuid.UUID) error {
+	profile, err := s.authService.GetProfile(ctx, &dto.GetProfileRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get profile")
+	}
+	if profile.Role != "ADMIN" {
+		return ErrNotAdmin
+	}
+	return nil
+}
+
+// Open puts the on-sale of an event behind a waiting room, opening it again changes its settings and keeps the queue
+func (s *WaitingRoomService) Open(ctx context.Context, req *dto.OpenWaitingRoomRequest, userID uuid.UUID) (dto.WaitingRoomDTO, error) {
+	eventID, err := admission.ParseEventID(req.EventID)
+	if err != nil {
+		return dto.WaitingRoomDTO{}, errors.Wrap(ErrInvalidWaitingRoom, err.Error())
+	}
+	opensAt, err := time.Parse(time.RFC3339, req.OpensAt)
+	if err != nil {
+		return dto.WaitingRoomDTO{}, errors.Wrap(ErrInvalidWaitingRoom, "opensAt must be RFC3339")
+	}
+	if req.Order != OrderRandom && req.Order != OrderFirstCome {
+		return dto.WaitingRoomDTO{}, errors.Wrap(ErrInvalidWaitingRoom, "order must be RANDOM or FIRST_COME")
+	}
+	if req.BatchSize < 1 || req.MaxAdmitted < 0 {
+		return dto.WaitingRoomDTO{}, errors.Wrap(ErrInvalidWaitingRoom, "batchSize must be positive and maxAdmitted not negative")
+	}
+
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return dto.WaitingRoomDTO{}, err
+	}
+
+	_, err = s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, admission.RoomKey(eventID),
+			"opens_at", opensAt.UnixMilli(),
+			"order", req.Order,
+			"batch_size", req.BatchSize,
+			"max_admitted", req.MaxAdmitted)
+		pipe.SAdd(ctx, roomsKey, eventID)
+		return nil
+	})
+	if err != nil {
+		return dto.WaitingRoomDTO{}, errors.Wrap(err, "failed to open waiting room")
+	}
+
+	return dto.WaitingRoomDTO{
+		EventID:     eventID,
+		OpensAt:     opensAt.Format(time.RFC3339),
+		Order:       req.Order,
+		BatchSize:   req.BatchSize,
+		MaxAdmitted: req.MaxAdmitted,
+	}, nil
+}
+
+// Close removes the waiting room of an event, the reservation endpoints stop asking for a pass
+func (s *WaitingRoomService) Close(ctx context.Context, rawEventID string, userID uuid.UUID) error {
+	eventID, err := admission.ParseEventID(rawEventID)
+	if err != nil {
+		return errors.Wrap(ErrInvalidWaitingRoom, err.Error())
+	}
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return err
+	}
+
+	_, err = s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, roomKeys(eventID)...)
+		pipe.SRem(ctx, roomsKey, eventID)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to close waiting room")
+	}
+	return nil
+}
+
+// Join puts the user in the waiting room of an event, joining again keeps the place
+func (s *WaitingRoomService) Join(ctx context.Context, eventID string, userID uuid.UUID) (dto.WaitingRoomStatusDTO, error) {
+	return s.place(ctx, eventID, userID, true)
+}
+
+// Status returns the place of the user in the waiting room of an event
+func (s *WaitingRoomService) Status(ctx context.Context, eventID string, userID uuid.UUID) (dto.WaitingRoomStatusDTO, error) {
+	return s.place(ctx, eventID, userID, false)
+}
+
+func (s *WaitingRoomService) place(ctx context.Context, rawEventID string, userID uuid.UUID, join bool) (dto.WaitingRoomStatusDTO, error) {
+	eventID, err := admission.ParseEventID(rawEventID)
+	if err != nil {
+		return dto.WaitingRoomStatusDTO{}, errors.Wrap(ErrInvalidWaitingRoom, err.Error())
+	}
+
+	joinFlag := "0"
+	if join {
+		joinFlag = "1"
+	}
+
+	place, err := placeScript.Run(ctx, s.redisClient, roomKeys(eventID), userID.String(), time.Now().UnixMilli(), joinFlag).Int64Slice()
+	if err != nil {
+		return dto.WaitingRoomStatusDTO{}, errors.Wrap(err, "failed to find place in waiting room")
+	}
+
+	code, value, opensAt := place[0], place[1], place[2]
+	if code == -1 {
+		return dto.WaitingRoomStatusDTO{}, ErrNoWaitingRoom
+	}
+
+	result := dto.WaitingRoomStatusDTO{
+		EventID: eventID,
+		OpensAt: time.UnixMilli(opensAt).Format(time.RFC3339),
+	}
+	switch code {
+	case 0:
+		expiresAt := time.UnixMilli(value)
+		pass, err := s.signer.Sign(admission.Pass{
+			UserID:    userID,
+			EventID:   eventID,
+			ExpiresAt: expiresAt.Unix(),
+		})
+		if err != nil {
+			return dto.WaitingRoomStatusDTO{}, err
+		}
+		result.Status = StatusAdmitted
+		result.Pass = pass
+		result.PassExpiresAt = expiresAt.Format(time.RFC3339)
+	case 1:
+		result.Status = StatusWaiting
+		result.Position = value
+	case 2:
+		result.Status = StatusLobby
+	default:
+		result.Status = StatusNotJoined
+	}
+
+	return result, nil
+}
+
+// NoWaitingRoom reports an event without a waiting room
+func (s *WaitingRoomService) NoWaitingRoom(err error) bool {
+	return errors.Is(err, ErrNoWaitingRoom)
+}
+
+// NotAdmin reports a waiting room change by a user who is not an admin
+func (s *WaitingRoomService) NotAdmin(err error) bool {
+	return errors.Is(err, ErrNotAdmin)
+}
+
+// InvalidWaitingRoom reports waiting room settings that are not valid
+func (s *WaitingRoomService) InvalidWaitingRoom(err error) bool {
+	return errors.Is(err, ErrInvalidWaitingRoom)
+}
+
+func parseRoomInt(room map[string]string, field string) int64 {
+	value, _ := strconv.ParseInt(room[field], 10, 64)
+	return value
+}
@@ -0,0 +1,153 @@
+package waitlist
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Handler struct {
+	service        *WaitlistService
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewHandler(service *WaitlistService, authMiddleware authentication.AuthMiddleware) *Handler {
+	return &Handler{
+		service:        service,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (h *Handler) Mount(r fiber.Router) {
+	group := r.Group("/waitlist")
+	group.Post("/", h.authMiddleware.Auth, h.JoinWaitlist)
+	group.Get("/", h.authMiddleware.Auth, h.ListWaitlistEntries)
+	group.Delete("/:id", h.authMiddleware.Auth, h.LeaveWaitlist)
+}
+
+// @Summary      	Join Waitlist
+// @Description  	Wait for seats given back in a zone of an event, zone 0 takes seats of any zone. Seats given back are first held for the next user in line, who is notified and has a limited time to pay before the offer moves on
+// @Tags			waitlist
+// @Router			/v1/waitlist [POST]
+// @Security		ApiKeyAuth
+// @Param			body	body		dto.JoinWaitlistRequest	true	"Join waitlist request"
+// @Success			200 {object}	dto.HttpResponse[dto.WaitlistEntryDTO]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) JoinWaitlist(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.JoinWaitlistRequest
+	if err := c.BodyParser(&req); err != nil || req.EventID == "" || req.Quantity <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request body",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.Join(ctx, &req, userID)
+	if err != nil {
+		if h.service.TicketLimitExceeded(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		if h.service.WaitlistConflict(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.WaitlistEntryDTO]{
+		Result: result,
+	})
+}
+
+// @Summary      	List Waitlist Entries
+// @Description  	List the waitlist entries of the user that still wait or hold an offer
+// @Tags			waitlist
+// @Router			/v1/waitlist [GET]
+// @Security		ApiKeyAuth
+// @Success			200 {object}	dto.HttpResponse[dto.ListWaitlistEntriesResponse]
+// @Failure			401	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) ListWaitlistEntries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	result, err := h.service.List(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.ListWaitlistEntriesResponse]{
+		Result: result,
+	})
+}
+
+// @Summary      	Leave Waitlist
+// @Description  	Leave the waitlist, an entry holding an offer is settled by paying or deleting its reservation
+// @Tags			waitlist
+// @Router			/v1/waitlist/{id} [DELETE]
+// @Security		ApiKeyAuth
+// @Param			id	path		string	true	"Waitlist entry ID"
+// @Success			200 {object}	dto.HttpResponse[dto.LeaveWaitlistResponse]
+// @Failure			400	{object}	dto.HttpError
+// @Failure			401	{object}	dto.HttpError
+// @Failure			409	{object}	dto.HttpError
+// @Failure			500	{object}	dto.HttpError
+func (h *Handler) LeaveWaitlist(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.LeaveWaitlistRequest
+	if err := c.ParamsParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: "Invalid request parameters",
+		})
+	}
+
+	userID, err := h.authMiddleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.HttpError{
+			Error: "UNAUTHORIZED",
+		})
+	}
+
+	id, err := h.service.Leave(ctx, &req, userID)
+	if err != nil {
+		if h.service.WaitlistConflict(err) {
+			return c.Status(fiber.StatusConflict).JSON(dto.HttpError{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.HttpResponse[dto.LeaveWaitlistResponse]{
+		Result: dto.LeaveWaitlistResponse{
+			ID: id,
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package waitlist
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WaitlistService struct {
+	client reservationpb.ReservationServiceClient
+}
+
+func NewService(client reservationpb.ReservationServiceClient) *WaitlistService {
+	return &WaitlistService{
+		client: client,
+	}
+}
+
+func (s *WaitlistService) TransformEntryToDTO(entry *reservationpb.WaitlistEntry) dto.WaitlistEntryDTO {
+	return dto.WaitlistEntryDTO{
+		ID:             entry.Id,
+		EventID:        entry.EventId,
+		ZoneNumber:     entry.ZoneNumber,
+		Quantity:       entry.Quantity,
+		Status:         entry.Status,
+		ReservationID:  entry.ReservationId,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
+
+// Join puts the user in line for seats given back in a zone of an event
+func (s *WaitlistService) Join(ctx context.Context, req *dto.JoinWaitlistRequest, userID uuid.UUID) (dto.WaitlistEntryDTO, error) {
+	response, err := s.client.JoinWaitlist(ctx, &reservationpb.JoinWaitlistRequest{
+		UserId:     userID.String(),
+		EventId:    req.EventID,
+		ZoneNumber: req.ZoneNumber,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return dto.WaitlistEntryDTO{}, errors.Wrap(err, "failed to join waitlist")
+	}
+
+	return s.TransformEntryToDTO(response.Entry), nil
+}
+
+// Leave takes a waiting entry of the user off the waitlist
+func (s *WaitlistService) Leave(ctx context.Context, req *dto.LeaveWaitlistRequest, userID uuid.UUID) (string, error) {
+	response, err := s.client.LeaveWaitlist(ctx, &reservationpb.LeaveWaitlistRequest{
+		Id:     req.ID,
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to leave waitlist")
+	}
+
+	return response.Id, nil
+}
+
+// List lists the entries of the user that still wait or hold an offer
+func (s *WaitlistService) List(ctx context.Context, userID uuid.UUID) (dto.ListWaitlistEntriesResponse, error) {
+	response, err := s.client.ListWaitlistEntries(ctx, &reservationpb.ListWaitlistEntriesRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return dto.ListWaitlistEntriesResponse{}, errors.Wrap(err, "failed to list waitlist entries")
+	}
+
+	entries := make([]dto.WaitlistEntryDTO, 0, len(response.Entries))
+	for _, entry := range response.Entries {
+		entries = append(entries, s.TransformEntryToDTO(entry))
+	}
+
+	return dto.ListWaitlistEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
+// WaitlistConflict reports a second entry for the same zone or an entry that no longer waits
+func (s *WaitlistService) WaitlistConflict(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && (st.Code() == codes.AlreadyExists || st.Code() == codes.FailedPrecondition)
+}
+
+// TicketLimitExceeded reports a request that would take the user over the ticket limit per user of the event
+func (s *WaitlistService) TicketLimitExceeded(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*reservationpb.TicketLimitExceeded); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package admission
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/dto"
+	"github.com/cp-rektmart/aconcert-microservice/gateway/internal/middlewares/authentication"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// PassHeader carries the pass issued by the waiting room
+const PassHeader = "X-Waiting-Room-Pass"
+
+// RoomKey is the hash describing the waiting room of an event, the room is open while it exists
+func RoomKey(eventID string) string {
+	return "waitingroom:" + eventID
+}
+
+// ParseEventID returns the event ID in the form waiting rooms are keyed by, so an ID in uppercase or
+// wrapped in braces finds the same room
+func ParseEventID(eventID string) (string, error) {
+	id, err := uuid.Parse(eventID)
+	if err != nil {
+		return "", errors.Wrap(err, "event ID must be a UUID")
+	}
+	return id.String(), nil
+}
+
+type PassMiddleware interface {
+	// RequireReservationPass checks the pass of the signed in user for the event in the request body
+	RequireReservationPass(c *fiber.Ctx) error
+	// RequireSeatsPass checks the pass for the event in the path
+	RequireSeatsPass(c *fiber.Ctx) error
+}
+
+type passMiddleware struct {
+	signer         *Signer
+	redisClient    *redis.Client
+	authMiddleware authentication.AuthMiddleware
+}
+
+func NewPassMiddleware(signer *Signer, redisClient *redis.Client, authMiddleware authentication.AuthMiddleware) PassMiddleware {
+	return &passMiddleware{
+		signer:         signer,
+		redisClient:    redisClient,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (m *passMiddleware) RequireReservationPass(c *fiber.Ctx) error {
+	var body struct {
+		EventID string `json:"eventId"`
+	}
+	if err := c.BodyParser(&body); err != nil || body.EventID == "" {
+		// the handler reports the malformed body
+		return c.Next()
+	}
+
+	return m.requirePass(c, body.EventID, true)
+}
+
+func (m *passMiddleware) RequireSeatsPass(c *fiber.Ctx) error {
+	return m.requirePass(c, c.Params("eventId"), false)
+}
+
+// requirePass lets the request through if the event has no waiting room or the pass admits it
+func (m *passMiddleware) requirePass(c *fiber.Ctx, rawEventID string, ownPass bool) error {
+	ctx := c.UserContext()
+
+	eventID, err := ParseEventID(rawEventID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+
+	open, err := m.roomOpen(ctx, eventID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.HttpError{
+			Error: err.Error(),
+		})
+	}
+	if !open {
+		return c.Next()
+	}
+
+	pass, err := m.signer.Verify(c.Get(PassHeader))
+	if err != nil || pass.EventID != eventID {
+		return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+			Error: "this event has a waiting room, join it to get a pass",
+		})
+	}
+
+	if ownPass {
+		userID, err := m.authMiddleware.GetUserIDFromContext(ctx)
+		if err != nil || userID != pass.UserID {
+			return c.Status(fiber.StatusForbidden).JSON(dto.HttpError{
+				Error: "the waiting room pass belongs to another user",
+			})
+		}
+	}
+
+	return c.Next()
+}
+
+func (m *passMiddleware) roomOpen(ctx context.Context, eventID string) (bool, error) {
+	exists, err := m.redisClient.Exists(ctx, RoomKey(eventID)).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get waiting room")
+	}
+	return exists == 1, nil
+}
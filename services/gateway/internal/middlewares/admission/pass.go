@@ -0,0 +1,75 @@
+package admission
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidPass = errors.New("invalid waiting room pass")
+
+// Pass lets a user admitted from the waiting room of an event use the reservation endpoints until it expires
+type Pass struct {
+	UserID    uuid.UUID `json:"uid"`
+	EventID   string    `json:"eid"`
+	ExpiresAt int64     `json:"exp"`
+}
+
+// Signer signs and verifies passes with a secret shared by all gateway replicas
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret string) (*Signer, error) {
+	if secret == "" {
+		return nil, errors.New("waiting room pass secret is required")
+	}
+	return &Signer{
+		secret: []byte(secret),
+	}, nil
+}
+
+func (s *Signer) mac(payload string) string {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Sign encodes the pass as "payload.signature", both base64url
+func (s *Signer) Sign(pass Pass) (string, error) {
+	data, err := json.Marshal(pass)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal pass")
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + s.mac(payload), nil
+}
+
+// Verify returns the pass of a token signed by Sign that has not expired yet
+func (s *Signer) Verify(token string) (Pass, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.mac(payload))) {
+		return Pass{}, ErrInvalidPass
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Pass{}, ErrInvalidPass
+	}
+
+	var pass Pass
+	if err := json.Unmarshal(data, &pass); err != nil {
+		return Pass{}, ErrInvalidPass
+	}
+	if time.Now().Unix() >= pass.ExpiresAt {
+		return Pass{}, errors.Wrap(ErrInvalidPass, "pass expired")
+	}
+
+	return pass, nil
+}
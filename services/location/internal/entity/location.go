@@ -5,6 +5,11 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	ZoneTypeSeated           = "SEATED"
+	ZoneTypeGeneralAdmission = "GENERAL_ADMISSION"
+)
+
 type LocationEntity struct {
 	ID            primitive.ObjectID `bson:"_id,omitempty"`
 	VenueName     string             `bson:"venue_name"`
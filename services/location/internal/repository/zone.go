@@ -73,6 +73,9 @@ func (r *LocationRepository) UpdateZone(ctx context.Context, locID primitive.Obj
 	if zone.NumberOfRows != 0 {
 		updateFields["zones.$.number_of_rows"] = zone.NumberOfRows
 	}
+	if zone.ZoneType != "" {
+		updateFields["zones.$.zone_type"] = zone.ZoneType
+	}
 
 	if len(updateFields) == 0 {
 		return status.Errorf(codes.InvalidArgument, "no fields to update")
@@ -86,6 +86,16 @@ func validateZones(zones []*locationpb.Zone) error {
 			return status.Errorf(codes.InvalidArgument, "duplicate zone_number: %d", z.ZoneNumber)
 		}
 		seen[z.ZoneNumber] = true
+
+		switch z.ZoneType {
+		case "", entity.ZoneTypeSeated:
+		case entity.ZoneTypeGeneralAdmission:
+			if z.Capacity <= 0 {
+				return status.Errorf(codes.InvalidArgument, "general admission zone %d needs a capacity", z.ZoneNumber)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid zone_type for zone %d: %s", z.ZoneNumber, z.ZoneType)
+		}
 	}
 	return nil
 }
@@ -122,6 +132,13 @@ func collectUpdateFields(req *locationpb.UpdateLocationRequest) bson.M {
 }
 
 func toProtoLocation(loc *entity.LocationEntity) *locationpb.Location {
+	// zones stored before zone types existed are all seated
+	for _, z := range loc.Zones {
+		if z.ZoneType == "" {
+			z.ZoneType = entity.ZoneTypeSeated
+		}
+	}
+
 	return &locationpb.Location{
 		Id:            loc.ID.Hex(),
 		VenueName:     loc.VenueName,
@@ -15,6 +15,10 @@ func (s *LocationService) AddZone(ctx context.Context, req *locationpb.AddZoneRe
 		return nil, status.Errorf(codes.InvalidArgument, "invalid location id: %v", err)
 	}
 
+	if err := validateZones([]*locationpb.Zone{req.Zone}); err != nil {
+		return nil, err
+	}
+
 	if err := s.locationRepo.AddZone(ctx, id, req.Zone); err != nil {
 		return nil, err
 	}
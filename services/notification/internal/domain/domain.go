@@ -42,3 +42,103 @@ func (d *Domain) ReservationCancelledEvent(ctx context.Context, reservation enti
 
 	return nil
 }
+
+// ReservationExtendedEvent tells only the buyer about the new time left on their hold.
+func (d *Domain) ReservationExtendedEvent(ctx context.Context, reservation entities.ExtendedNotiReservation) error {
+	userID, err := uuid.Parse(reservation.UserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "reservation.extended", reservation); err != nil {
+		return errors.Wrap(err, "failed to push reservation.extended message")
+	}
+
+	return nil
+}
+
+// ReservationRefundedEvent tells only the buyer how much of their booking was paid back.
+func (d *Domain) ReservationRefundedEvent(ctx context.Context, reservation entities.RefundedNotiReservation) error {
+	userID, err := uuid.Parse(reservation.UserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "reservation.refunded", reservation); err != nil {
+		return errors.Wrap(err, "failed to push reservation.refunded message")
+	}
+
+	return nil
+}
+
+// TicketTransferOfferedEvent tells the recipient that a ticket is waiting for them to accept it.
+func (d *Domain) TicketTransferOfferedEvent(ctx context.Context, transfer entities.TransferOfferedNotiTicket) error {
+	userID, err := uuid.Parse(transfer.ToUserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "ticket.transfer_offered", transfer); err != nil {
+		return errors.Wrap(err, "failed to push ticket.transfer_offered message")
+	}
+
+	return nil
+}
+
+// TicketTransferredEvent tells both the previous and the new owner that the ticket changed hands.
+func (d *Domain) TicketTransferredEvent(ctx context.Context, transfer entities.TransferredNotiTicket) error {
+	for _, id := range []string{transfer.FromUserID, transfer.ToUserID} {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return errors.Wrap(err, "invalid user id")
+		}
+
+		if err := d.realtime.PushMessage(ctx, userID, "ticket.transferred", transfer); err != nil {
+			return errors.Wrap(err, "failed to push ticket.transferred message")
+		}
+	}
+
+	return nil
+}
+
+// WaitlistOfferedEvent tells a waitlisted user that seats are held for them until the offer expires.
+func (d *Domain) WaitlistOfferedEvent(ctx context.Context, offer entities.OfferedNotiWaitlist) error {
+	userID, err := uuid.Parse(offer.UserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "waitlist.offered", offer); err != nil {
+		return errors.Wrap(err, "failed to push waitlist.offered message")
+	}
+
+	return nil
+}
+
+// BallotWonEvent tells a ballot winner that seats are held for them until the payment deadline.
+func (d *Domain) BallotWonEvent(ctx context.Context, result entities.WonNotiBallot) error {
+	userID, err := uuid.Parse(result.UserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "ballot.won", result); err != nil {
+		return errors.Wrap(err, "failed to push ballot.won message")
+	}
+
+	return nil
+}
+
+// BallotLostEvent tells an applicant that the ballot did not allocate them seats.
+func (d *Domain) BallotLostEvent(ctx context.Context, result entities.LostNotiBallot) error {
+	userID, err := uuid.Parse(result.UserID)
+	if err != nil {
+		return errors.Wrap(err, "invalid user id")
+	}
+
+	if err := d.realtime.PushMessage(ctx, userID, "ballot.lost", result); err != nil {
+		return errors.Wrap(err, "failed to push ballot.lost message")
+	}
+
+	return nil
+}
@@ -2,18 +2,29 @@ package entities
 
 import (
 	"encoding/json"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
 )
 
 type MessageType string
 
 const (
-	MessageTypeEventCreated         MessageType = "event.created"
-	MessageTypeEventUpdated         MessageType = "event.updated"
-	MessageTypeReservationConfirmed MessageType = "reservation.confirmed"
-	MessageTypeReservationCancelled MessageType = "reservation.cancelled"
+	MessageTypeEventCreated          MessageType = "event.created"
+	MessageTypeEventUpdated          MessageType = "event.updated"
+	MessageTypeReservationConfirmed  MessageType = "reservation.confirmed"
+	MessageTypeReservationCancelled  MessageType = "reservation.cancelled"
+	MessageTypeReservationExtended   MessageType = "reservation.extended"
+	MessageTypeReservationRefunded   MessageType = "reservation.refunded"
+	MessageTypeTicketTransferOffered MessageType = "ticket.transfer_offered"
+	MessageTypeTicketTransferred     MessageType = "ticket.transferred"
+	MessageTypeWaitlistOffered       MessageType = "waitlist.offered"
+	MessageTypeBallotWon             MessageType = "ballot.won"
+	MessageTypeBallotLost            MessageType = "ballot.lost"
 )
 
 type Message struct {
+	// ID is set by the producer's outbox and repeats if a message is delivered more than once
+	ID   string          `json:"id"`
 	Type MessageType     `json:"type"`
 	Data json.RawMessage `json:"data"`
 }
@@ -41,4 +52,65 @@ type CancelledNotiReservation struct {
 	ID      string `json:"id"`
 	UserID  string `json:"user_id"`
 	EventID string `json:"event_id"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type ExtendedNotiReservation struct {
+	ID       string  `json:"id"`
+	UserID   string  `json:"user_id"`
+	EventID  string  `json:"event_id"`
+	TimeLeft float64 `json:"time_left"`
+}
+
+type RefundedNotiReservation struct {
+	ID      string      `json:"id"`
+	UserID  string      `json:"user_id"`
+	EventID string      `json:"event_id"`
+	Amount  money.Money `json:"amount"`
+}
+
+type TransferOfferedNotiTicket struct {
+	ID         string `json:"id"`
+	TicketID   string `json:"ticket_id"`
+	EventID    string `json:"event_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+type TransferredNotiTicket struct {
+	TransferID string `json:"transfer_id"`
+	TicketID   string `json:"ticket_id"`
+	EventID    string `json:"event_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+type OfferedNotiWaitlist struct {
+	ID            string      `json:"id"`
+	ReservationID string      `json:"reservation_id"`
+	UserID        string      `json:"user_id"`
+	EventID       string      `json:"event_id"`
+	ZoneNumber    int32       `json:"zone_number"`
+	Quantity      int32       `json:"quantity"`
+	TotalPrice    money.Money `json:"total_price"`
+	ExpiresAt     string      `json:"expires_at"`
+}
+
+type WonNotiBallot struct {
+	ID              string `json:"id"`
+	ReservationID   string `json:"reservation_id"`
+	UserID          string `json:"user_id"`
+	EventID         string `json:"event_id"`
+	ZoneNumber      int32  `json:"zone_number"`
+	Quantity        int32  `json:"quantity"`
+	PaymentDeadline string `json:"payment_deadline"`
+}
+
+type LostNotiBallot struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	EventID    string `json:"event_id"`
+	ZoneNumber int32  `json:"zone_number"`
+	Quantity   int32  `json:"quantity"`
+	Reason     string `json:"reason,omitempty"`
 }
@@ -29,7 +29,7 @@ func (h *Handler) Mount(ctx context.Context, msgs <-chan amqp.Delivery) error {
 			continue
 		}
 
-		fmt.Printf("📩 Received message: %+v\n", eventData.Type)
+		fmt.Printf("📩 Received message: %+v (id=%s)\n", eventData.Type, eventData.ID)
 		switch eventData.Type {
 		case entities.MessageTypeEventCreated:
 			var event entities.Event
@@ -61,6 +61,76 @@ func (h *Handler) Mount(ctx context.Context, msgs <-chan amqp.Delivery) error {
 			if err := h.domain.ReservationCancelledEvent(ctx, reservation); err != nil {
 				log.Printf("Error handling reservation.cancelled: %s", err)
 			}
+		case entities.MessageTypeReservationExtended:
+			var reservation entities.ExtendedNotiReservation
+			if err := json.Unmarshal(eventData.Data, &reservation); err != nil {
+				log.Printf("Error unmarshaling reservation.extended payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.ReservationExtendedEvent(ctx, reservation); err != nil {
+				log.Printf("Error handling reservation.extended: %s", err)
+			}
+		case entities.MessageTypeReservationRefunded:
+			var reservation entities.RefundedNotiReservation
+			if err := json.Unmarshal(eventData.Data, &reservation); err != nil {
+				log.Printf("Error unmarshaling reservation.refunded payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.ReservationRefundedEvent(ctx, reservation); err != nil {
+				log.Printf("Error handling reservation.refunded: %s", err)
+			}
+		case entities.MessageTypeTicketTransferOffered:
+			var transfer entities.TransferOfferedNotiTicket
+			if err := json.Unmarshal(eventData.Data, &transfer); err != nil {
+				log.Printf("Error unmarshaling ticket.transfer_offered payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.TicketTransferOfferedEvent(ctx, transfer); err != nil {
+				log.Printf("Error handling ticket.transfer_offered: %s", err)
+			}
+		case entities.MessageTypeTicketTransferred:
+			var transfer entities.TransferredNotiTicket
+			if err := json.Unmarshal(eventData.Data, &transfer); err != nil {
+				log.Printf("Error unmarshaling ticket.transferred payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.TicketTransferredEvent(ctx, transfer); err != nil {
+				log.Printf("Error handling ticket.transferred: %s", err)
+			}
+		case entities.MessageTypeWaitlistOffered:
+			var offer entities.OfferedNotiWaitlist
+			if err := json.Unmarshal(eventData.Data, &offer); err != nil {
+				log.Printf("Error unmarshaling waitlist.offered payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.WaitlistOfferedEvent(ctx, offer); err != nil {
+				log.Printf("Error handling waitlist.offered: %s", err)
+			}
+		case entities.MessageTypeBallotWon:
+			var result entities.WonNotiBallot
+			if err := json.Unmarshal(eventData.Data, &result); err != nil {
+				log.Printf("Error unmarshaling ballot.won payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.BallotWonEvent(ctx, result); err != nil {
+				log.Printf("Error handling ballot.won: %s", err)
+			}
+		case entities.MessageTypeBallotLost:
+			var result entities.LostNotiBallot
+			if err := json.Unmarshal(eventData.Data, &result); err != nil {
+				log.Printf("Error unmarshaling ballot.lost payload: %s", err)
+				continue
+			}
+
+			if err := h.domain.BallotLostEvent(ctx, result); err != nil {
+				log.Printf("Error handling ballot.lost: %s", err)
+			}
 		default:
 			log.Printf("Unknown message type: %s", eventData.Type)
 			continue
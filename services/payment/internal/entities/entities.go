@@ -1,8 +1,10 @@
 package entities
 
+import "github.com/cp-rektmart/aconcert-microservice/pkg/money"
+
 type Seat struct {
 	ZoneNumber int
-	Price      float64
+	Price      money.Money
 	Row        int
 	Column     int
 }
@@ -11,6 +13,6 @@ type Reservation struct {
 	ID         string
 	UserID     string
 	EventID    string
-	TotalPrice float64
+	TotalPrice money.Money
 	Seats      []Seat
 }
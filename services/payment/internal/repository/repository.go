@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/cp-rektmart/aconcert-microservice/payment/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
 )
 
@@ -30,7 +31,7 @@ func (r *Repository) GetReservationByStripeSessionID(ctx context.Context, sessio
 	for i, seat := range response.Seats {
 		seats[i] = entities.Seat{
 			ZoneNumber: int(seat.ZoneNumber),
-			Price:      seat.Price,
+			Price:      money.FromProto(seat.GetPrice()),
 			Row:        int(seat.Row),
 			Column:     int(seat.Column),
 		}
@@ -40,7 +41,7 @@ func (r *Repository) GetReservationByStripeSessionID(ctx context.Context, sessio
 		ID:         response.Id,
 		UserID:     response.UserId,
 		EventID:    response.EventId,
-		TotalPrice: response.TotalPrice,
+		TotalPrice: money.FromProto(response.GetTotalPrice()),
 		Seats:      seats,
 	}, nil
 }
@@ -55,6 +56,10 @@ func (r *Repository) ConfirmPayment(ctx context.Context, reservationID string) e
 	}
 
 	if !response.Success {
+		// the reservation was cancelled and the payment refunded, there is nothing left to retry
+		if response.Refunded {
+			return nil
+		}
 		return errors.New("NOT_SUCCESSFUL")
 	}
 
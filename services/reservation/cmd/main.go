@@ -11,14 +11,21 @@ import (
 
 	"github.com/cp-rektmart/aconcert-microservice/pkg/grpclogger"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/postgres"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	locationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/location"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/redis"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/config"
 	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/ballot"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/credential"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/domains"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/expiry"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/reconciliation"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -70,15 +77,36 @@ func main() {
 	}
 	eventClient := eventpb.NewEventServiceClient(eventConn)
 
+	locationConn, err := grpc.NewClient(conf.LocationClientBaseURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.PanicContext(ctx, "failed to connect to location service", slog.Any("error", err))
+	}
+	locationClient := locationpb.NewLocationServiceClient(locationConn)
+
 	reservationRepo := repositories.NewReservationRepository(queries, pgConn, redisConn)
 
-	reservationServer := domains.New(reservationRepo, conf.Stripe, eventClient)
+	signer, err := credential.NewSigner(conf.CredentialSigningKey)
+	if err != nil {
+		logger.PanicContext(ctx, "failed to load credential signing key", slog.Any("error", err))
+	}
+
+	reservationServer := domains.New(reservationRepo, conf.Stripe, eventClient, locationClient, signer, fees.NewCalculator(conf.Fees))
 	reservationpb.RegisterReservationServiceServer(grpcServer, reservationServer)
 
-	// Start Redis expiration listener in background
-	go func() {
-		reservationRepo.StartExpirationListener(ctx)
-	}()
+	// Rebuild the Redis seat state before serving, then keep it in line on a schedule
+	reconciler := reconciliation.New(reservationRepo, conf.ReconcileInterval, domains.ReservationTTL)
+	reconciler.Run(ctx)
+	go reconciler.Start(ctx)
+
+	// Relay notifications committed to the outbox
+	go outbox.NewRelay(reservationRepo, rabbitmq.RabbitMQClient.PublishMessageToQueue).Start(ctx)
+
+	// Sweep expired holds in background, only the elected replica does the work
+	sweeper := expiry.NewSweeper(reservationRepo, reservationServer)
+	go sweeper.Start(ctx)
+
+	// Draw ballots whose application window closed, only the elected replica does the work
+	go ballot.NewDrawer(reservationRepo, reservationServer).Start(ctx)
 
 	go func() {
 		logger.InfoContext(ctx, "starting gRPC server", slog.String("port", strconv.Itoa(conf.Port)))
@@ -1,11 +1,14 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v10"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/postgres"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/redis"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
 	"github.com/joho/godotenv"
 )
 
@@ -19,15 +22,19 @@ type StripeConfig struct {
 }
 
 type AppConfig struct {
-	Name               string          `env:"NAME"`
-	Port               int             `env:"PORT"`
-	Environment        string          `env:"ENVIRONMENT"`
-	Logger             logger.Config   `envPrefix:"LOGGER_"`
-	Postgres           postgres.Config `envPrefix:"POSTGRES_"`
-	Redis              redis.Config    `envPrefix:"REDIS_"`
-	Stripe             StripeConfig    `envPrefix:"STRIPE_"`
-	RabbitMQ           rabbitmq.Config `envPrefix:"RABBITMQ_"`
-	EventClientBaseURL string          `env:"EVENT_CLIENT_BASE_URL"`
+	Name                  string          `env:"NAME"`
+	Port                  int             `env:"PORT"`
+	Environment           string          `env:"ENVIRONMENT"`
+	Logger                logger.Config   `envPrefix:"LOGGER_"`
+	Postgres              postgres.Config `envPrefix:"POSTGRES_"`
+	Redis                 redis.Config    `envPrefix:"REDIS_"`
+	Stripe                StripeConfig    `envPrefix:"STRIPE_"`
+	RabbitMQ              rabbitmq.Config `envPrefix:"RABBITMQ_"`
+	EventClientBaseURL    string          `env:"EVENT_CLIENT_BASE_URL"`
+	LocationClientBaseURL string          `env:"LOCATION_CLIENT_BASE_URL"`
+	ReconcileInterval     time.Duration   `env:"RECONCILE_INTERVAL" envDefault:"5m"`
+	CredentialSigningKey  string          `env:"CREDENTIAL_SIGNING_KEY"`
+	Fees                  fees.Config     `envPrefix:"FEE_"`
 }
 
 func Load() *AppConfig {
@@ -9,6 +9,46 @@ import (
 	"context"
 )
 
+// iteratorForBulkCreateReservationLineItems implements pgx.CopyFromSource.
+type iteratorForBulkCreateReservationLineItems struct {
+	rows                 []BulkCreateReservationLineItemsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkCreateReservationLineItems) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkCreateReservationLineItems) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ReservationID,
+		r.rows[0].Position,
+		r.rows[0].Kind,
+		r.rows[0].Name,
+		r.rows[0].Description,
+		r.rows[0].ZoneNumber,
+		r.rows[0].Quantity,
+		r.rows[0].UnitAmount,
+		r.rows[0].Amount,
+	}, nil
+}
+
+func (r iteratorForBulkCreateReservationLineItems) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkCreateReservationLineItems(ctx context.Context, arg []BulkCreateReservationLineItemsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"reservationlineitem"}, []string{"reservation_id", "position", "kind", "name", "description", "zone_number", "quantity", "unit_amount", "amount"}, &iteratorForBulkCreateReservationLineItems{rows: arg})
+}
+
 // iteratorForBulkCreateReservationTickets implements pgx.CopyFromSource.
 type iteratorForBulkCreateReservationTickets struct {
 	rows                 []BulkCreateReservationTicketsParams
@@ -8,16 +8,111 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Ballotapplication struct {
+	ID            pgtype.UUID        `json:"id"`
+	EventID       pgtype.UUID        `json:"event_id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	ZoneNumber    int32              `json:"zone_number"`
+	Quantity      int32              `json:"quantity"`
+	Status        string             `json:"status"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
+	DrawPosition  *int32             `json:"draw_position"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Ballotdraw struct {
+	EventID        pgtype.UUID        `json:"event_id"`
+	Seed           int64              `json:"seed"`
+	ApplicationIds []pgtype.UUID      `json:"application_ids"`
+	DrawnAt        pgtype.Timestamptz `json:"drawn_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+}
+
+type Idempotencykey struct {
+	UserID         pgtype.UUID        `json:"user_id"`
+	IdempotencyKey string             `json:"idempotency_key"`
+	RequestHash    []byte             `json:"request_hash"`
+	Response       []byte             `json:"response"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type Outbox struct {
+	ID            pgtype.UUID        `json:"id"`
+	Queue         string             `json:"queue"`
+	Type          string             `json:"type"`
+	Payload       []byte             `json:"payload"`
+	Attempts      int32              `json:"attempts"`
+	LastError     *string            `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+}
+
+type Promotion struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Code                  string             `json:"code"`
+	DiscountType          string             `json:"discount_type"`
+	PercentOff            float64            `json:"percent_off"`
+	EventID               pgtype.UUID        `json:"event_id"`
+	ZoneNumber            *int32             `json:"zone_number"`
+	MaxRedemptions        int32              `json:"max_redemptions"`
+	MaxRedemptionsPerUser int32              `json:"max_redemptions_per_user"`
+	RedemptionCount       int32              `json:"redemption_count"`
+	Stackable             bool               `json:"stackable"`
+	StartsAt              pgtype.Timestamptz `json:"starts_at"`
+	EndsAt                pgtype.Timestamptz `json:"ends_at"`
+	Active                bool               `json:"active"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	AmountOff             int64              `json:"amount_off"`
+	Currency              string             `json:"currency"`
+}
+
+type Promotionredemption struct {
+	ID            pgtype.UUID        `json:"id"`
+	PromotionID   pgtype.UUID        `json:"promotion_id"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	Amount        int64              `json:"amount"`
+	Status        string             `json:"status"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Reservation struct {
-	ID              pgtype.UUID        `json:"id"`
-	CreatedAt       pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
-	UserID          pgtype.UUID        `json:"user_id"`
-	EventID         pgtype.UUID        `json:"event_id"`
-	Status          string             `json:"status"`
-	StripeSessionID string             `json:"stripe_session_id"`
-	TotalPrice      float64            `json:"total_price"`
+	ID                  pgtype.UUID        `json:"id"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt           pgtype.Timestamptz `json:"deleted_at"`
+	UserID              pgtype.UUID        `json:"user_id"`
+	EventID             pgtype.UUID        `json:"event_id"`
+	Status              string             `json:"status"`
+	StripeSessionID     string             `json:"stripe_session_id"`
+	TotalPrice          int64              `json:"total_price"`
+	Currency            string             `json:"currency"`
+	Subtotal            int64              `json:"subtotal"`
+	Discount            int64              `json:"discount"`
+	BookingFee          int64              `json:"booking_fee"`
+	ProcessingFee       int64              `json:"processing_fee"`
+	Tax                 int64              `json:"tax"`
+	VatRate             float64            `json:"vat_rate"`
+	VatInclusive        bool               `json:"vat_inclusive"`
+	BookingFeePerTicket int64              `json:"booking_fee_per_ticket"`
+}
+
+type Reservationlineitem struct {
+	ID            pgtype.UUID        `json:"id"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
+	Position      int32              `json:"position"`
+	Kind          string             `json:"kind"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	ZoneNumber    *int32             `json:"zone_number"`
+	Quantity      int32              `json:"quantity"`
+	UnitAmount    int64              `json:"unit_amount"`
+	Amount        int64              `json:"amount"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type Reservationticket struct {
@@ -26,13 +121,69 @@ type Reservationticket struct {
 }
 
 type Ticket struct {
-	ID            pgtype.UUID        `json:"id"`
-	ReservationID pgtype.UUID        `json:"reservation_id"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
-	ZoneNumber    int32              `json:"zone_number"`
-	RowNumber     int32              `json:"row_number"`
-	ColNumber     int32              `json:"col_number"`
-	EventID       pgtype.UUID        `json:"event_id"`
+	ID                   pgtype.UUID        `json:"id"`
+	ReservationID        pgtype.UUID        `json:"reservation_id"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt            pgtype.Timestamptz `json:"deleted_at"`
+	ZoneNumber           int32              `json:"zone_number"`
+	RowNumber            int32              `json:"row_number"`
+	ColNumber            int32              `json:"col_number"`
+	EventID              pgtype.UUID        `json:"event_id"`
+	OwnerID              pgtype.UUID        `json:"owner_id"`
+	CredentialVersion    int32              `json:"credential_version"`
+	CheckedInAt          pgtype.Timestamptz `json:"checked_in_at"`
+	CheckedInGate        *string            `json:"checked_in_gate"`
+	TicketTypeID         pgtype.UUID        `json:"ticket_type_id"`
+	RequiresVerification bool               `json:"requires_verification"`
+	Price                *int64             `json:"price"`
+}
+
+type Tickethistory struct {
+	ID             pgtype.UUID        `json:"id"`
+	TicketID       pgtype.UUID        `json:"ticket_id"`
+	ReservationID  pgtype.UUID        `json:"reservation_id"`
+	Action         string             `json:"action"`
+	RefundedAmount int64              `json:"refunded_amount"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type Tickettransfer struct {
+	ID         pgtype.UUID        `json:"id"`
+	TicketID   pgtype.UUID        `json:"ticket_id"`
+	FromUserID pgtype.UUID        `json:"from_user_id"`
+	ToUserID   pgtype.UUID        `json:"to_user_id"`
+	Status     string             `json:"status"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Tickettypeclaim struct {
+	ID                   pgtype.UUID        `json:"id"`
+	TicketTypeID         pgtype.UUID        `json:"ticket_type_id"`
+	ReservationID        pgtype.UUID        `json:"reservation_id"`
+	Quantity             int32              `json:"quantity"`
+	RequiresVerification bool               `json:"requires_verification"`
+	Status               string             `json:"status"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Tickettypeinventory struct {
+	TicketTypeID pgtype.UUID        `json:"ticket_type_id"`
+	Sold         int32              `json:"sold"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Waitlistentry struct {
+	ID             pgtype.UUID        `json:"id"`
+	EventID        pgtype.UUID        `json:"event_id"`
+	ZoneNumber     int32              `json:"zone_number"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	Quantity       int32              `json:"quantity"`
+	Status         string             `json:"status"`
+	ReservationID  pgtype.UUID        `json:"reservation_id"`
+	OfferExpiresAt pgtype.Timestamptz `json:"offer_expires_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
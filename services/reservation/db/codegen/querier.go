@@ -11,25 +11,53 @@ import (
 )
 
 type Querier interface {
+	BulkCreateReservationLineItems(ctx context.Context, arg []BulkCreateReservationLineItemsParams) (int64, error)
 	BulkCreateReservationTickets(ctx context.Context, arg []BulkCreateReservationTicketsParams) (int64, error)
+	CancelReservationTickets(ctx context.Context, arg CancelReservationTicketsParams) ([]Ticket, error)
+	CheckInTicket(ctx context.Context, arg CheckInTicketParams) (Ticket, error)
 	CheckReservationTicketExists(ctx context.Context, arg CheckReservationTicketExistsParams) (bool, error)
 	CheckSeatAvailability(ctx context.Context, arg CheckSeatAvailabilityParams) (bool, error)
 	CheckSeatAvailabilityForEvent(ctx context.Context, arg CheckSeatAvailabilityForEventParams) (bool, error)
+	ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (int64, error)
+	ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]Outbox, error)
+	ClaimPromotionRedemption(ctx context.Context, id pgtype.UUID) (int64, error)
+	ClaimTicketType(ctx context.Context, arg ClaimTicketTypeParams) (int64, error)
+	CloseWaitlistOffer(ctx context.Context, arg CloseWaitlistOfferParams) error
+	CompleteBallotDraw(ctx context.Context, eventID pgtype.UUID) error
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CountAppliedBallotApplications(ctx context.Context, eventID pgtype.UUID) (int64, error)
 	CountReservationsByEventID(ctx context.Context, eventID pgtype.UUID) (int64, error)
 	CountReservationsByUserID(ctx context.Context, userID pgtype.UUID) (int64, error)
 	CountReservationsForTicket(ctx context.Context, ticketID pgtype.UUID) (int64, error)
 	CountTicketsByReservationID(ctx context.Context, reservationID pgtype.UUID) (int64, error)
+	CountTicketsByUserAndEvent(ctx context.Context, arg CountTicketsByUserAndEventParams) (int64, error)
+	CountTicketsByZone(ctx context.Context, eventID pgtype.UUID) ([]CountTicketsByZoneRow, error)
 	CountTicketsInReservation(ctx context.Context, reservationID pgtype.UUID) (int64, error)
+	CountUserPromotionRedemptions(ctx context.Context, arg CountUserPromotionRedemptionsParams) (int64, error)
+	CreateBallotApplication(ctx context.Context, arg CreateBallotApplicationParams) (Ballotapplication, error)
+	CreateBallotDraw(ctx context.Context, arg CreateBallotDrawParams) error
+	CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error
+	CreatePromotion(ctx context.Context, arg CreatePromotionParams) (Promotion, error)
+	CreatePromotionRedemption(ctx context.Context, arg CreatePromotionRedemptionParams) error
 	CreateReservation(ctx context.Context, arg CreateReservationParams) (Reservation, error)
 	CreateReservationTicket(ctx context.Context, arg CreateReservationTicketParams) error
 	CreateTicket(ctx context.Context, arg CreateTicketParams) (Ticket, error)
+	CreateTicketHistory(ctx context.Context, arg CreateTicketHistoryParams) error
+	CreateTicketTransfer(ctx context.Context, arg CreateTicketTransferParams) (Tickettransfer, error)
+	CreateTicketTypeClaim(ctx context.Context, arg CreateTicketTypeClaimParams) error
 	CreateTicketWithAvailabilityCheck(ctx context.Context, arg CreateTicketWithAvailabilityCheckParams) (Ticket, error)
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (Waitlistentry, error)
+	DeactivatePromotion(ctx context.Context, id pgtype.UUID) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
 	DeleteReservation(ctx context.Context, id pgtype.UUID) error
 	DeleteReservationTicket(ctx context.Context, arg DeleteReservationTicketParams) error
 	DeleteReservationTicketsByReservationID(ctx context.Context, reservationID pgtype.UUID) error
 	DeleteReservationTicketsByTicketID(ctx context.Context, ticketID pgtype.UUID) error
 	DeleteTicket(ctx context.Context, id pgtype.UUID) error
 	DeleteTicketsByReservationID(ctx context.Context, reservationID pgtype.UUID) error
+	GetBallotApplication(ctx context.Context, id pgtype.UUID) (Ballotapplication, error)
+	GetBallotDraw(ctx context.Context, eventID pgtype.UUID) (Ballotdraw, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (Idempotencykey, error)
 	GetReservation(ctx context.Context, id pgtype.UUID) (Reservation, error)
 	GetReservationByID(ctx context.Context, id pgtype.UUID) (Reservation, error)
 	GetReservationByStripeSessionID(ctx context.Context, stripeSessionID string) (Reservation, error)
@@ -37,22 +65,57 @@ type Querier interface {
 	GetTicket(ctx context.Context, id pgtype.UUID) (Ticket, error)
 	GetTicketByID(ctx context.Context, id pgtype.UUID) (Ticket, error)
 	GetTicketBySeat(ctx context.Context, arg GetTicketBySeatParams) (Ticket, error)
+	GetTicketForUpdate(ctx context.Context, id pgtype.UUID) (Ticket, error)
 	GetTicketReservations(ctx context.Context, ticketID pgtype.UUID) ([]GetTicketReservationsRow, error)
+	GetTicketTransfer(ctx context.Context, id pgtype.UUID) (Tickettransfer, error)
+	GetWaitlistEntry(ctx context.Context, id pgtype.UUID) (Waitlistentry, error)
 	HardDeleteReservation(ctx context.Context, id pgtype.UUID) error
 	HardDeleteTicket(ctx context.Context, id pgtype.UUID) error
+	LeaveWaitlist(ctx context.Context, arg LeaveWaitlistParams) (int64, error)
+	ListActiveWaitlistEntriesByUserID(ctx context.Context, userID pgtype.UUID) ([]Waitlistentry, error)
 	ListAllReservationTickets(ctx context.Context) ([]Reservationticket, error)
+	ListAppliedBallotApplicationIDs(ctx context.Context, eventID pgtype.UUID) ([]pgtype.UUID, error)
+	ListBallotApplicationsByUserID(ctx context.Context, userID pgtype.UUID) ([]Ballotapplication, error)
+	ListBallotEventIDs(ctx context.Context) ([]pgtype.UUID, error)
+	ListEventScanTickets(ctx context.Context, eventID pgtype.UUID) ([]ListEventScanTicketsRow, error)
+	ListPromotions(ctx context.Context) ([]Promotion, error)
+	ListPromotionsByCodes(ctx context.Context, codes []string) ([]Promotion, error)
+	ListReservationLineItems(ctx context.Context, reservationID pgtype.UUID) ([]Reservationlineitem, error)
+	ListReservationPromotions(ctx context.Context, reservationID pgtype.UUID) ([]ListReservationPromotionsRow, error)
 	ListReservations(ctx context.Context) ([]Reservation, error)
 	ListReservationsByEventID(ctx context.Context, eventID pgtype.UUID) ([]Reservation, error)
 	ListReservationsByStatus(ctx context.Context, status string) ([]Reservation, error)
+	ListReservationsByStatusCreatedBefore(ctx context.Context, arg ListReservationsByStatusCreatedBeforeParams) ([]Reservation, error)
+	ListReservationsByUserEventStatus(ctx context.Context, arg ListReservationsByUserEventStatusParams) ([]Reservation, error)
 	ListReservationsByUserID(ctx context.Context, userID pgtype.UUID) ([]Reservation, error)
+	ListTicketEventIDs(ctx context.Context) ([]pgtype.UUID, error)
+	ListTicketHistoryByReservationID(ctx context.Context, reservationID pgtype.UUID) ([]ListTicketHistoryByReservationIDRow, error)
+	ListTicketTransfersByUserID(ctx context.Context, arg ListTicketTransfersByUserIDParams) ([]ListTicketTransfersByUserIDRow, error)
 	ListTickets(ctx context.Context) ([]Ticket, error)
 	ListTicketsByEventID(ctx context.Context, eventID pgtype.UUID) ([]Ticket, error)
+	ListTicketsByOwnerID(ctx context.Context, ownerID pgtype.UUID) ([]Ticket, error)
 	ListTicketsByReservationID(ctx context.Context, reservationID pgtype.UUID) ([]Ticket, error)
 	ListTicketsBySeat(ctx context.Context, arg ListTicketsBySeatParams) ([]Ticket, error)
+	ListWaitingEntries(ctx context.Context, arg ListWaitingEntriesParams) ([]Waitlistentry, error)
+	MarkOutboxMessageFailed(ctx context.Context, arg MarkOutboxMessageFailedParams) error
+	MarkOutboxMessagePublished(ctx context.Context, id pgtype.UUID) error
+	OfferWaitlistEntry(ctx context.Context, arg OfferWaitlistEntryParams) (int64, error)
+	RecordOfflineCheckIn(ctx context.Context, arg RecordOfflineCheckInParams) (Ticket, error)
+	ReleasePromotionRedemptions(ctx context.Context, reservationID pgtype.UUID) error
+	ReleaseTicketTypeClaims(ctx context.Context, reservationID pgtype.UUID) error
+	ReturnTicketTypeClaim(ctx context.Context, arg ReturnTicketTypeClaimParams) error
+	SettleBallotApplication(ctx context.Context, arg SettleBallotApplicationParams) (int64, error)
+	TransferTicketOwner(ctx context.Context, arg TransferTicketOwnerParams) (Ticket, error)
+	TransitionReservationStatus(ctx context.Context, arg TransitionReservationStatusParams) (int64, error)
+	TransitionTicketTransferStatus(ctx context.Context, arg TransitionTicketTransferStatusParams) (int64, error)
+	TransitionTicketTransfersByTicketID(ctx context.Context, arg TransitionTicketTransfersByTicketIDParams) error
 	UpdateReservation(ctx context.Context, arg UpdateReservationParams) (Reservation, error)
+	UpdateReservationBreakdown(ctx context.Context, arg UpdateReservationBreakdownParams) (int64, error)
 	UpdateReservationStatus(ctx context.Context, arg UpdateReservationStatusParams) (Reservation, error)
+	UpdateReservationStripeSession(ctx context.Context, arg UpdateReservationStripeSessionParams) (int64, error)
 	UpdateTicket(ctx context.Context, arg UpdateTicketParams) (Ticket, error)
 	UpdateTicketReservation(ctx context.Context, arg UpdateTicketReservationParams) (Ticket, error)
+	WithdrawBallotApplication(ctx context.Context, arg WithdrawBallotApplicationParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -42,19 +42,37 @@ INSERT INTO Reservation (
     event_id,
     status,
     total_price,
-    stripe_session_id
+    stripe_session_id,
+    currency,
+    subtotal,
+    discount,
+    booking_fee,
+    processing_fee,
+    tax,
+    vat_rate,
+    vat_inclusive,
+    booking_fee_per_ticket
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+) RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket
 `
 
 type CreateReservationParams struct {
-	ID              pgtype.UUID `json:"id"`
-	UserID          pgtype.UUID `json:"user_id"`
-	EventID         pgtype.UUID `json:"event_id"`
-	Status          string      `json:"status"`
-	TotalPrice      float64     `json:"total_price"`
-	StripeSessionID string      `json:"stripe_session_id"`
+	ID                  pgtype.UUID `json:"id"`
+	UserID              pgtype.UUID `json:"user_id"`
+	EventID             pgtype.UUID `json:"event_id"`
+	Status              string      `json:"status"`
+	TotalPrice          int64       `json:"total_price"`
+	StripeSessionID     string      `json:"stripe_session_id"`
+	Currency            string      `json:"currency"`
+	Subtotal            int64       `json:"subtotal"`
+	Discount            int64       `json:"discount"`
+	BookingFee          int64       `json:"booking_fee"`
+	ProcessingFee       int64       `json:"processing_fee"`
+	Tax                 int64       `json:"tax"`
+	VatRate             float64     `json:"vat_rate"`
+	VatInclusive        bool        `json:"vat_inclusive"`
+	BookingFeePerTicket int64       `json:"booking_fee_per_ticket"`
 }
 
 func (q *Queries) CreateReservation(ctx context.Context, arg CreateReservationParams) (Reservation, error) {
@@ -65,6 +83,15 @@ func (q *Queries) CreateReservation(ctx context.Context, arg CreateReservationPa
 		arg.Status,
 		arg.TotalPrice,
 		arg.StripeSessionID,
+		arg.Currency,
+		arg.Subtotal,
+		arg.Discount,
+		arg.BookingFee,
+		arg.ProcessingFee,
+		arg.Tax,
+		arg.VatRate,
+		arg.VatInclusive,
+		arg.BookingFeePerTicket,
 	)
 	var i Reservation
 	err := row.Scan(
@@ -77,6 +104,15 @@ func (q *Queries) CreateReservation(ctx context.Context, arg CreateReservationPa
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
@@ -93,7 +129,7 @@ func (q *Queries) DeleteReservation(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getReservation = `-- name: GetReservation :one
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1
 `
@@ -111,12 +147,21 @@ func (q *Queries) GetReservation(ctx context.Context, id pgtype.UUID) (Reservati
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
 
 const getReservationByID = `-- name: GetReservationByID :one
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE id = $1
 LIMIT 1
 `
@@ -134,12 +179,21 @@ func (q *Queries) GetReservationByID(ctx context.Context, id pgtype.UUID) (Reser
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
 
 const getReservationByStripeSessionID = `-- name: GetReservationByStripeSessionID :one
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE stripe_session_id = $1 AND deleted_at IS NULL
 LIMIT 1
 `
@@ -157,6 +211,15 @@ func (q *Queries) GetReservationByStripeSessionID(ctx context.Context, stripeSes
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
@@ -172,7 +235,7 @@ func (q *Queries) HardDeleteReservation(ctx context.Context, id pgtype.UUID) err
 }
 
 const listReservations = `-- name: ListReservations :many
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -196,6 +259,15 @@ func (q *Queries) ListReservations(ctx context.Context) ([]Reservation, error) {
 			&i.Status,
 			&i.StripeSessionID,
 			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
 		); err != nil {
 			return nil, err
 		}
@@ -208,7 +280,7 @@ func (q *Queries) ListReservations(ctx context.Context) ([]Reservation, error) {
 }
 
 const listReservationsByEventID = `-- name: ListReservationsByEventID :many
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE event_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -232,6 +304,15 @@ func (q *Queries) ListReservationsByEventID(ctx context.Context, eventID pgtype.
 			&i.Status,
 			&i.StripeSessionID,
 			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
 		); err != nil {
 			return nil, err
 		}
@@ -244,7 +325,7 @@ func (q *Queries) ListReservationsByEventID(ctx context.Context, eventID pgtype.
 }
 
 const listReservationsByStatus = `-- name: ListReservationsByStatus :many
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE status = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -268,6 +349,116 @@ func (q *Queries) ListReservationsByStatus(ctx context.Context, status string) (
 			&i.Status,
 			&i.StripeSessionID,
 			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReservationsByStatusCreatedBefore = `-- name: ListReservationsByStatusCreatedBefore :many
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
+WHERE status = $1 AND created_at < $2 AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+type ListReservationsByStatusCreatedBeforeParams struct {
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListReservationsByStatusCreatedBefore(ctx context.Context, arg ListReservationsByStatusCreatedBeforeParams) ([]Reservation, error) {
+	rows, err := q.db.Query(ctx, listReservationsByStatusCreatedBefore, arg.Status, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reservation{}
+	for rows.Next() {
+		var i Reservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.EventID,
+			&i.Status,
+			&i.StripeSessionID,
+			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReservationsByUserEventStatus = `-- name: ListReservationsByUserEventStatus :many
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
+WHERE user_id = $1 AND event_id = $2 AND status = $3 AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+type ListReservationsByUserEventStatusParams struct {
+	UserID  pgtype.UUID `json:"user_id"`
+	EventID pgtype.UUID `json:"event_id"`
+	Status  string      `json:"status"`
+}
+
+func (q *Queries) ListReservationsByUserEventStatus(ctx context.Context, arg ListReservationsByUserEventStatusParams) ([]Reservation, error) {
+	rows, err := q.db.Query(ctx, listReservationsByUserEventStatus, arg.UserID, arg.EventID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reservation{}
+	for rows.Next() {
+		var i Reservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserID,
+			&i.EventID,
+			&i.Status,
+			&i.StripeSessionID,
+			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
 		); err != nil {
 			return nil, err
 		}
@@ -280,7 +471,7 @@ func (q *Queries) ListReservationsByStatus(ctx context.Context, status string) (
 }
 
 const listReservationsByUserID = `-- name: ListReservationsByUserID :many
-SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price FROM Reservation
+SELECT id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket FROM Reservation
 WHERE user_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -304,6 +495,15 @@ func (q *Queries) ListReservationsByUserID(ctx context.Context, userID pgtype.UU
 			&i.Status,
 			&i.StripeSessionID,
 			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
 		); err != nil {
 			return nil, err
 		}
@@ -315,6 +515,26 @@ func (q *Queries) ListReservationsByUserID(ctx context.Context, userID pgtype.UU
 	return items, nil
 }
 
+const transitionReservationStatus = `-- name: TransitionReservationStatus :execrows
+UPDATE Reservation
+SET status = $1, updated_at = NOW()
+WHERE id = $2 AND status = $3 AND deleted_at IS NULL
+`
+
+type TransitionReservationStatusParams struct {
+	NewStatus     string      `json:"new_status"`
+	ID            pgtype.UUID `json:"id"`
+	CurrentStatus string      `json:"current_status"`
+}
+
+func (q *Queries) TransitionReservationStatus(ctx context.Context, arg TransitionReservationStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, transitionReservationStatus, arg.NewStatus, arg.ID, arg.CurrentStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateReservation = `-- name: UpdateReservation :one
 UPDATE Reservation
 SET
@@ -323,7 +543,7 @@ SET
     status = COALESCE($3, status),
     updated_at = NOW()
 WHERE id = $4 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price
+RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket
 `
 
 type UpdateReservationParams struct {
@@ -351,15 +571,65 @@ func (q *Queries) UpdateReservation(ctx context.Context, arg UpdateReservationPa
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
 
+const updateReservationBreakdown = `-- name: UpdateReservationBreakdown :execrows
+UPDATE Reservation
+SET
+    subtotal = $1,
+    discount = $2,
+    booking_fee = $3,
+    processing_fee = $4,
+    tax = $5,
+    total_price = $6,
+    updated_at = NOW()
+WHERE id = $7 AND status = $8 AND deleted_at IS NULL
+`
+
+type UpdateReservationBreakdownParams struct {
+	Subtotal      int64       `json:"subtotal"`
+	Discount      int64       `json:"discount"`
+	BookingFee    int64       `json:"booking_fee"`
+	ProcessingFee int64       `json:"processing_fee"`
+	Tax           int64       `json:"tax"`
+	TotalPrice    int64       `json:"total_price"`
+	ID            pgtype.UUID `json:"id"`
+	Status        string      `json:"status"`
+}
+
+func (q *Queries) UpdateReservationBreakdown(ctx context.Context, arg UpdateReservationBreakdownParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateReservationBreakdown,
+		arg.Subtotal,
+		arg.Discount,
+		arg.BookingFee,
+		arg.ProcessingFee,
+		arg.Tax,
+		arg.TotalPrice,
+		arg.ID,
+		arg.Status,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateReservationStatus = `-- name: UpdateReservationStatus :one
 UPDATE Reservation
 SET status = $2, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price
+RETURNING id, created_at, updated_at, deleted_at, user_id, event_id, status, stripe_session_id, total_price, currency, subtotal, discount, booking_fee, processing_fee, tax, vat_rate, vat_inclusive, booking_fee_per_ticket
 `
 
 type UpdateReservationStatusParams struct {
@@ -380,6 +650,35 @@ func (q *Queries) UpdateReservationStatus(ctx context.Context, arg UpdateReserva
 		&i.Status,
 		&i.StripeSessionID,
 		&i.TotalPrice,
+		&i.Currency,
+		&i.Subtotal,
+		&i.Discount,
+		&i.BookingFee,
+		&i.ProcessingFee,
+		&i.Tax,
+		&i.VatRate,
+		&i.VatInclusive,
+		&i.BookingFeePerTicket,
 	)
 	return i, err
 }
+
+const updateReservationStripeSession = `-- name: UpdateReservationStripeSession :execrows
+UPDATE Reservation
+SET stripe_session_id = $1, updated_at = NOW()
+WHERE id = $2 AND status = $3 AND deleted_at IS NULL
+`
+
+type UpdateReservationStripeSessionParams struct {
+	StripeSessionID string      `json:"stripe_session_id"`
+	ID              pgtype.UUID `json:"id"`
+	Status          string      `json:"status"`
+}
+
+func (q *Queries) UpdateReservationStripeSession(ctx context.Context, arg UpdateReservationStripeSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateReservationStripeSession, arg.StripeSessionID, arg.ID, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -117,24 +117,31 @@ const getReservationTickets = `-- name: GetReservationTickets :many
 SELECT
     rt.reservation_id,
     rt.ticket_id,
-    t.id, t.reservation_id, t.created_at, t.updated_at, t.deleted_at, t.zone_number, t.row_number, t.col_number, t.event_id
+    t.id, t.reservation_id, t.created_at, t.updated_at, t.deleted_at, t.zone_number, t.row_number, t.col_number, t.event_id, t.owner_id, t.credential_version, t.checked_in_at, t.checked_in_gate, t.ticket_type_id, t.requires_verification, t.price
 FROM ReservationTicket rt
 JOIN Ticket t ON rt.ticket_id = t.id
 WHERE rt.reservation_id = $1 AND t.deleted_at IS NULL
 `
 
 type GetReservationTicketsRow struct {
-	ReservationID   pgtype.UUID        `json:"reservation_id"`
-	TicketID        pgtype.UUID        `json:"ticket_id"`
-	ID              pgtype.UUID        `json:"id"`
-	ReservationID_2 pgtype.UUID        `json:"reservation_id_2"`
-	CreatedAt       pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
-	ZoneNumber      int32              `json:"zone_number"`
-	RowNumber       int32              `json:"row_number"`
-	ColNumber       int32              `json:"col_number"`
-	EventID         pgtype.UUID        `json:"event_id"`
+	ReservationID        pgtype.UUID        `json:"reservation_id"`
+	TicketID             pgtype.UUID        `json:"ticket_id"`
+	ID                   pgtype.UUID        `json:"id"`
+	ReservationID_2      pgtype.UUID        `json:"reservation_id_2"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt            pgtype.Timestamptz `json:"deleted_at"`
+	ZoneNumber           int32              `json:"zone_number"`
+	RowNumber            int32              `json:"row_number"`
+	ColNumber            int32              `json:"col_number"`
+	EventID              pgtype.UUID        `json:"event_id"`
+	OwnerID              pgtype.UUID        `json:"owner_id"`
+	CredentialVersion    int32              `json:"credential_version"`
+	CheckedInAt          pgtype.Timestamptz `json:"checked_in_at"`
+	CheckedInGate        *string            `json:"checked_in_gate"`
+	TicketTypeID         pgtype.UUID        `json:"ticket_type_id"`
+	RequiresVerification bool               `json:"requires_verification"`
+	Price                *int64             `json:"price"`
 }
 
 func (q *Queries) GetReservationTickets(ctx context.Context, reservationID pgtype.UUID) ([]GetReservationTicketsRow, error) {
@@ -158,6 +165,13 @@ func (q *Queries) GetReservationTickets(ctx context.Context, reservationID pgtyp
 			&i.RowNumber,
 			&i.ColNumber,
 			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
 		); err != nil {
 			return nil, err
 		}
@@ -173,24 +187,33 @@ const getTicketReservations = `-- name: GetTicketReservations :many
 SELECT
     rt.reservation_id,
     rt.ticket_id,
-    r.id, r.created_at, r.updated_at, r.deleted_at, r.user_id, r.event_id, r.status, r.stripe_session_id, r.total_price
+    r.id, r.created_at, r.updated_at, r.deleted_at, r.user_id, r.event_id, r.status, r.stripe_session_id, r.total_price, r.currency, r.subtotal, r.discount, r.booking_fee, r.processing_fee, r.tax, r.vat_rate, r.vat_inclusive, r.booking_fee_per_ticket
 FROM ReservationTicket rt
 JOIN Reservation r ON rt.reservation_id = r.id
 WHERE rt.ticket_id = $1 AND r.deleted_at IS NULL
 `
 
 type GetTicketReservationsRow struct {
-	ReservationID   pgtype.UUID        `json:"reservation_id"`
-	TicketID        pgtype.UUID        `json:"ticket_id"`
-	ID              pgtype.UUID        `json:"id"`
-	CreatedAt       pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
-	UserID          pgtype.UUID        `json:"user_id"`
-	EventID         pgtype.UUID        `json:"event_id"`
-	Status          string             `json:"status"`
-	StripeSessionID string             `json:"stripe_session_id"`
-	TotalPrice      float64            `json:"total_price"`
+	ReservationID       pgtype.UUID        `json:"reservation_id"`
+	TicketID            pgtype.UUID        `json:"ticket_id"`
+	ID                  pgtype.UUID        `json:"id"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt           pgtype.Timestamptz `json:"deleted_at"`
+	UserID              pgtype.UUID        `json:"user_id"`
+	EventID             pgtype.UUID        `json:"event_id"`
+	Status              string             `json:"status"`
+	StripeSessionID     string             `json:"stripe_session_id"`
+	TotalPrice          int64              `json:"total_price"`
+	Currency            string             `json:"currency"`
+	Subtotal            int64              `json:"subtotal"`
+	Discount            int64              `json:"discount"`
+	BookingFee          int64              `json:"booking_fee"`
+	ProcessingFee       int64              `json:"processing_fee"`
+	Tax                 int64              `json:"tax"`
+	VatRate             float64            `json:"vat_rate"`
+	VatInclusive        bool               `json:"vat_inclusive"`
+	BookingFeePerTicket int64              `json:"booking_fee_per_ticket"`
 }
 
 func (q *Queries) GetTicketReservations(ctx context.Context, ticketID pgtype.UUID) ([]GetTicketReservationsRow, error) {
@@ -214,6 +237,15 @@ func (q *Queries) GetTicketReservations(ctx context.Context, ticketID pgtype.UUI
 			&i.Status,
 			&i.StripeSessionID,
 			&i.TotalPrice,
+			&i.Currency,
+			&i.Subtotal,
+			&i.Discount,
+			&i.BookingFee,
+			&i.ProcessingFee,
+			&i.Tax,
+			&i.VatRate,
+			&i.VatInclusive,
+			&i.BookingFeePerTicket,
 		); err != nil {
 			return nil, err
 		}
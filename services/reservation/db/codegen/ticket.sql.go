@@ -11,6 +11,103 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelReservationTickets = `-- name: CancelReservationTickets :many
+UPDATE Ticket
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE reservation_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL AND checked_in_at IS NULL
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
+`
+
+type CancelReservationTicketsParams struct {
+	ReservationID pgtype.UUID   `json:"reservation_id"`
+	Ids           []pgtype.UUID `json:"ids"`
+}
+
+func (q *Queries) CancelReservationTickets(ctx context.Context, arg CancelReservationTicketsParams) ([]Ticket, error) {
+	rows, err := q.db.Query(ctx, cancelReservationTickets, arg.ReservationID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ticket{}
+	for rows.Next() {
+		var i Ticket
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReservationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ZoneNumber,
+			&i.RowNumber,
+			&i.ColNumber,
+			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const checkInTicket = `-- name: CheckInTicket :one
+UPDATE Ticket
+SET checked_in_at = NOW(), checked_in_gate = $1, updated_at = NOW()
+WHERE id = $2
+    AND owner_id = $3
+    AND credential_version = $4
+    AND deleted_at IS NULL
+    AND checked_in_at IS NULL
+    AND reservation_id IN (SELECT id FROM Reservation WHERE status = 'CONFIRMED')
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
+`
+
+type CheckInTicketParams struct {
+	GateID            *string     `json:"gate_id"`
+	ID                pgtype.UUID `json:"id"`
+	OwnerID           pgtype.UUID `json:"owner_id"`
+	CredentialVersion int32       `json:"credential_version"`
+}
+
+func (q *Queries) CheckInTicket(ctx context.Context, arg CheckInTicketParams) (Ticket, error) {
+	row := q.db.QueryRow(ctx, checkInTicket,
+		arg.GateID,
+		arg.ID,
+		arg.OwnerID,
+		arg.CredentialVersion,
+	)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.ReservationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ZoneNumber,
+		&i.RowNumber,
+		&i.ColNumber,
+		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
+	)
+	return i, err
+}
+
 const checkSeatAvailability = `-- name: CheckSeatAvailability :one
 SELECT EXISTS(
     SELECT 1 FROM Ticket
@@ -41,6 +138,7 @@ SELECT EXISTS(
         AND zone_number = $2
         AND row_number = $3
         AND col_number = $4
+        AND row_number > 0
         AND deleted_at IS NULL
 ) AS is_taken
 `
@@ -76,16 +174,71 @@ func (q *Queries) CountTicketsByReservationID(ctx context.Context, reservationID
 	return count, err
 }
 
+const countTicketsByUserAndEvent = `-- name: CountTicketsByUserAndEvent :one
+SELECT COUNT(*) FROM Ticket
+WHERE owner_id = $1 AND event_id = $2 AND deleted_at IS NULL
+`
+
+type CountTicketsByUserAndEventParams struct {
+	OwnerID pgtype.UUID `json:"owner_id"`
+	EventID pgtype.UUID `json:"event_id"`
+}
+
+func (q *Queries) CountTicketsByUserAndEvent(ctx context.Context, arg CountTicketsByUserAndEventParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTicketsByUserAndEvent, arg.OwnerID, arg.EventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTicketsByZone = `-- name: CountTicketsByZone :many
+SELECT zone_number, COUNT(*) AS sold FROM Ticket
+WHERE event_id = $1 AND deleted_at IS NULL
+GROUP BY zone_number
+ORDER BY zone_number
+`
+
+type CountTicketsByZoneRow struct {
+	ZoneNumber int32 `json:"zone_number"`
+	Sold       int64 `json:"sold"`
+}
+
+func (q *Queries) CountTicketsByZone(ctx context.Context, eventID pgtype.UUID) ([]CountTicketsByZoneRow, error) {
+	rows, err := q.db.Query(ctx, countTicketsByZone, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountTicketsByZoneRow{}
+	for rows.Next() {
+		var i CountTicketsByZoneRow
+		if err := rows.Scan(&i.ZoneNumber, &i.Sold); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createTicket = `-- name: CreateTicket :one
 INSERT INTO Ticket (
     reservation_id,
     zone_number,
     row_number,
     col_number,
-    event_id
+    event_id,
+    owner_id,
+    ticket_type_id,
+    requires_verification,
+    price
 ) VALUES (
-    $1, $2, $3, $4, $5
-) RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id
+    $1, $2, $3, $4, $5, (SELECT user_id FROM Reservation WHERE id = $1), $6,
+    COALESCE((SELECT requires_verification FROM TicketTypeClaim WHERE reservation_id = $1 AND ticket_type_id = $6), FALSE),
+    $7
+) RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
 `
 
 type CreateTicketParams struct {
@@ -94,6 +247,8 @@ type CreateTicketParams struct {
 	RowNumber     int32       `json:"row_number"`
 	ColNumber     int32       `json:"col_number"`
 	EventID       pgtype.UUID `json:"event_id"`
+	TicketTypeID  pgtype.UUID `json:"ticket_type_id"`
+	Price         *int64      `json:"price"`
 }
 
 func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Ticket, error) {
@@ -103,6 +258,8 @@ func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Tic
 		arg.RowNumber,
 		arg.ColNumber,
 		arg.EventID,
+		arg.TicketTypeID,
+		arg.Price,
 	)
 	var i Ticket
 	err := row.Scan(
@@ -115,6 +272,13 @@ func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Tic
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
@@ -135,12 +299,13 @@ INSERT INTO Ticket (
     zone_number,
     row_number,
     col_number,
-    event_id
+    event_id,
+    owner_id
 )
-SELECT $5, $2, $3, $4, $1
+SELECT $5, $2, $3, $4, $1, (SELECT user_id FROM Reservation WHERE id = $5)
 FROM seat_check
 WHERE NOT is_taken
-RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
 `
 
 type CreateTicketWithAvailabilityCheckParams struct {
@@ -170,6 +335,13 @@ func (q *Queries) CreateTicketWithAvailabilityCheck(ctx context.Context, arg Cre
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
@@ -197,7 +369,7 @@ func (q *Queries) DeleteTicketsByReservationID(ctx context.Context, reservationI
 }
 
 const getTicket = `-- name: GetTicket :one
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1
 `
@@ -215,12 +387,19 @@ func (q *Queries) GetTicket(ctx context.Context, id pgtype.UUID) (Ticket, error)
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
 
 const getTicketByID = `-- name: GetTicketByID :one
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE id = $1
 LIMIT 1
 `
@@ -238,12 +417,19 @@ func (q *Queries) GetTicketByID(ctx context.Context, id pgtype.UUID) (Ticket, er
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
 
 const getTicketBySeat = `-- name: GetTicketBySeat :one
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE zone_number = $1
     AND row_number = $2
     AND col_number = $3
@@ -270,6 +456,44 @@ func (q *Queries) GetTicketBySeat(ctx context.Context, arg GetTicketBySeatParams
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
+	)
+	return i, err
+}
+
+const getTicketForUpdate = `-- name: GetTicketForUpdate :one
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
+WHERE id = $1
+LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetTicketForUpdate(ctx context.Context, id pgtype.UUID) (Ticket, error) {
+	row := q.db.QueryRow(ctx, getTicketForUpdate, id)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.ReservationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ZoneNumber,
+		&i.RowNumber,
+		&i.ColNumber,
+		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
@@ -284,8 +508,80 @@ func (q *Queries) HardDeleteTicket(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const listEventScanTickets = `-- name: ListEventScanTickets :many
+SELECT
+    t.id,
+    t.credential_version,
+    (t.deleted_at IS NOT NULL OR r.status <> 'CONFIRMED')::boolean AS revoked,
+    t.checked_in_at,
+    t.requires_verification
+FROM Ticket t
+JOIN Reservation r ON r.id = t.reservation_id
+WHERE t.event_id = $1 AND r.status IN ('CONFIRMED', 'REFUNDED')
+ORDER BY t.id
+`
+
+type ListEventScanTicketsRow struct {
+	ID                   pgtype.UUID        `json:"id"`
+	CredentialVersion    int32              `json:"credential_version"`
+	Revoked              bool               `json:"revoked"`
+	CheckedInAt          pgtype.Timestamptz `json:"checked_in_at"`
+	RequiresVerification bool               `json:"requires_verification"`
+}
+
+func (q *Queries) ListEventScanTickets(ctx context.Context, eventID pgtype.UUID) ([]ListEventScanTicketsRow, error) {
+	rows, err := q.db.Query(ctx, listEventScanTickets, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventScanTicketsRow{}
+	for rows.Next() {
+		var i ListEventScanTicketsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CredentialVersion,
+			&i.Revoked,
+			&i.CheckedInAt,
+			&i.RequiresVerification,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketEventIDs = `-- name: ListTicketEventIDs :many
+SELECT DISTINCT event_id FROM Ticket
+WHERE deleted_at IS NULL
+`
+
+func (q *Queries) ListTicketEventIDs(ctx context.Context) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listTicketEventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var event_id pgtype.UUID
+		if err := rows.Scan(&event_id); err != nil {
+			return nil, err
+		}
+		items = append(items, event_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTickets = `-- name: ListTickets :many
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -309,6 +605,13 @@ func (q *Queries) ListTickets(ctx context.Context) ([]Ticket, error) {
 			&i.RowNumber,
 			&i.ColNumber,
 			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
 		); err != nil {
 			return nil, err
 		}
@@ -321,7 +624,7 @@ func (q *Queries) ListTickets(ctx context.Context) ([]Ticket, error) {
 }
 
 const listTicketsByEventID = `-- name: ListTicketsByEventID :many
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE event_id = $1 AND deleted_at IS NULL
 ORDER BY zone_number, row_number, col_number
 `
@@ -345,6 +648,56 @@ func (q *Queries) ListTicketsByEventID(ctx context.Context, eventID pgtype.UUID)
 			&i.RowNumber,
 			&i.ColNumber,
 			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketsByOwnerID = `-- name: ListTicketsByOwnerID :many
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
+WHERE owner_id = $1 AND deleted_at IS NULL
+ORDER BY event_id, zone_number, row_number, col_number
+`
+
+func (q *Queries) ListTicketsByOwnerID(ctx context.Context, ownerID pgtype.UUID) ([]Ticket, error) {
+	rows, err := q.db.Query(ctx, listTicketsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ticket{}
+	for rows.Next() {
+		var i Ticket
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReservationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ZoneNumber,
+			&i.RowNumber,
+			&i.ColNumber,
+			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
 		); err != nil {
 			return nil, err
 		}
@@ -357,7 +710,7 @@ func (q *Queries) ListTicketsByEventID(ctx context.Context, eventID pgtype.UUID)
 }
 
 const listTicketsByReservationID = `-- name: ListTicketsByReservationID :many
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE reservation_id = $1 AND deleted_at IS NULL
 ORDER BY zone_number, row_number, col_number
 `
@@ -381,6 +734,13 @@ func (q *Queries) ListTicketsByReservationID(ctx context.Context, reservationID
 			&i.RowNumber,
 			&i.ColNumber,
 			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
 		); err != nil {
 			return nil, err
 		}
@@ -393,7 +753,7 @@ func (q *Queries) ListTicketsByReservationID(ctx context.Context, reservationID
 }
 
 const listTicketsBySeat = `-- name: ListTicketsBySeat :many
-SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id FROM Ticket
+SELECT id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price FROM Ticket
 WHERE zone_number = $1
     AND row_number = $2
     AND col_number = $3
@@ -426,6 +786,13 @@ func (q *Queries) ListTicketsBySeat(ctx context.Context, arg ListTicketsBySeatPa
 			&i.RowNumber,
 			&i.ColNumber,
 			&i.EventID,
+			&i.OwnerID,
+			&i.CredentialVersion,
+			&i.CheckedInAt,
+			&i.CheckedInGate,
+			&i.TicketTypeID,
+			&i.RequiresVerification,
+			&i.Price,
 		); err != nil {
 			return nil, err
 		}
@@ -437,6 +804,93 @@ func (q *Queries) ListTicketsBySeat(ctx context.Context, arg ListTicketsBySeatPa
 	return items, nil
 }
 
+const recordOfflineCheckIn = `-- name: RecordOfflineCheckIn :one
+UPDATE Ticket
+SET checked_in_at = $1, checked_in_gate = $2, updated_at = NOW()
+WHERE id = $3
+    AND owner_id = $4
+    AND credential_version = $5
+    AND deleted_at IS NULL
+    AND (checked_in_at IS NULL OR checked_in_at > $1)
+    AND reservation_id IN (SELECT id FROM Reservation WHERE status = 'CONFIRMED')
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
+`
+
+type RecordOfflineCheckInParams struct {
+	ScannedAt         pgtype.Timestamptz `json:"scanned_at"`
+	GateID            *string            `json:"gate_id"`
+	ID                pgtype.UUID        `json:"id"`
+	OwnerID           pgtype.UUID        `json:"owner_id"`
+	CredentialVersion int32              `json:"credential_version"`
+}
+
+func (q *Queries) RecordOfflineCheckIn(ctx context.Context, arg RecordOfflineCheckInParams) (Ticket, error) {
+	row := q.db.QueryRow(ctx, recordOfflineCheckIn,
+		arg.ScannedAt,
+		arg.GateID,
+		arg.ID,
+		arg.OwnerID,
+		arg.CredentialVersion,
+	)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.ReservationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ZoneNumber,
+		&i.RowNumber,
+		&i.ColNumber,
+		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
+	)
+	return i, err
+}
+
+const transferTicketOwner = `-- name: TransferTicketOwner :one
+UPDATE Ticket
+SET owner_id = $1, credential_version = credential_version + 1, updated_at = NOW()
+WHERE id = $2 AND owner_id = $3 AND deleted_at IS NULL AND checked_in_at IS NULL
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
+`
+
+type TransferTicketOwnerParams struct {
+	NewOwnerID pgtype.UUID `json:"new_owner_id"`
+	ID         pgtype.UUID `json:"id"`
+	OwnerID    pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) TransferTicketOwner(ctx context.Context, arg TransferTicketOwnerParams) (Ticket, error) {
+	row := q.db.QueryRow(ctx, transferTicketOwner, arg.NewOwnerID, arg.ID, arg.OwnerID)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.ReservationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ZoneNumber,
+		&i.RowNumber,
+		&i.ColNumber,
+		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
+	)
+	return i, err
+}
+
 const updateTicket = `-- name: UpdateTicket :one
 UPDATE Ticket
 SET
@@ -446,7 +900,7 @@ SET
     col_number = COALESCE($4, col_number),
     updated_at = NOW()
 WHERE id = $5 AND deleted_at IS NULL
-RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
 `
 
 type UpdateTicketParams struct {
@@ -476,6 +930,13 @@ func (q *Queries) UpdateTicket(ctx context.Context, arg UpdateTicketParams) (Tic
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
@@ -484,7 +945,7 @@ const updateTicketReservation = `-- name: UpdateTicketReservation :one
 UPDATE Ticket
 SET reservation_id = $2, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id
+RETURNING id, reservation_id, created_at, updated_at, deleted_at, zone_number, row_number, col_number, event_id, owner_id, credential_version, checked_in_at, checked_in_gate, ticket_type_id, requires_verification, price
 `
 
 type UpdateTicketReservationParams struct {
@@ -505,6 +966,13 @@ func (q *Queries) UpdateTicketReservation(ctx context.Context, arg UpdateTicketR
 		&i.RowNumber,
 		&i.ColNumber,
 		&i.EventID,
+		&i.OwnerID,
+		&i.CredentialVersion,
+		&i.CheckedInAt,
+		&i.CheckedInGate,
+		&i.TicketTypeID,
+		&i.RequiresVerification,
+		&i.Price,
 	)
 	return i, err
 }
@@ -0,0 +1,33 @@
+-- migrate:up
+-- a seat sold twice is two paid tickets, one of them has to be compensated and refunded by hand before the
+-- index can be built, so the migration stops and lists them instead of dropping either
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(
+        format('event %s zone %s row %s col %s: tickets %s', event_id, zone_number, row_number, col_number, tickets),
+        E'\n'
+    )
+    INTO duplicates
+    FROM (
+        SELECT event_id, zone_number, row_number, col_number,
+            string_agg(id::TEXT, ', ' ORDER BY created_at, id) AS tickets
+        FROM Ticket
+        WHERE deleted_at IS NULL
+        GROUP BY event_id, zone_number, row_number, col_number
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION E'seats sold more than once, compensate the later tickets before migrating:\n%', duplicates;
+    END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX ticket_event_seat_unique_idx
+ON Ticket (event_id, zone_number, row_number, col_number)
+WHERE deleted_at IS NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS ticket_event_seat_unique_idx;
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE Outbox (
+    id UUID PRIMARY KEY,
+    queue TEXT NOT NULL,
+    type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX outbox_pending_idx ON Outbox (next_attempt_at) WHERE published_at IS NULL;
+
+-- migrate:down
+DROP TABLE IF EXISTS Outbox;
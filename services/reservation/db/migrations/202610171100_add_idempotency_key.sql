@@ -0,0 +1,13 @@
+-- migrate:up
+CREATE TABLE IdempotencyKey (
+    user_id UUID NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS IdempotencyKey;
@@ -0,0 +1,14 @@
+-- migrate:up
+-- general admission tickets are unnumbered (row 0, column 0) and share their seat, capacity is enforced in Redis
+DROP INDEX IF EXISTS ticket_event_seat_unique_idx;
+
+CREATE UNIQUE INDEX ticket_event_seat_unique_idx
+ON Ticket (event_id, zone_number, row_number, col_number)
+WHERE deleted_at IS NULL AND row_number > 0;
+
+-- migrate:down
+DROP INDEX IF EXISTS ticket_event_seat_unique_idx;
+
+CREATE UNIQUE INDEX ticket_event_seat_unique_idx
+ON Ticket (event_id, zone_number, row_number, col_number)
+WHERE deleted_at IS NULL;
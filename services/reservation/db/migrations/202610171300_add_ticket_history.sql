@@ -0,0 +1,25 @@
+-- migrate:up
+-- one row per change of a ticket, ISSUED when it is sold and CANCELLED or REFUNDED when it is given back
+CREATE TABLE TicketHistory (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL,
+    reservation_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    refunded_amount FLOAT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (ticket_id) REFERENCES Ticket(id),
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE INDEX ticket_history_reservation_idx ON TicketHistory (reservation_id, created_at);
+
+INSERT INTO TicketHistory (ticket_id, reservation_id, action, created_at)
+SELECT id, reservation_id, 'ISSUED', created_at FROM Ticket;
+
+INSERT INTO TicketHistory (ticket_id, reservation_id, action, created_at)
+SELECT id, reservation_id, 'CANCELLED', deleted_at FROM Ticket
+WHERE deleted_at IS NOT NULL;
+
+-- migrate:down
+DROP TABLE IF EXISTS TicketHistory;
@@ -0,0 +1,36 @@
+-- migrate:up
+-- a ticket belongs to its buyer until it is transferred, credential_version is bumped on every
+-- transfer so credentials issued to the previous owner stop being valid
+ALTER TABLE Ticket ADD COLUMN owner_id UUID;
+ALTER TABLE Ticket ADD COLUMN credential_version INT NOT NULL DEFAULT 1;
+
+UPDATE Ticket t SET owner_id = r.user_id
+FROM Reservation r
+WHERE r.id = t.reservation_id;
+
+ALTER TABLE Ticket ALTER COLUMN owner_id SET NOT NULL;
+
+CREATE INDEX ticket_owner_event_idx ON Ticket (owner_id, event_id) WHERE deleted_at IS NULL;
+
+-- an offer of a ticket to another user, PENDING until the recipient accepts it or the owner offers the ticket again
+CREATE TABLE TicketTransfer (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL,
+    from_user_id UUID NOT NULL,
+    to_user_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'PENDING',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (ticket_id) REFERENCES Ticket(id)
+);
+
+CREATE UNIQUE INDEX ticket_transfer_pending_idx ON TicketTransfer (ticket_id) WHERE status = 'PENDING';
+CREATE INDEX ticket_transfer_to_user_idx ON TicketTransfer (to_user_id, status);
+CREATE INDEX ticket_transfer_from_user_idx ON TicketTransfer (from_user_id, status);
+
+-- migrate:down
+DROP TABLE IF EXISTS TicketTransfer;
+DROP INDEX IF EXISTS ticket_owner_event_idx;
+ALTER TABLE Ticket DROP COLUMN IF EXISTS credential_version;
+ALTER TABLE Ticket DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,8 @@
+-- migrate:up
+-- a ticket is checked in once, at the gate that scanned its credential first
+ALTER TABLE Ticket ADD COLUMN checked_in_at TIMESTAMPTZ;
+ALTER TABLE Ticket ADD COLUMN checked_in_gate TEXT;
+
+-- migrate:down
+ALTER TABLE Ticket DROP COLUMN IF EXISTS checked_in_gate;
+ALTER TABLE Ticket DROP COLUMN IF EXISTS checked_in_at;
@@ -0,0 +1,7 @@
+-- migrate:up
+
+-- scan bundles list every ticket of an event, given back ones included
+CREATE INDEX ticket_event_idx ON Ticket (event_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS ticket_event_idx;
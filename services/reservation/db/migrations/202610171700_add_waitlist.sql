@@ -0,0 +1,25 @@
+-- migrate:up
+-- a user waiting for seats of an event zone, zone_number 0 takes seats of any zone. Seats given back are
+-- offered in order of joining as a PENDING reservation, reservation_id points to it once OFFERED
+CREATE TABLE WaitlistEntry (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL,
+    zone_number INT NOT NULL DEFAULT 0,
+    user_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'WAITING',
+    reservation_id UUID,
+    offer_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE UNIQUE INDEX waitlist_entry_active_idx ON WaitlistEntry (event_id, zone_number, user_id) WHERE status IN ('WAITING', 'OFFERED');
+CREATE INDEX waitlist_entry_queue_idx ON WaitlistEntry (event_id, zone_number, created_at) WHERE status = 'WAITING';
+CREATE INDEX waitlist_entry_reservation_idx ON WaitlistEntry (reservation_id);
+CREATE INDEX waitlist_entry_user_idx ON WaitlistEntry (user_id, status);
+
+-- migrate:down
+DROP TABLE IF EXISTS WaitlistEntry;
@@ -0,0 +1,35 @@
+-- migrate:up
+-- a ballot application of a user for a quantity of tickets in a zone of an event. When the application
+-- window closes the draw settles each APPLIED application to WON, holding seats in reservation_id, or LOST
+CREATE TABLE BallotApplication (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    zone_number INT NOT NULL,
+    quantity INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'APPLIED',
+    reservation_id UUID,
+    draw_position INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE UNIQUE INDEX ballot_application_active_idx ON BallotApplication (event_id, user_id) WHERE status <> 'WITHDRAWN';
+CREATE INDEX ballot_application_pending_idx ON BallotApplication (event_id) WHERE status = 'APPLIED';
+CREATE INDEX ballot_application_user_idx ON BallotApplication (user_id, created_at);
+
+-- the draw of an event, kept for audit. Applications are sorted by id and shuffled with a generator
+-- seeded by seed, application_ids holds the outcome in draw order
+CREATE TABLE BallotDraw (
+    event_id UUID PRIMARY KEY,
+    seed BIGINT NOT NULL,
+    application_ids UUID[] NOT NULL,
+    drawn_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS BallotDraw;
+DROP TABLE IF EXISTS BallotApplication;
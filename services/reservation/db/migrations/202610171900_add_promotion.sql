@@ -0,0 +1,45 @@
+-- migrate:up
+-- a promo code taking a PERCENTAGE or a FIXED amount off the seats it applies to. A promotion without
+-- event_id applies to every event, zone_number narrows it to one zone of its event. A cap of 0 is unlimited
+CREATE TABLE Promotion (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL,
+    discount_type TEXT NOT NULL,
+    discount_value FLOAT NOT NULL,
+    event_id UUID,
+    zone_number INT,
+    max_redemptions INT NOT NULL DEFAULT 0,
+    max_redemptions_per_user INT NOT NULL DEFAULT 0,
+    redemption_count INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX promotion_code_idx ON Promotion (code);
+
+-- a promo code used by a reservation. Redemptions of reservations that are cancelled before they are
+-- paid are RELEASED and stop counting towards the caps
+CREATE TABLE PromotionRedemption (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    promotion_id UUID NOT NULL,
+    reservation_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    amount FLOAT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (promotion_id) REFERENCES Promotion(id),
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE UNIQUE INDEX promotion_redemption_reservation_idx ON PromotionRedemption (reservation_id, promotion_id);
+CREATE INDEX promotion_redemption_user_idx ON PromotionRedemption (promotion_id, user_id) WHERE status = 'ACTIVE';
+
+-- migrate:down
+DROP TABLE IF EXISTS PromotionRedemption;
+DROP TABLE IF EXISTS Promotion;
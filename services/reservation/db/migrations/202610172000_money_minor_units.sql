@@ -0,0 +1,39 @@
+-- migrate:up
+-- money is stored as an integer amount of the minor unit of its currency, satang for THB
+ALTER TABLE Reservation
+ALTER COLUMN total_price TYPE BIGINT USING ROUND((total_price * 100)::NUMERIC)::BIGINT;
+
+ALTER TABLE TicketHistory ALTER COLUMN refunded_amount DROP DEFAULT;
+ALTER TABLE TicketHistory
+ALTER COLUMN refunded_amount TYPE BIGINT USING ROUND((refunded_amount * 100)::NUMERIC)::BIGINT;
+ALTER TABLE TicketHistory ALTER COLUMN refunded_amount SET DEFAULT 0;
+
+ALTER TABLE PromotionRedemption
+ALTER COLUMN amount TYPE BIGINT USING ROUND((amount * 100)::NUMERIC)::BIGINT;
+
+-- a PERCENTAGE promotion keeps its rate in percent_off, a FIXED one moves its value to amount_off
+ALTER TABLE Promotion RENAME COLUMN discount_value TO percent_off;
+ALTER TABLE Promotion ADD COLUMN amount_off BIGINT NOT NULL DEFAULT 0;
+
+UPDATE Promotion
+SET amount_off = ROUND((percent_off * 100)::NUMERIC)::BIGINT, percent_off = 0
+WHERE discount_type = 'FIXED';
+
+-- migrate:down
+UPDATE Promotion
+SET percent_off = amount_off / 100.0
+WHERE discount_type = 'FIXED';
+
+ALTER TABLE Promotion DROP COLUMN IF EXISTS amount_off;
+ALTER TABLE Promotion RENAME COLUMN percent_off TO discount_value;
+
+ALTER TABLE PromotionRedemption
+ALTER COLUMN amount TYPE FLOAT USING amount / 100.0;
+
+ALTER TABLE TicketHistory ALTER COLUMN refunded_amount DROP DEFAULT;
+ALTER TABLE TicketHistory
+ALTER COLUMN refunded_amount TYPE FLOAT USING refunded_amount / 100.0;
+ALTER TABLE TicketHistory ALTER COLUMN refunded_amount SET DEFAULT 0;
+
+ALTER TABLE Reservation
+ALTER COLUMN total_price TYPE FLOAT USING total_price / 100.0;
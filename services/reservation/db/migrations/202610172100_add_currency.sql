@@ -0,0 +1,9 @@
+-- migrate:up
+-- a reservation is paid in the currency of its event, a FIXED promotion takes its amount off reservations
+-- in its own currency only. Everything stored so far is in THB
+ALTER TABLE Reservation ADD COLUMN currency TEXT NOT NULL DEFAULT 'THB';
+ALTER TABLE Promotion ADD COLUMN currency TEXT NOT NULL DEFAULT 'THB';
+
+-- migrate:down
+ALTER TABLE Promotion DROP COLUMN IF EXISTS currency;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS currency;
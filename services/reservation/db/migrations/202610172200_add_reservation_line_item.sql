@@ -0,0 +1,24 @@
+-- migrate:up
+-- the breakdown a reservation is charged by, the same lines the buyer sees at checkout. A TICKET line is
+-- the seats of one zone, FEE and DISCOUNT lines follow them. Amounts are in the minor unit of the currency
+-- of the reservation, discounts are negative
+CREATE TABLE ReservationLineItem (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL,
+    position INT NOT NULL,
+    kind TEXT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    zone_number INT,
+    quantity INT NOT NULL,
+    unit_amount BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE UNIQUE INDEX reservation_line_item_position_idx ON ReservationLineItem (reservation_id, position);
+
+-- migrate:down
+DROP TABLE IF EXISTS ReservationLineItem;
@@ -0,0 +1,27 @@
+-- migrate:up
+-- what total_price is made of, in the minor unit of the currency of the reservation: the tickets before
+-- discounts, the promo code discounts, the booking fee of every ticket, the processing fee of the order and
+-- the VAT. With vat_inclusive the VAT is part of the prices and fees, otherwise it is added on top.
+-- Reservations made so far were charged for their tickets only
+ALTER TABLE Reservation ADD COLUMN subtotal BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN booking_fee BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN processing_fee BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN tax BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN vat_rate DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE Reservation ADD COLUMN vat_inclusive BOOLEAN NOT NULL DEFAULT TRUE;
+
+UPDATE Reservation r
+SET discount = COALESCE((
+    SELECT SUM(pr.amount) FROM PromotionRedemption pr WHERE pr.reservation_id = r.id
+), 0);
+UPDATE Reservation SET subtotal = total_price + discount;
+
+-- migrate:down
+ALTER TABLE Reservation DROP COLUMN IF EXISTS vat_inclusive;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS vat_rate;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS tax;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS processing_fee;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS booking_fee;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS discount;
+ALTER TABLE Reservation DROP COLUMN IF EXISTS subtotal;
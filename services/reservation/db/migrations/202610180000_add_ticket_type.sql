@@ -0,0 +1,36 @@
+-- migrate:up
+-- the ticket type of the event service a ticket was sold as, NULL when it was sold at the zone price.
+-- requires_verification is copied from the type when the ticket is issued so the door does not ask the
+-- event service
+ALTER TABLE Ticket ADD COLUMN ticket_type_id UUID;
+ALTER TABLE Ticket ADD COLUMN requires_verification BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- how many tickets of each ticket type are held or sold, checked against the cap of the type
+CREATE TABLE TicketTypeInventory (
+    ticket_type_id UUID PRIMARY KEY,
+    sold INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- the tickets of a type a reservation took. Claims of reservations that are cancelled or refunded are
+-- RELEASED and stop counting towards the cap
+CREATE TABLE TicketTypeClaim (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_type_id UUID NOT NULL,
+    reservation_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    requires_verification BOOLEAN NOT NULL DEFAULT FALSE,
+    status TEXT NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (reservation_id) REFERENCES Reservation(id)
+);
+
+CREATE UNIQUE INDEX ticket_type_claim_reservation_idx ON TicketTypeClaim (reservation_id, ticket_type_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS TicketTypeClaim;
+DROP TABLE IF EXISTS TicketTypeInventory;
+ALTER TABLE Ticket DROP COLUMN IF EXISTS requires_verification;
+ALTER TABLE Ticket DROP COLUMN IF EXISTS ticket_type_id;
@@ -0,0 +1,7 @@
+-- migrate:up
+-- the price a ticket was sold at, locked when its seat was held so a price tier that changes during
+-- checkout does not change what was charged. NULL for tickets sold before prices were locked
+ALTER TABLE Ticket ADD COLUMN price BIGINT;
+
+-- migrate:down
+ALTER TABLE Ticket DROP COLUMN IF EXISTS price;
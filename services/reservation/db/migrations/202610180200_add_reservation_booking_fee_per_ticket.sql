@@ -0,0 +1,12 @@
+-- migrate:up
+-- the booking fee charged per ticket, kept so a partial cancellation charges the tickets left the same fee
+-- instead of deriving it back from booking_fee. Reservations made so far carry it on their booking fee line
+ALTER TABLE Reservation ADD COLUMN booking_fee_per_ticket BIGINT NOT NULL DEFAULT 0;
+
+UPDATE Reservation r
+SET booking_fee_per_ticket = li.unit_amount
+FROM ReservationLineItem li
+WHERE li.reservation_id = r.id AND li.kind = 'FEE' AND li.name = 'Booking fee';
+
+-- migrate:down
+ALTER TABLE Reservation DROP COLUMN IF EXISTS booking_fee_per_ticket;
@@ -0,0 +1,61 @@
+-- name: CreateBallotApplication :one
+INSERT INTO BallotApplication (
+    event_id,
+    user_id,
+    zone_number,
+    quantity
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetBallotApplication :one
+SELECT * FROM BallotApplication
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListBallotApplicationsByUserID :many
+SELECT * FROM BallotApplication
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListAppliedBallotApplicationIDs :many
+SELECT id FROM BallotApplication
+WHERE event_id = $1 AND status = 'APPLIED'
+ORDER BY id;
+
+-- name: ListBallotEventIDs :many
+SELECT DISTINCT event_id FROM BallotApplication
+WHERE status = 'APPLIED';
+
+-- name: CountAppliedBallotApplications :one
+SELECT COUNT(*) FROM BallotApplication
+WHERE event_id = $1 AND status = 'APPLIED';
+
+-- name: WithdrawBallotApplication :execrows
+UPDATE BallotApplication
+SET status = 'WITHDRAWN', updated_at = NOW()
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id) AND status = 'APPLIED';
+
+-- name: SettleBallotApplication :execrows
+UPDATE BallotApplication
+SET status = sqlc.arg(new_status), reservation_id = sqlc.arg(reservation_id), draw_position = sqlc.arg(draw_position), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = 'APPLIED';
+
+-- name: CreateBallotDraw :exec
+INSERT INTO BallotDraw (
+    event_id,
+    seed,
+    application_ids
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (event_id) DO NOTHING;
+
+-- name: GetBallotDraw :one
+SELECT * FROM BallotDraw
+WHERE event_id = $1
+LIMIT 1;
+
+-- name: CompleteBallotDraw :exec
+UPDATE BallotDraw
+SET completed_at = NOW()
+WHERE event_id = $1 AND completed_at IS NULL;
@@ -0,0 +1,25 @@
+-- name: ClaimIdempotencyKey :execrows
+INSERT INTO IdempotencyKey (
+    user_id,
+    idempotency_key,
+    request_hash
+) VALUES (
+    sqlc.arg(user_id), sqlc.arg(idempotency_key), sqlc.arg(request_hash)
+)
+ON CONFLICT (user_id, idempotency_key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash, response = NULL, created_at = NOW()
+WHERE (IdempotencyKey.response IS NULL AND IdempotencyKey.created_at < sqlc.arg(abandoned_before))
+    OR IdempotencyKey.created_at < sqlc.arg(expired_before);
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM IdempotencyKey
+WHERE user_id = $1 AND idempotency_key = $2;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE IdempotencyKey
+SET response = $3
+WHERE user_id = $1 AND idempotency_key = $2;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM IdempotencyKey
+WHERE user_id = $1 AND idempotency_key = $2 AND response IS NULL;
@@ -0,0 +1,31 @@
+-- name: CreateOutboxMessage :exec
+INSERT INTO Outbox (
+    id,
+    queue,
+    type,
+    payload
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: ClaimOutboxMessages :many
+UPDATE Outbox
+SET next_attempt_at = sqlc.arg(locked_until)
+WHERE id IN (
+    SELECT id FROM Outbox
+    WHERE published_at IS NULL AND next_attempt_at <= NOW()
+    ORDER BY created_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE Outbox
+SET published_at = NOW()
+WHERE id = $1;
+
+-- name: MarkOutboxMessageFailed :exec
+UPDATE Outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1;
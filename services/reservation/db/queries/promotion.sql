@@ -0,0 +1,66 @@
+-- name: CreatePromotion :one
+INSERT INTO Promotion (
+    code,
+    discount_type,
+    percent_off,
+    amount_off,
+    event_id,
+    zone_number,
+    max_redemptions,
+    max_redemptions_per_user,
+    stackable,
+    starts_at,
+    ends_at,
+    currency
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: ListPromotions :many
+SELECT * FROM Promotion
+ORDER BY created_at DESC;
+
+-- name: ListPromotionsByCodes :many
+SELECT * FROM Promotion
+WHERE code = ANY(sqlc.arg(codes)::TEXT[]);
+
+-- name: DeactivatePromotion :execrows
+UPDATE Promotion
+SET active = FALSE, updated_at = NOW()
+WHERE id = $1;
+
+-- name: ClaimPromotionRedemption :execrows
+UPDATE Promotion
+SET redemption_count = redemption_count + 1, updated_at = NOW()
+WHERE id = $1 AND active AND (max_redemptions = 0 OR redemption_count < max_redemptions);
+
+-- name: CountUserPromotionRedemptions :one
+SELECT COUNT(*) FROM PromotionRedemption
+WHERE promotion_id = $1 AND user_id = $2 AND status = 'ACTIVE';
+
+-- name: CreatePromotionRedemption :exec
+INSERT INTO PromotionRedemption (
+    promotion_id,
+    reservation_id,
+    user_id,
+    amount
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: ReleasePromotionRedemptions :exec
+WITH released AS (
+    UPDATE PromotionRedemption
+    SET status = 'RELEASED', updated_at = NOW()
+    WHERE reservation_id = $1 AND status = 'ACTIVE'
+    RETURNING promotion_id
+)
+UPDATE Promotion
+SET redemption_count = redemption_count - 1, updated_at = NOW()
+WHERE id IN (SELECT promotion_id FROM released);
+
+-- name: ListReservationPromotions :many
+SELECT p.code, pr.amount FROM PromotionRedemption pr
+JOIN Promotion p ON p.id = pr.promotion_id
+WHERE pr.reservation_id = $1
+ORDER BY p.code;
@@ -5,9 +5,18 @@ INSERT INTO Reservation (
     event_id,
     status,
     total_price,
-    stripe_session_id
+    stripe_session_id,
+    currency,
+    subtotal,
+    discount,
+    booking_fee,
+    processing_fee,
+    tax,
+    vat_rate,
+    vat_inclusive,
+    booking_fee_per_ticket
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 ) RETURNING *;
 
 -- name: GetReservation :one
@@ -30,6 +39,11 @@ SELECT * FROM Reservation
 WHERE user_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC;
 
+-- name: ListReservationsByUserEventStatus :many
+SELECT * FROM Reservation
+WHERE user_id = $1 AND event_id = $2 AND status = $3 AND deleted_at IS NULL
+ORDER BY created_at;
+
 -- name: ListReservationsByEventID :many
 SELECT * FROM Reservation
 WHERE event_id = $1 AND deleted_at IS NULL
@@ -40,12 +54,39 @@ SELECT * FROM Reservation
 WHERE status = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC;
 
+-- name: ListReservationsByStatusCreatedBefore :many
+SELECT * FROM Reservation
+WHERE status = $1 AND created_at < $2 AND deleted_at IS NULL
+ORDER BY created_at;
+
 -- name: UpdateReservationStatus :one
 UPDATE Reservation
 SET status = $2, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdateReservationStripeSession :execrows
+UPDATE Reservation
+SET stripe_session_id = sqlc.arg(stripe_session_id), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(status) AND deleted_at IS NULL;
+
+-- name: TransitionReservationStatus :execrows
+UPDATE Reservation
+SET status = sqlc.arg(new_status), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status) AND deleted_at IS NULL;
+
+-- name: UpdateReservationBreakdown :execrows
+UPDATE Reservation
+SET
+    subtotal = sqlc.arg(subtotal),
+    discount = sqlc.arg(discount),
+    booking_fee = sqlc.arg(booking_fee),
+    processing_fee = sqlc.arg(processing_fee),
+    tax = sqlc.arg(tax),
+    total_price = sqlc.arg(total_price),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(status) AND deleted_at IS NULL;
+
 -- name: UpdateReservation :one
 UPDATE Reservation
 SET
@@ -0,0 +1,19 @@
+-- name: BulkCreateReservationLineItems :copyfrom
+INSERT INTO ReservationLineItem (
+    reservation_id,
+    position,
+    kind,
+    name,
+    description,
+    zone_number,
+    quantity,
+    unit_amount,
+    amount
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: ListReservationLineItems :many
+SELECT * FROM ReservationLineItem
+WHERE reservation_id = $1
+ORDER BY position;
@@ -4,9 +4,15 @@ INSERT INTO Ticket (
     zone_number,
     row_number,
     col_number,
-    event_id
+    event_id,
+    owner_id,
+    ticket_type_id,
+    requires_verification,
+    price
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, (SELECT user_id FROM Reservation WHERE id = $1), $6,
+    COALESCE((SELECT requires_verification FROM TicketTypeClaim WHERE reservation_id = $1 AND ticket_type_id = $6), FALSE),
+    $7
 ) RETURNING *;
 
 -- name: GetTicket :one
@@ -29,11 +35,20 @@ SELECT * FROM Ticket
 WHERE reservation_id = $1 AND deleted_at IS NULL
 ORDER BY zone_number, row_number, col_number;
 
+-- name: ListTicketsByOwnerID :many
+SELECT * FROM Ticket
+WHERE owner_id = $1 AND deleted_at IS NULL
+ORDER BY event_id, zone_number, row_number, col_number;
+
 -- name: ListTicketsByEventID :many
 SELECT * FROM Ticket
 WHERE event_id = $1 AND deleted_at IS NULL
 ORDER BY zone_number, row_number, col_number;
 
+-- name: ListTicketEventIDs :many
+SELECT DISTINCT event_id FROM Ticket
+WHERE deleted_at IS NULL;
+
 -- name: ListTicketsBySeat :many
 SELECT * FROM Ticket
 WHERE zone_number = $1
@@ -67,6 +82,52 @@ SET reservation_id = $2, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: TransferTicketOwner :one
+UPDATE Ticket
+SET owner_id = sqlc.arg(new_owner_id), credential_version = credential_version + 1, updated_at = NOW()
+WHERE id = sqlc.arg(id) AND owner_id = sqlc.arg(owner_id) AND deleted_at IS NULL AND checked_in_at IS NULL
+RETURNING *;
+
+-- name: CheckInTicket :one
+UPDATE Ticket
+SET checked_in_at = NOW(), checked_in_gate = sqlc.arg(gate_id), updated_at = NOW()
+WHERE id = sqlc.arg(id)
+    AND owner_id = sqlc.arg(owner_id)
+    AND credential_version = sqlc.arg(credential_version)
+    AND deleted_at IS NULL
+    AND checked_in_at IS NULL
+    AND reservation_id IN (SELECT id FROM Reservation WHERE status = 'CONFIRMED')
+RETURNING *;
+
+-- name: GetTicketForUpdate :one
+SELECT * FROM Ticket
+WHERE id = $1
+LIMIT 1
+FOR UPDATE;
+
+-- name: RecordOfflineCheckIn :one
+UPDATE Ticket
+SET checked_in_at = sqlc.arg(scanned_at), checked_in_gate = sqlc.arg(gate_id), updated_at = NOW()
+WHERE id = sqlc.arg(id)
+    AND owner_id = sqlc.arg(owner_id)
+    AND credential_version = sqlc.arg(credential_version)
+    AND deleted_at IS NULL
+    AND (checked_in_at IS NULL OR checked_in_at > sqlc.arg(scanned_at))
+    AND reservation_id IN (SELECT id FROM Reservation WHERE status = 'CONFIRMED')
+RETURNING *;
+
+-- name: ListEventScanTickets :many
+SELECT
+    t.id,
+    t.credential_version,
+    (t.deleted_at IS NOT NULL OR r.status <> 'CONFIRMED')::boolean AS revoked,
+    t.checked_in_at,
+    t.requires_verification
+FROM Ticket t
+JOIN Reservation r ON r.id = t.reservation_id
+WHERE t.event_id = $1 AND r.status IN ('CONFIRMED', 'REFUNDED')
+ORDER BY t.id;
+
 -- name: DeleteTicket :exec
 UPDATE Ticket
 SET deleted_at = NOW(), updated_at = NOW()
@@ -77,6 +138,12 @@ UPDATE Ticket
 SET deleted_at = NOW(), updated_at = NOW()
 WHERE reservation_id = $1 AND deleted_at IS NULL;
 
+-- name: CancelReservationTickets :many
+UPDATE Ticket
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE reservation_id = sqlc.arg(reservation_id) AND id = ANY(sqlc.arg(ids)::uuid[]) AND deleted_at IS NULL AND checked_in_at IS NULL
+RETURNING *;
+
 -- name: HardDeleteTicket :exec
 DELETE FROM Ticket
 WHERE id = $1;
@@ -85,6 +152,16 @@ WHERE id = $1;
 SELECT COUNT(*) FROM Ticket
 WHERE reservation_id = $1 AND deleted_at IS NULL;
 
+-- name: CountTicketsByUserAndEvent :one
+SELECT COUNT(*) FROM Ticket
+WHERE owner_id = $1 AND event_id = $2 AND deleted_at IS NULL;
+
+-- name: CountTicketsByZone :many
+SELECT zone_number, COUNT(*) AS sold FROM Ticket
+WHERE event_id = $1 AND deleted_at IS NULL
+GROUP BY zone_number
+ORDER BY zone_number;
+
 -- name: CheckSeatAvailability :one
 SELECT EXISTS(
     SELECT 1 FROM Ticket
@@ -101,6 +178,7 @@ SELECT EXISTS(
         AND zone_number = $2
         AND row_number = $3
         AND col_number = $4
+        AND row_number > 0
         AND deleted_at IS NULL
 ) AS is_taken;
 
@@ -120,9 +198,10 @@ INSERT INTO Ticket (
     zone_number,
     row_number,
     col_number,
-    event_id
+    event_id,
+    owner_id
 )
-SELECT $5, $2, $3, $4, $1
+SELECT $5, $2, $3, $4, $1, (SELECT user_id FROM Reservation WHERE id = $5)
 FROM seat_check
 WHERE NOT is_taken
 RETURNING *;
@@ -0,0 +1,24 @@
+-- name: CreateTicketHistory :exec
+INSERT INTO TicketHistory (
+    ticket_id,
+    reservation_id,
+    action,
+    refunded_amount
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: ListTicketHistoryByReservationID :many
+SELECT
+    h.id,
+    h.ticket_id,
+    h.action,
+    h.refunded_amount,
+    h.created_at,
+    t.zone_number,
+    t.row_number,
+    t.col_number
+FROM TicketHistory h
+JOIN Ticket t ON t.id = h.ticket_id
+WHERE h.reservation_id = $1
+ORDER BY h.created_at, t.zone_number, t.row_number, t.col_number;
@@ -0,0 +1,44 @@
+-- name: CreateTicketTransfer :one
+INSERT INTO TicketTransfer (
+    id,
+    ticket_id,
+    from_user_id,
+    to_user_id
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetTicketTransfer :one
+SELECT * FROM TicketTransfer
+WHERE id = $1
+LIMIT 1;
+
+-- name: TransitionTicketTransferStatus :execrows
+UPDATE TicketTransfer
+SET status = sqlc.arg(new_status), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status);
+
+-- name: TransitionTicketTransfersByTicketID :exec
+UPDATE TicketTransfer
+SET status = sqlc.arg(new_status), updated_at = NOW()
+WHERE ticket_id = sqlc.arg(ticket_id) AND status = sqlc.arg(current_status);
+
+-- name: ListTicketTransfersByUserID :many
+SELECT
+    tt.id,
+    tt.ticket_id,
+    tt.from_user_id,
+    tt.to_user_id,
+    tt.status,
+    tt.created_at,
+    tt.updated_at,
+    t.event_id,
+    t.zone_number,
+    t.row_number,
+    t.col_number
+FROM TicketTransfer tt
+JOIN Ticket t ON t.id = tt.ticket_id
+WHERE (tt.from_user_id = sqlc.arg(user_id) OR tt.to_user_id = sqlc.arg(user_id))
+    AND tt.status = sqlc.arg(status)
+    AND t.deleted_at IS NULL
+ORDER BY tt.created_at DESC;
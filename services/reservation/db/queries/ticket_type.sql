@@ -0,0 +1,40 @@
+-- name: ClaimTicketType :execrows
+INSERT INTO TicketTypeInventory (ticket_type_id, sold)
+SELECT sqlc.arg(ticket_type_id)::UUID, sqlc.arg(quantity)::INT
+WHERE sqlc.arg(quantity_cap)::INT = 0 OR sqlc.arg(quantity)::INT <= sqlc.arg(quantity_cap)::INT
+ON CONFLICT (ticket_type_id) DO UPDATE
+SET sold = TicketTypeInventory.sold + EXCLUDED.sold, updated_at = NOW()
+WHERE sqlc.arg(quantity_cap)::INT = 0 OR TicketTypeInventory.sold + EXCLUDED.sold <= sqlc.arg(quantity_cap)::INT;
+
+-- name: CreateTicketTypeClaim :exec
+INSERT INTO TicketTypeClaim (
+    ticket_type_id,
+    reservation_id,
+    quantity,
+    requires_verification
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: ReleaseTicketTypeClaims :exec
+WITH released AS (
+    UPDATE TicketTypeClaim
+    SET status = 'RELEASED', updated_at = NOW()
+    WHERE reservation_id = $1 AND status = 'ACTIVE'
+    RETURNING ticket_type_id, quantity
+)
+UPDATE TicketTypeInventory
+SET sold = TicketTypeInventory.sold - released.quantity, updated_at = NOW()
+FROM released
+WHERE TicketTypeInventory.ticket_type_id = released.ticket_type_id;
+
+-- name: ReturnTicketTypeClaim :exec
+WITH returned AS (
+    UPDATE TicketTypeClaim
+    SET quantity = quantity - sqlc.arg(quantity)::INT, updated_at = NOW()
+    WHERE reservation_id = sqlc.arg(reservation_id) AND ticket_type_id = sqlc.arg(ticket_type_id) AND status = 'ACTIVE'
+    RETURNING ticket_type_id
+)
+UPDATE TicketTypeInventory
+SET sold = sold - sqlc.arg(quantity)::INT, updated_at = NOW()
+WHERE ticket_type_id IN (SELECT ticket_type_id FROM returned);
@@ -0,0 +1,42 @@
+-- name: CreateWaitlistEntry :one
+INSERT INTO WaitlistEntry (
+    event_id,
+    zone_number,
+    user_id,
+    quantity
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetWaitlistEntry :one
+SELECT * FROM WaitlistEntry
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListActiveWaitlistEntriesByUserID :many
+SELECT * FROM WaitlistEntry
+WHERE user_id = $1 AND status IN ('WAITING', 'OFFERED')
+ORDER BY created_at;
+
+-- name: ListWaitingEntries :many
+SELECT * FROM WaitlistEntry
+WHERE event_id = sqlc.arg(event_id)
+    AND zone_number IN (sqlc.arg(zone_number), 0)
+    AND status = 'WAITING'
+ORDER BY created_at
+LIMIT sqlc.arg(max_entries);
+
+-- name: OfferWaitlistEntry :execrows
+UPDATE WaitlistEntry
+SET status = 'OFFERED', reservation_id = sqlc.arg(reservation_id), offer_expires_at = sqlc.arg(offer_expires_at), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = 'WAITING';
+
+-- name: CloseWaitlistOffer :exec
+UPDATE WaitlistEntry
+SET status = sqlc.arg(new_status), updated_at = NOW()
+WHERE reservation_id = sqlc.arg(reservation_id) AND status = 'OFFERED';
+
+-- name: LeaveWaitlist :execrows
+UPDATE WaitlistEntry
+SET status = 'LEFT', updated_at = NOW()
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id) AND status = 'WAITING';
@@ -13,6 +13,7 @@ require (
 	github.com/redis/go-redis/v9 v9.14.0
 	github.com/stripe/stripe-go/v83 v83.2.0
 	google.golang.org/grpc v1.76.0
+	google.golang.org/protobuf v1.36.10
 )
 
 require (
@@ -37,5 +38,4 @@ require (
 	golang.org/x/sys v0.36.0 // indirect
 	golang.org/x/text v0.29.0 // indirect
 	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
 )
@@ -0,0 +1,80 @@
+package ballot
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+const (
+	leaderLeaseKey = "reservation:ballot:leader"
+	leaderLease    = 2 * time.Minute
+	drawInterval   = 30 * time.Second
+)
+
+// BallotDrawer draws the ballot of an event once its application window closed.
+type BallotDrawer interface {
+	DrawBallot(ctx context.Context, eventID string) error
+}
+
+// Drawer walks the events with undrawn ballot applications and draws those whose window closed.
+// Only the replica holding the leader lease draws, so applications are settled one at a time.
+type Drawer struct {
+	repo     repositories.ReservationRepository
+	drawer   BallotDrawer
+	instance string
+}
+
+func NewDrawer(repo repositories.ReservationRepository, drawer BallotDrawer) *Drawer {
+	return &Drawer{
+		repo:     repo,
+		drawer:   drawer,
+		instance: uuid.New().String(),
+	}
+}
+
+// Start looks for ballots to draw on every interval until ctx is done.
+func (d *Drawer) Start(ctx context.Context) {
+	ticker := time.NewTicker(drawInterval)
+	defer ticker.Stop()
+
+	logger.InfoContext(ctx, "ballot drawer started", slog.String("instance", d.instance))
+
+	for {
+		select {
+		case <-ticker.C:
+			held, err := d.repo.RenewLease(ctx, leaderLeaseKey, d.instance, leaderLease)
+			if err != nil {
+				logger.ErrorContext(ctx, "renew ballot drawer lease failed", slog.Any("error", err))
+				continue
+			}
+			if !held {
+				continue
+			}
+			d.drawAll(ctx)
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "ballot drawer stopped")
+			return
+		}
+	}
+}
+
+func (d *Drawer) drawAll(ctx context.Context) {
+	eventIDs, err := d.repo.ListBallotEventIDs(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "list ballot events failed", slog.Any("error", err))
+		return
+	}
+
+	for _, eventID := range eventIDs {
+		// a failed draw resumes on the next interval
+		if err := d.drawer.DrawBallot(ctx, eventID); err != nil {
+			logger.ErrorContext(ctx, "ballot draw failed", slog.String("eventID", eventID), slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Package credential signs and verifies the tokens rendered as QR codes on tickets.
+// A token is the base64url encoded claims and their Ed25519 signature joined by a dot,
+// so door devices holding the public key can verify it without calling the service.
+package credential
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCredential is returned for tokens that are malformed or not signed by the service.
+var ErrInvalidCredential = errors.New("invalid credential")
+
+// Claims is what a credential vouches for. Version is the credential version of the ticket,
+// it is bumped on every transfer so tokens of previous owners stop matching.
+type Claims struct {
+	TicketID   string `json:"tid"`
+	EventID    string `json:"eid"`
+	ZoneNumber int32  `json:"z"`
+	RowNumber  int32  `json:"r"`
+	ColNumber  int32  `json:"c"`
+	OwnerID    string `json:"o"`
+	Version    int32  `json:"v"`
+}
+
+type Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from a base64 encoded 32 byte Ed25519 seed.
+func NewSigner(seed string) (*Signer, error) {
+	raw, err := base64.StdEncoding.DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signing key: %w", err)
+	}
+	if len(raw) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be %d bytes, got %d", ed25519.SeedSize, len(raw))
+	}
+
+	return &Signer{
+		privateKey: ed25519.NewKeyFromSeed(raw),
+	}, nil
+}
+
+// PublicKey returns the key that verifies the credentials of this signer.
+func (s *Signer) PublicKey() ed25519.PublicKey {
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
+
+func (s *Signer) Sign(claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(s.privateKey, []byte(encoded))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the signature of a token and returns its claims.
+func (s *Signer) Verify(token string) (Claims, error) {
+	encoded, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Claims{}, ErrInvalidCredential
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !ed25519.Verify(s.PublicKey(), []byte(encoded), signature) {
+		return Claims{}, ErrInvalidCredential
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Claims{}, ErrInvalidCredential
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, ErrInvalidCredential
+	}
+	return claims, nil
+}
@@ -0,0 +1,394 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+func toBallotApplicationProto(application *db.Ballotapplication) *reservationpb.BallotApplication {
+	applicationProto := &reservationpb.BallotApplication{
+		Id:            pgUUIDToString(application.ID),
+		EventId:       pgUUIDToString(application.EventID),
+		ZoneNumber:    application.ZoneNumber,
+		Quantity:      application.Quantity,
+		Status:        application.Status,
+		ReservationId: pgUUIDToString(application.ReservationID),
+		CreatedAt:     application.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if application.DrawPosition != nil {
+		applicationProto.DrawPosition = *application.DrawPosition
+	}
+	return applicationProto
+}
+
+// ballotWindow reads the application window of an event, ok is false when the event has no ballot.
+func ballotWindow(event *eventpb.Event) (opensAt, closesAt time.Time, ok bool, err error) {
+	if event.GetBallotOpensAt() == "" || event.GetBallotClosesAt() == "" {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	if opensAt, err = time.Parse(time.RFC3339, event.GetBallotOpensAt()); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	if closesAt, err = time.Parse(time.RFC3339, event.GetBallotClosesAt()); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	return opensAt, closesAt, true, nil
+}
+
+// checkBallotSaleOpen refuses reservations for a ballot event until its applications were all drawn.
+func (r *ReserveDomainImpl) checkBallotSaleOpen(ctx context.Context, event *eventpb.Event) error {
+	_, closesAt, ok, err := ballotWindow(event)
+	if err != nil {
+		return apperror.Internal("failed to read ballot window", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if time.Now().Before(closesAt) {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("tickets for this event are allocated by ballot, applications close at %s", closesAt.Format(time.RFC3339)))
+	}
+
+	pending, err := r.repo.CountAppliedBallotApplications(ctx, event.GetId())
+	if err != nil {
+		return apperror.Internal("failed to count ballot applications", err)
+	}
+	if pending > 0 {
+		return status.Error(codes.FailedPrecondition, "the ballot for this event is being drawn, the sale opens once it is done")
+	}
+	return nil
+}
+
+// ApplyBallot enters the user in the ballot of an event while its application window is open.
+func (r *ReserveDomainImpl) ApplyBallot(ctx context.Context, req *reservationpb.ApplyBallotRequest) (*reservationpb.ApplyBallotResponse, error) {
+	if _, err := uuid.Parse(req.GetEventId()); err != nil {
+		return nil, apperror.BadRequest("invalid event id", err)
+	}
+	if req.GetQuantity() <= 0 {
+		return nil, apperror.BadRequest("quantity must be positive", nil)
+	}
+
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: req.GetEventId(),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	opensAt, closesAt, ok, err := ballotWindow(event.GetEvent())
+	if err != nil {
+		return nil, apperror.Internal("failed to read ballot window", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "this event has no ballot")
+	}
+	now := time.Now()
+	if now.Before(opensAt) || !now.Before(closesAt) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("ballot applications are open from %s to %s",
+			opensAt.Format(time.RFC3339), closesAt.Format(time.RFC3339)))
+	}
+
+	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+		EventId: req.GetEventId(),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event zone", err)
+	}
+
+	found := false
+	for _, zone := range eventZone.GetList() {
+		if zone.GetZoneNumber() == req.GetZoneNumber() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, apperror.BadRequest("invalid zone number", nil)
+	}
+
+	if limit := event.GetEvent().GetMaxTicketsPerUser(); limit > 0 {
+		if err := r.checkTicketLimit(ctx, req.GetUserId(), req.GetEventId(), limit, int(req.GetQuantity())); err != nil {
+			return nil, err
+		}
+	}
+
+	application, err := r.repo.ApplyBallot(ctx, req.GetUserId(), req.GetEventId(), req.GetZoneNumber(), req.GetQuantity())
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlreadyApplied) {
+			return nil, status.Error(codes.AlreadyExists, "already applied to the ballot of this event")
+		}
+		logger.ErrorContext(ctx, "apply ballot failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to apply to ballot", err)
+	}
+
+	logger.InfoContext(ctx, "ballot application received",
+		slog.String("applicationID", pgUUIDToString(application.ID)),
+		slog.String("eventID", req.GetEventId()),
+		slog.Int("zone", int(req.GetZoneNumber())))
+
+	return &reservationpb.ApplyBallotResponse{
+		Application: toBallotApplicationProto(application),
+	}, nil
+}
+
+// WithdrawBallotApplication takes an application out of the ballot before it is drawn.
+func (r *ReserveDomainImpl) WithdrawBallotApplication(ctx context.Context, req *reservationpb.WithdrawBallotApplicationRequest) (*reservationpb.WithdrawBallotApplicationResponse, error) {
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, apperror.BadRequest("invalid ballot application id", err)
+	}
+
+	withdrawn, err := r.repo.WithdrawBallotApplication(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to withdraw ballot application", err)
+	}
+	if !withdrawn {
+		application, err := r.repo.GetBallotApplication(ctx, req.GetId())
+		if err != nil || pgUUIDToString(application.UserID) != req.GetUserId() {
+			return nil, apperror.NotFound("ballot application not found", err)
+		}
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("ballot application is %s", application.Status))
+	}
+
+	return &reservationpb.WithdrawBallotApplicationResponse{
+		Id: req.GetId(),
+	}, nil
+}
+
+// ListBallotApplications returns the ballot applications of a user with their outcome.
+func (r *ReserveDomainImpl) ListBallotApplications(ctx context.Context, req *reservationpb.ListBallotApplicationsRequest) (*reservationpb.ListBallotApplicationsResponse, error) {
+	applications, err := r.repo.ListBallotApplications(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to list ballot applications", err)
+	}
+
+	applicationsProto := make([]*reservationpb.BallotApplication, 0, len(applications))
+	for i := range applications {
+		applicationsProto = append(applicationsProto, toBallotApplicationProto(&applications[i]))
+	}
+
+	return &reservationpb.ListBallotApplicationsResponse{
+		Applications: applicationsProto,
+	}, nil
+}
+
+// GetBallotDraw returns the seed and outcome of the draw of an event, anyone can replay it with shuffleBallot.
+func (r *ReserveDomainImpl) GetBallotDraw(ctx context.Context, req *reservationpb.GetBallotDrawRequest) (*reservationpb.GetBallotDrawResponse, error) {
+	if _, err := uuid.Parse(req.GetEventId()); err != nil {
+		return nil, apperror.BadRequest("invalid event id", err)
+	}
+
+	draw, err := r.repo.GetBallotDraw(ctx, req.GetEventId())
+	if err != nil {
+		return nil, apperror.Internal("failed to get ballot draw", err)
+	}
+	if draw == nil {
+		return nil, status.Error(codes.NotFound, "the ballot of this event was not drawn yet")
+	}
+
+	applicationIDs := make([]string, 0, len(draw.ApplicationIds))
+	for _, id := range draw.ApplicationIds {
+		applicationIDs = append(applicationIDs, pgUUIDToString(id))
+	}
+
+	res := &reservationpb.GetBallotDrawResponse{
+		EventId:        req.GetEventId(),
+		Seed:           draw.Seed,
+		ApplicationIds: applicationIDs,
+		DrawnAt:        draw.DrawnAt.Time.Format(time.RFC3339),
+	}
+	if draw.CompletedAt.Valid {
+		res.CompletedAt = draw.CompletedAt.Time.Format(time.RFC3339)
+	}
+	return res, nil
+}
+
+// DrawBallot allocates the seats of an event to its ballot applications once the window closed.
+// The order is drawn once and stored with its seed before any seats are held, so an interrupted
+// draw resumes in the same order and skips the applications it already settled. Every application
+// in turn gets the best available seats of its zone, held for the payment window of the event, or
+// loses when they are gone.
+func (r *ReserveDomainImpl) DrawBallot(ctx context.Context, eventID string) error {
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// applications of an event whose ballot was removed are drawn right away
+	_, closesAt, ok, err := ballotWindow(event.GetEvent())
+	if err != nil {
+		return fmt.Errorf("failed to read ballot window: %w", err)
+	}
+	if ok && time.Now().Before(closesAt) {
+		return nil
+	}
+
+	draw, err := r.repo.GetBallotDraw(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get ballot draw: %w", err)
+	}
+	if draw == nil {
+		applicationIDs, err := r.repo.ListAppliedBallotApplicationIDs(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("failed to list ballot applications: %w", err)
+		}
+
+		seed := rand.Int64()
+		if draw, err = r.repo.CreateBallotDraw(ctx, eventID, seed, shuffleBallot(applicationIDs, seed)); err != nil {
+			return fmt.Errorf("failed to store ballot draw: %w", err)
+		}
+
+		logger.InfoContext(ctx, "ballot drawn",
+			slog.String("eventID", eventID),
+			slog.Int64("seed", draw.Seed),
+			slog.Int("applications", len(draw.ApplicationIds)))
+	}
+
+	paymentWindow := time.Duration(event.GetEvent().GetBallotPaymentHours()) * time.Hour
+	if paymentWindow == 0 {
+		// the ballot was removed along with its payment window
+		paymentWindow = ResevationMax
+	}
+	var won, lost int
+	for i, id := range draw.ApplicationIds {
+		application, err := r.repo.GetBallotApplication(ctx, pgUUIDToString(id))
+		if err != nil {
+			return fmt.Errorf("failed to get ballot application: %w", err)
+		}
+		if application.Status != string(entities.BallotApplied) {
+			continue
+		}
+
+		allocated, err := r.allocateBallotApplication(ctx, application, int32(i+1), paymentWindow)
+		if err != nil {
+			return err
+		}
+		if allocated {
+			won++
+		} else {
+			lost++
+		}
+	}
+
+	if err := r.repo.CompleteBallotDraw(ctx, eventID); err != nil {
+		return fmt.Errorf("failed to complete ballot draw: %w", err)
+	}
+
+	logger.InfoContext(ctx, "ballot draw completed",
+		slog.String("eventID", eventID),
+		slog.Int("won", won),
+		slog.Int("lost", lost))
+	return nil
+}
+
+// allocateBallotApplication holds seats for the application at position in the draw and notifies the
+// applicant of the outcome. Only failures worth retrying are returned, the application stays APPLIED.
+func (r *ReserveDomainImpl) allocateBallotApplication(ctx context.Context, application *db.Ballotapplication, position int32, paymentWindow time.Duration) (bool, error) {
+	applicationID := pgUUIDToString(application.ID)
+	userID := pgUUIDToString(application.UserID)
+	eventID := pgUUIDToString(application.EventID)
+
+	res, err := r.reserveBestAvailable(ctx, &reservationpb.ReserveBestAvailableRequest{
+		UserId:     userID,
+		EventId:    eventID,
+		ZoneNumber: application.ZoneNumber,
+		Quantity:   application.Quantity,
+	}, holdOptions{ttl: paymentWindow + SafetyBuffer, ballot: true})
+	if err != nil {
+		if retryableBallotError(err) {
+			return false, fmt.Errorf("failed to reserve for ballot application %s: %w", applicationID, err)
+		}
+
+		message, err2 := outbox.NewMessage(repositories.NotificationsQueue, "ballot.lost", entities.LostNotiBallot{
+			ID:         applicationID,
+			UserID:     userID,
+			EventID:    eventID,
+			ZoneNumber: application.ZoneNumber,
+			Quantity:   application.Quantity,
+			Reason:     ballotLossReason(err),
+		})
+		if err2 != nil {
+			return false, err2
+		}
+		if err2 := r.repo.SettleBallotApplication(ctx, applicationID, entities.BallotLost, "", position, message); err2 != nil {
+			return false, fmt.Errorf("failed to settle ballot application %s: %w", applicationID, err2)
+		}
+		return false, nil
+	}
+
+	message, err := outbox.NewMessage(repositories.NotificationsQueue, "ballot.won", entities.WonNotiBallot{
+		ID:              applicationID,
+		ReservationID:   res.GetId(),
+		UserID:          userID,
+		EventID:         eventID,
+		ZoneNumber:      application.ZoneNumber,
+		Quantity:        application.Quantity,
+		PaymentDeadline: time.Now().Add(paymentWindow).Format(time.RFC3339),
+	})
+	if err == nil {
+		err = r.repo.SettleBallotApplication(ctx, applicationID, entities.BallotWon, res.GetId(), position, message)
+	}
+	if err != nil {
+		// the seats go back so the retry holds them again
+		rollbackReservation(ctx, r.repo, userID, eventID, res.GetId(), convertSeatsToSeatInfo(res.GetSeats()))
+		return false, fmt.Errorf("failed to settle ballot application %s: %w", applicationID, err)
+	}
+
+	logger.InfoContext(ctx, "ballot application won",
+		slog.String("applicationID", applicationID),
+		slog.String("reservationID", res.GetId()),
+		slog.Int("position", int(position)))
+	return true, nil
+}
+
+// shuffleBallot returns the application ids in draw order. The ids are sorted and shuffled with a PCG
+// generator seeded by seed, so the stored seed and applications reproduce the draw.
+func shuffleBallot(applicationIDs []string, seed int64) []string {
+	order := make([]string, len(applicationIDs))
+	copy(order, applicationIDs)
+	slices.Sort(order)
+
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	return order
+}
+
+// retryableBallotError reports failures of the service itself rather than of the application.
+func retryableBallotError(err error) bool {
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code >= http.StatusInternalServerError
+	}
+	code := status.Code(err)
+	return code == codes.Internal || code == codes.Unknown || code == codes.Unavailable
+}
+
+func ballotLossReason(err error) string {
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Message
+	}
+	return status.Convert(err).Message()
+}
@@ -0,0 +1,257 @@
+package domains
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	locationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/location"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// bestAvailableAttempts bounds the retries when another buyer takes a picked seat first.
+const bestAvailableAttempts = 3
+
+func (r *ReserveDomainImpl) ReserveBestAvailable(ctx context.Context, req *reservationpb.ReserveBestAvailableRequest) (*reservationpb.ReserveBestAvailableResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, apperror.BadRequest("user ID required", nil)
+	}
+	if req.GetEventId() == "" {
+		return nil, apperror.BadRequest("event ID required", nil)
+	}
+	if req.GetQuantity() <= 0 {
+		return nil, apperror.BadRequest("quantity must be positive", nil)
+	}
+
+	return r.reserveBestAvailable(ctx, req, holdOptions{})
+}
+
+// reserveBestAvailable picks the best free seats of the zone and holds them with opts.
+func (r *ReserveDomainImpl) reserveBestAvailable(ctx context.Context, req *reservationpb.ReserveBestAvailableRequest, opts holdOptions) (*reservationpb.ReserveBestAvailableResponse, error) {
+	zone, err := r.getZoneLayout(ctx, req.GetEventId(), req.GetZoneNumber())
+	if err != nil {
+		return nil, err
+	}
+	if zone.GetZoneType() == string(entities.ZoneGeneralAdmission) {
+		return r.reserveGeneralAdmission(ctx, req, opts)
+	}
+	if req.GetContiguous() && req.GetQuantity() > zone.GetSeatsPerRow() {
+		return nil, apperror.BadRequest("quantity does not fit in one row", nil)
+	}
+
+	eventSeats, err := r.repo.GetAllEventSeats(ctx, req.GetEventId())
+	if err != nil {
+		return nil, apperror.Internal("failed to get event seats", err)
+	}
+
+	taken := make(map[repositories.SeatInfo]struct{}, len(eventSeats))
+	for _, seat := range eventSeats {
+		if seat.ZoneNumber != req.GetZoneNumber() {
+			continue
+		}
+		taken[repositories.SeatInfo{ZoneNumber: seat.ZoneNumber, RowNumber: seat.RowNumber, ColNumber: seat.ColNumber}] = struct{}{}
+	}
+
+	for attempt := 1; attempt <= bestAvailableAttempts; attempt++ {
+		seats := pickBestAvailable(zone, taken, int(req.GetQuantity()), req.GetContiguous())
+		if seats == nil {
+			return nil, apperror.BadRequest("not enough seats available in this zone", nil)
+		}
+
+		requested := make([]*reservationpb.CreateReservationSeatRequest, len(seats))
+		for i, seat := range seats {
+			requested[i] = &reservationpb.CreateReservationSeatRequest{
+				ZoneNumber: seat.ZoneNumber,
+				Row:        seat.RowNumber,
+				Column:     seat.ColNumber,
+			}
+		}
+
+		res, err := r.reserve(ctx, &reservationpb.CreateReservationRequest{
+			UserId:     req.GetUserId(),
+			EventId:    req.GetEventId(),
+			Seats:      requested,
+			PromoCodes: req.GetPromoCodes(),
+		}, opts)
+		if err == nil {
+			return &reservationpb.ReserveBestAvailableResponse{
+				Id:    res.GetId(),
+				Seats: requested,
+			}, nil
+		}
+
+		conflicts, ok := seatConflicts(err)
+		if !ok {
+			return nil, err
+		}
+
+		// another buyer was faster, pick again around the seats that are gone now
+		logger.InfoContext(ctx, "best available seats taken, picking again",
+			slog.String("eventID", req.GetEventId()),
+			slog.Int("attempt", attempt),
+			slog.Int("conflicts", len(conflicts)))
+		for _, seat := range conflicts {
+			taken[seat] = struct{}{}
+		}
+	}
+
+	return nil, status.Error(codes.AlreadyExists, "seats are selling fast, please try again")
+}
+
+// reserveGeneralAdmission holds unnumbered tickets, there is nothing to pick in a standing zone.
+func (r *ReserveDomainImpl) reserveGeneralAdmission(ctx context.Context, req *reservationpb.ReserveBestAvailableRequest, opts holdOptions) (*reservationpb.ReserveBestAvailableResponse, error) {
+	res, err := r.reserve(ctx, &reservationpb.CreateReservationRequest{
+		UserId:  req.GetUserId(),
+		EventId: req.GetEventId(),
+		GeneralAdmission: []*reservationpb.GeneralAdmissionRequest{
+			{ZoneNumber: req.GetZoneNumber(), Quantity: req.GetQuantity()},
+		},
+		PromoCodes: req.GetPromoCodes(),
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	seats := make([]*reservationpb.CreateReservationSeatRequest, req.GetQuantity())
+	for i := range seats {
+		seats[i] = &reservationpb.CreateReservationSeatRequest{ZoneNumber: req.GetZoneNumber()}
+	}
+	return &reservationpb.ReserveBestAvailableResponse{
+		Id:    res.GetId(),
+		Seats: seats,
+	}, nil
+}
+
+// reserve runs a reservation built here through the regular flow, so limits, pricing and the hold are shared.
+// There is no idempotency key to honour on this path.
+func (r *ReserveDomainImpl) reserve(ctx context.Context, req *reservationpb.CreateReservationRequest, opts holdOptions) (*reservationpb.CreateReservationResponse, error) {
+	if err := validateReservationRequest(req); err != nil {
+		return nil, err
+	}
+	return r.createReservation(ctx, req, opts)
+}
+
+// getZoneLayout looks up the rows and seats per row of a zone at the venue of the event.
+func (r *ReserveDomainImpl) getZoneLayout(ctx context.Context, eventID string, zoneNumber int32) (*locationpb.Zone, error) {
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	location, err := r.locationClient.GetLocation(ctx, &locationpb.GetLocationRequest{
+		Id: event.GetEvent().GetLocationId(),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get location", err)
+	}
+
+	for _, zone := range location.GetZones() {
+		if zone.GetZoneNumber() == zoneNumber {
+			return zone, nil
+		}
+	}
+	return nil, apperror.BadRequest("invalid zone number", nil)
+}
+
+// pickBestAvailable returns quantity free seats of the zone, or nil if there are not enough.
+// Front rows win over central columns. Rows and columns are numbered from 1, row 1 is the front.
+func pickBestAvailable(zone *locationpb.Zone, taken map[repositories.SeatInfo]struct{}, quantity int, contiguous bool) []repositories.SeatInfo {
+	rows := zone.GetNumberOfRows()
+	cols := zone.GetSeatsPerRow()
+	// doubled to stay in integers, the center of a row with an even number of seats lies between two seats
+	center := int(cols) + 1
+
+	seatAt := func(row, col int32) repositories.SeatInfo {
+		return repositories.SeatInfo{ZoneNumber: zone.GetZoneNumber(), RowNumber: row, ColNumber: col}
+	}
+	isFree := func(row, col int32) bool {
+		_, ok := taken[seatAt(row, col)]
+		return !ok
+	}
+
+	if contiguous {
+		for row := int32(1); row <= rows; row++ {
+			bestStart, bestDistance := int32(0), 0
+			run := 0
+			for col := int32(1); col <= cols; col++ {
+				if !isFree(row, col) {
+					run = 0
+					continue
+				}
+				run++
+				if run < quantity {
+					continue
+				}
+
+				start := col - int32(quantity) + 1
+				distance := abs(int(start+col) - center)
+				if bestStart == 0 || distance < bestDistance {
+					bestStart, bestDistance = start, distance
+				}
+			}
+
+			if bestStart != 0 {
+				seats := make([]repositories.SeatInfo, quantity)
+				for i := range seats {
+					seats[i] = seatAt(row, bestStart+int32(i))
+				}
+				return seats
+			}
+		}
+		return nil
+	}
+
+	var free []repositories.SeatInfo
+	for row := int32(1); row <= rows; row++ {
+		for col := int32(1); col <= cols; col++ {
+			if isFree(row, col) {
+				free = append(free, seatAt(row, col))
+			}
+		}
+	}
+	if len(free) < quantity {
+		return nil
+	}
+
+	sort.SliceStable(free, func(i, j int) bool {
+		if free[i].RowNumber != free[j].RowNumber {
+			return free[i].RowNumber < free[j].RowNumber
+		}
+		return abs(2*int(free[i].ColNumber)-center) < abs(2*int(free[j].ColNumber)-center)
+	})
+	return free[:quantity]
+}
+
+// seatConflicts reads the seats carried by a seatConflictError.
+func seatConflicts(err error) ([]repositories.SeatInfo, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.AlreadyExists {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		conflict, ok := detail.(*reservationpb.SeatConflict)
+		if !ok {
+			continue
+		}
+		return convertSeatsToSeatInfo(conflict.GetSeats()), true
+	}
+	return nil, false
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,240 @@
+package domains
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/credential"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// maxUploadScans bounds the scans a door device uploads at once.
+const maxUploadScans = 1000
+
+// verifiedScan is an uploaded scan whose credential was verified, index is its position in the upload.
+type verifiedScan struct {
+	index     int
+	claims    credential.Claims
+	gateID    string
+	scannedAt time.Time
+}
+
+func checkedInGate(ticket *db.Ticket) string {
+	if ticket.CheckedInGate == nil {
+		return ""
+	}
+	return *ticket.CheckedInGate
+}
+
+// CheckInTicket admits the holder of a scanned credential. The ticket is marked checked in atomically,
+// so a second scan of the same ticket, at any gate, is refused with the gate and time of the first one.
+func (r *ReserveDomainImpl) CheckInTicket(ctx context.Context, req *reservationpb.CheckInTicketRequest) (*reservationpb.CheckInTicketResponse, error) {
+	if req.GetGateId() == "" {
+		return nil, apperror.BadRequest("gate is required", nil)
+	}
+
+	claims, err := r.signer.Verify(req.GetCredential())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid credential")
+	}
+	if claims.EventID != req.GetEventId() {
+		return nil, status.Error(codes.FailedPrecondition, "credential is for another event")
+	}
+
+	ticket, err := r.repo.CheckInTicket(ctx, claims.TicketID, claims.OwnerID, claims.Version, req.GetGateId())
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrTicketCheckedIn):
+			return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("ticket was checked in at gate %s at %s",
+				checkedInGate(ticket), ticket.CheckedInAt.Time.Format(time.RFC3339)))
+		case errors.Is(err, repositories.ErrTicketNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "ticket was given back")
+		case errors.Is(err, repositories.ErrCredentialSuperseded):
+			return nil, status.Error(codes.FailedPrecondition, "credential was replaced by a transfer")
+		case errors.Is(err, repositories.ErrTicketNotPaid):
+			return nil, status.Error(codes.FailedPrecondition, "ticket is not paid")
+		}
+		logger.ErrorContext(ctx, "check in ticket failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to check in ticket", err)
+	}
+
+	logger.InfoContext(ctx, "ticket checked in",
+		slog.String("ticketID", claims.TicketID),
+		slog.String("gateID", req.GetGateId()))
+
+	return &reservationpb.CheckInTicketResponse{
+		TicketId:             pgUUIDToString(ticket.ID),
+		EventId:              pgUUIDToString(ticket.EventID),
+		ZoneNumber:           ticket.ZoneNumber,
+		Row:                  ticket.RowNumber,
+		Column:               ticket.ColNumber,
+		Status:               string(entities.TicketCheckedIn),
+		CheckedInAt:          ticket.CheckedInAt.Time.Format(time.RFC3339),
+		GateId:               req.GetGateId(),
+		TicketTypeId:         pgUUIDToString(ticket.TicketTypeID),
+		RequiresVerification: ticket.RequiresVerification,
+	}, nil
+}
+
+// GetScanBundle returns what door devices need to admit the tickets of an event while offline: the key
+// that verifies credentials and the paid tickets with their credential version and revocation status.
+func (r *ReserveDomainImpl) GetScanBundle(ctx context.Context, req *reservationpb.GetScanBundleRequest) (*reservationpb.GetScanBundleResponse, error) {
+	eventID := req.GetEventId()
+	if _, err := uuid.Parse(eventID); err != nil {
+		return nil, apperror.BadRequest("invalid event id", err)
+	}
+
+	// taken before the tickets are read, changes after it are left to the next bundle
+	generatedAt := time.Now()
+
+	tickets, err := r.repo.ListEventScanTickets(ctx, eventID)
+	if err != nil {
+		return nil, apperror.Internal("failed to list event tickets", err)
+	}
+
+	bundleTickets := make([]*reservationpb.ScanBundleTicket, 0, len(tickets))
+	for _, ticket := range tickets {
+		bundleTicket := &reservationpb.ScanBundleTicket{
+			TicketId:             pgUUIDToString(ticket.ID),
+			CredentialVersion:    ticket.CredentialVersion,
+			Revoked:              ticket.Revoked,
+			RequiresVerification: ticket.RequiresVerification,
+		}
+		if ticket.CheckedInAt.Valid {
+			bundleTicket.CheckedInAt = ticket.CheckedInAt.Time.Format(time.RFC3339Nano)
+		}
+		bundleTickets = append(bundleTickets, bundleTicket)
+	}
+
+	return &reservationpb.GetScanBundleResponse{
+		EventId:     eventID,
+		PublicKey:   base64.StdEncoding.EncodeToString(r.signer.PublicKey()),
+		GeneratedAt: generatedAt.Format(time.RFC3339Nano),
+		Tickets:     bundleTickets,
+	}, nil
+}
+
+// UploadScans records the scan log of a door device that admitted tickets offline. Scans of a ticket,
+// from any gate and any upload, are resolved by their timestamp: the earliest admits the ticket and the
+// others are reported as conflicts. Uploading a log again does not change the outcome.
+func (r *ReserveDomainImpl) UploadScans(ctx context.Context, req *reservationpb.UploadScansRequest) (*reservationpb.UploadScansResponse, error) {
+	if _, err := uuid.Parse(req.GetEventId()); err != nil {
+		return nil, apperror.BadRequest("invalid event id", err)
+	}
+
+	scans := req.GetScans()
+	if len(scans) == 0 {
+		return nil, apperror.BadRequest("no scans to upload", nil)
+	}
+	if len(scans) > maxUploadScans {
+		return nil, apperror.BadRequest(fmt.Sprintf("at most %d scans can be uploaded at once", maxUploadScans), nil)
+	}
+
+	results := make([]*reservationpb.ScanResult, len(scans))
+	verified := make([]verifiedScan, 0, len(scans))
+	for i, scan := range scans {
+		results[i] = &reservationpb.ScanResult{
+			GateId:    scan.GetGateId(),
+			ScannedAt: scan.GetScannedAt(),
+			Status:    string(entities.ScanInvalid),
+		}
+
+		claims, err := r.signer.Verify(scan.GetCredential())
+		if err != nil || claims.EventID != req.GetEventId() {
+			continue
+		}
+		results[i].TicketId = claims.TicketID
+
+		scannedAt, err := time.Parse(time.RFC3339Nano, scan.GetScannedAt())
+		if err != nil || scan.GetGateId() == "" {
+			continue
+		}
+
+		verified = append(verified, verifiedScan{
+			index:  i,
+			claims: claims,
+			gateID: scan.GetGateId(),
+			// the database keeps microseconds, an upload repeated must compare equal
+			scannedAt: scannedAt.Truncate(time.Microsecond),
+		})
+	}
+
+	// scans are recorded earliest first, so a later scan of a ticket finds it checked in
+	sort.SliceStable(verified, func(i, j int) bool {
+		return verified[i].scannedAt.Before(verified[j].scannedAt)
+	})
+
+	conflicts := make([]*reservationpb.ScanConflict, 0)
+	for _, scan := range verified {
+		result := results[scan.index]
+
+		ticket, previous, err := r.repo.RecordOfflineCheckIn(ctx, repositories.OfflineScan{
+			TicketID:          scan.claims.TicketID,
+			OwnerID:           scan.claims.OwnerID,
+			CredentialVersion: scan.claims.Version,
+			GateID:            scan.gateID,
+			ScannedAt:         scan.scannedAt,
+		})
+		switch {
+		case err == nil:
+			result.Status = string(entities.ScanAdmitted)
+			if previous.CheckedInAt.Valid {
+				conflicts = append(conflicts, &reservationpb.ScanConflict{
+					TicketId:       scan.claims.TicketID,
+					AdmittedGateId: scan.gateID,
+					AdmittedAt:     scan.scannedAt.Format(time.RFC3339Nano),
+					RejectedGateId: checkedInGate(previous),
+					RejectedAt:     previous.CheckedInAt.Time.Format(time.RFC3339Nano),
+				})
+			}
+		case errors.Is(err, repositories.ErrTicketCheckedIn):
+			if checkedInGate(ticket) == scan.gateID && ticket.CheckedInAt.Time.Equal(scan.scannedAt) {
+				// uploaded before
+				result.Status = string(entities.ScanAdmitted)
+				continue
+			}
+			result.Status = string(entities.ScanDuplicate)
+			conflicts = append(conflicts, &reservationpb.ScanConflict{
+				TicketId:       scan.claims.TicketID,
+				AdmittedGateId: checkedInGate(ticket),
+				AdmittedAt:     ticket.CheckedInAt.Time.Format(time.RFC3339Nano),
+				RejectedGateId: scan.gateID,
+				RejectedAt:     scan.scannedAt.Format(time.RFC3339Nano),
+			})
+		case errors.Is(err, repositories.ErrTicketNotFound):
+			result.Status = string(entities.ScanRevoked)
+		case errors.Is(err, repositories.ErrCredentialSuperseded):
+			result.Status = string(entities.ScanSuperseded)
+		case errors.Is(err, repositories.ErrTicketNotPaid):
+			result.Status = string(entities.ScanUnpaid)
+		default:
+			// scans recorded so far stay, the device uploads the log again
+			logger.ErrorContext(ctx, "record offline check in failed", slog.Any("error", err))
+			return nil, apperror.Internal("failed to record offline scans", err)
+		}
+	}
+
+	logger.InfoContext(ctx, "offline scans uploaded",
+		slog.String("eventID", req.GetEventId()),
+		slog.Int("scans", len(scans)),
+		slog.Int("conflicts", len(conflicts)))
+
+	return &reservationpb.UploadScansResponse{
+		Results:   results,
+		Conflicts: conflicts,
+	}, nil
+}
@@ -4,31 +4,64 @@ import (
 	"context"
 
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	locationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/location"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/config"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/credential"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
 )
 
 type ReserveDomain interface {
 	CreateReservation(ctx context.Context, req *reservationpb.CreateReservationRequest) (*reservationpb.CreateReservationResponse, error)
+	ReserveBestAvailable(ctx context.Context, req *reservationpb.ReserveBestAvailableRequest) (*reservationpb.ReserveBestAvailableResponse, error)
 	DeleteReservation(ctx context.Context, req *reservationpb.DeleteReservationRequest) (*reservationpb.DeleteReservationResponse, error)
 	GetReservation(ctx context.Context, req *reservationpb.GetReservationRequest) (*reservationpb.GetReservationResponse, error)
 	ListReservation(ctx context.Context, req *reservationpb.ListReservationRequest) (*reservationpb.ListReservationResponse, error)
 	ConfirmReservation(ctx context.Context, req *reservationpb.ConfirmReservationRequest) (*reservationpb.ConfirmReservationResponse, error)
+	ExtendReservation(ctx context.Context, req *reservationpb.ExtendReservationRequest) (*reservationpb.ExtendReservationResponse, error)
+	RefundReservation(ctx context.Context, req *reservationpb.RefundReservationRequest) (*reservationpb.RefundReservationResponse, error)
+	CancelTickets(ctx context.Context, req *reservationpb.CancelTicketsRequest) (*reservationpb.CancelTicketsResponse, error)
+	ListTickets(ctx context.Context, req *reservationpb.ListTicketsRequest) (*reservationpb.ListTicketsResponse, error)
+	OfferTicketTransfer(ctx context.Context, req *reservationpb.OfferTicketTransferRequest) (*reservationpb.OfferTicketTransferResponse, error)
+	AcceptTicketTransfer(ctx context.Context, req *reservationpb.AcceptTicketTransferRequest) (*reservationpb.AcceptTicketTransferResponse, error)
+	ListTicketTransfers(ctx context.Context, req *reservationpb.ListTicketTransfersRequest) (*reservationpb.ListTicketTransfersResponse, error)
+	CheckInTicket(ctx context.Context, req *reservationpb.CheckInTicketRequest) (*reservationpb.CheckInTicketResponse, error)
+	GetScanBundle(ctx context.Context, req *reservationpb.GetScanBundleRequest) (*reservationpb.GetScanBundleResponse, error)
+	UploadScans(ctx context.Context, req *reservationpb.UploadScansRequest) (*reservationpb.UploadScansResponse, error)
+	JoinWaitlist(ctx context.Context, req *reservationpb.JoinWaitlistRequest) (*reservationpb.JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, req *reservationpb.LeaveWaitlistRequest) (*reservationpb.LeaveWaitlistResponse, error)
+	ListWaitlistEntries(ctx context.Context, req *reservationpb.ListWaitlistEntriesRequest) (*reservationpb.ListWaitlistEntriesResponse, error)
+	OfferReleasedSeats(ctx context.Context, eventID, reservationID string, seats []repositories.SeatInfo, sold bool) []repositories.SeatInfo
+	ApplyBallot(ctx context.Context, req *reservationpb.ApplyBallotRequest) (*reservationpb.ApplyBallotResponse, error)
+	WithdrawBallotApplication(ctx context.Context, req *reservationpb.WithdrawBallotApplicationRequest) (*reservationpb.WithdrawBallotApplicationResponse, error)
+	ListBallotApplications(ctx context.Context, req *reservationpb.ListBallotApplicationsRequest) (*reservationpb.ListBallotApplicationsResponse, error)
+	GetBallotDraw(ctx context.Context, req *reservationpb.GetBallotDrawRequest) (*reservationpb.GetBallotDrawResponse, error)
+	DrawBallot(ctx context.Context, eventID string) error
+	CreatePromotion(ctx context.Context, req *reservationpb.CreatePromotionRequest) (*reservationpb.CreatePromotionResponse, error)
+	ListPromotions(ctx context.Context, req *reservationpb.ListPromotionsRequest) (*reservationpb.ListPromotionsResponse, error)
+	DeactivatePromotion(ctx context.Context, req *reservationpb.DeactivatePromotionRequest) (*reservationpb.DeactivatePromotionResponse, error)
 	GetReservationByStripeSessionID(ctx context.Context, req *reservationpb.GetReservationByStripeSessionIDRequest) (*reservationpb.GetReservationResponse, error)
+	GetZonePricing(ctx context.Context, req *reservationpb.GetZonePricingRequest) (*reservationpb.GetZonePricingResponse, error)
 }
 
 type ReserveDomainImpl struct {
 	reservationpb.UnimplementedReservationServiceServer
-	stripe      config.StripeConfig
-	repo        repositories.ReservationRepository
-	eventClient eventpb.EventServiceClient
+	stripe         config.StripeConfig
+	repo           repositories.ReservationRepository
+	eventClient    eventpb.EventServiceClient
+	locationClient locationpb.LocationServiceClient
+	signer         *credential.Signer
+	fees           *fees.Calculator
 }
 
-func New(repo repositories.ReservationRepository, stripe config.StripeConfig, eventClient eventpb.EventServiceClient) *ReserveDomainImpl {
+func New(repo repositories.ReservationRepository, stripe config.StripeConfig, eventClient eventpb.EventServiceClient, locationClient locationpb.LocationServiceClient, signer *credential.Signer, fees *fees.Calculator) *ReserveDomainImpl {
 	return &ReserveDomainImpl{
-		repo:        repo,
-		stripe:      stripe,
-		eventClient: eventClient,
+		repo:           repo,
+		stripe:         stripe,
+		eventClient:    eventClient,
+		locationClient: locationClient,
+		signer:         signer,
+		fees:           fees,
 	}
 }
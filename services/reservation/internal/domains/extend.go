@@ -0,0 +1,167 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stripe/stripe-go/v83"
+	"github.com/stripe/stripe-go/v83/checkout/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// ExtendReservation gives the owner of a pending hold more time to pay, once per hold. Stripe does not move
+// the expiry of an open session, so the extended hold is paid on a new one and the old one is expired.
+func (r *ReserveDomainImpl) ExtendReservation(ctx context.Context, req *reservationpb.ExtendReservationRequest) (*reservationpb.ExtendReservationResponse, error) {
+	reservationID := req.GetId()
+
+	reservation, err := r.repo.GetReservation(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.NotFound("reservation not found", err)
+	}
+
+	userID := pgUUIDToString(reservation.UserID)
+	eventID := pgUUIDToString(reservation.EventID)
+
+	if userID != req.GetUserId() {
+		return nil, apperror.Forbidden("reservation belongs to another user", nil)
+	}
+	if reservation.Status != string(entities.Pending) {
+		return nil, status.Error(codes.FailedPrecondition, "only pending reservations can be extended")
+	}
+
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	maxExtension := time.Duration(event.GetEvent().GetMaxHoldExtensionSeconds()) * time.Second
+	if maxExtension <= 0 {
+		return nil, apperror.BadRequest("holds can not be extended for this event", nil)
+	}
+
+	extension := maxExtension
+	if req.Seconds != nil {
+		extension = time.Duration(req.GetSeconds()) * time.Second
+		if extension <= 0 || extension > maxExtension {
+			return nil, apperror.BadRequest(fmt.Sprintf("extension must be between 1 and %d seconds", int(maxExtension.Seconds())), nil)
+		}
+	}
+
+	timeLeft, err := r.repo.GetReservationTimeLeft(ctx, userID, reservationID)
+	if err != nil || timeLeft <= SafetyBuffer {
+		return nil, status.Error(codes.FailedPrecondition, "reservation hold expired")
+	}
+
+	seats, err := r.repo.GetReservationSeats(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.Internal("failed to get reservation seats", err)
+	}
+
+	items, err := r.repo.ListReservationLineItems(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.Internal("failed to get reservation line items", err)
+	}
+	lineItems := make([]repositories.LineItem, len(items))
+	for i, item := range items {
+		lineItems[i] = fromLineItemRow(item)
+	}
+
+	checkout, err := r.newCheckoutSession(reservationID, reservation.Currency, reservationBreakdown(reservation), lineItems, time.Now().Add(timeLeft+extension))
+	if err != nil {
+		return nil, apperror.Internal("failed to create Stripe session", err)
+	}
+
+	// the reservation moves to the new session before the hold is extended, so whatever fails below it
+	// keeps a session that can be paid and a failed extension can be tried again
+	if err := r.repo.ReplaceStripeSession(ctx, reservationID, checkout.ID); err != nil {
+		discardCheckoutSession(ctx, checkout)
+		if errors.Is(err, repositories.ErrReservationNotPending) {
+			return nil, status.Error(codes.FailedPrecondition, "only pending reservations can be extended")
+		}
+		logger.ErrorContext(ctx, "replace stripe session failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to extend reservation", err)
+	}
+
+	if err := r.expirePreviousSession(ctx, reservationID, reservation.StripeSessionID); err != nil {
+		// the old session is still open, move the reservation back to it
+		if err := r.repo.ReplaceStripeSession(ctx, reservationID, reservation.StripeSessionID); err != nil {
+			logger.ErrorContext(ctx, "restore stripe session failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+		}
+		discardCheckoutSession(ctx, checkout)
+		return nil, err
+	}
+
+	ttl, err := r.repo.ExtendHold(ctx, userID, reservationID, eventID, seats, extension)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrHoldAlreadyExtended):
+			return nil, status.Error(codes.FailedPrecondition, "reservation was extended already")
+		case errors.Is(err, repositories.ErrHoldExpired), errors.Is(err, repositories.ErrHoldSeatLost):
+			return nil, status.Error(codes.FailedPrecondition, "reservation hold expired")
+		}
+		logger.ErrorContext(ctx, "extend hold failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to extend reservation", err)
+	}
+
+	if err := r.repo.RescheduleHoldExpiry(ctx, reservationID, time.Now().Add(ttl)); err != nil {
+		// the sweeper reschedules holds it finds with time left, so this only delays the sweep
+		logger.ErrorContext(ctx, "reschedule hold expiry failed", slog.Any("error", err))
+	}
+
+	newTimeLeft := holdTimeLeft(ttl)
+
+	extended, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.extended", entities.ExtendedNotiReservation{
+		ID:       reservationID,
+		UserID:   userID,
+		EventID:  eventID,
+		TimeLeft: newTimeLeft,
+	})
+	if err == nil {
+		err = r.repo.PublishOutboxMessages(ctx, extended)
+	}
+	if err != nil {
+		// the hold is extended, the buyer sees the new time on the next GetReservation
+		logger.ErrorContext(ctx, "publish reservation extended failed", slog.Any("error", err))
+	}
+
+	logger.InfoContext(ctx, "reservation extended",
+		slog.String("reservationID", reservationID),
+		slog.Duration("extension", extension),
+		slog.Float64("timeLeft", newTimeLeft))
+
+	return &reservationpb.ExtendReservationResponse{
+		Id:                 reservationID,
+		TimeLeft:           newTimeLeft,
+		StripeClientSecret: checkout.ClientSecret,
+	}, nil
+}
+
+// expirePreviousSession expires the session a reservation was moved off and deletes its coupon. Stripe
+// refuses to expire a session that was paid meanwhile, that payment still confirms the reservation.
+func (r *ReserveDomainImpl) expirePreviousSession(ctx context.Context, reservationID, sessionID string) error {
+	previous, err := session.Expire(sessionID, nil)
+	if err == nil {
+		deleteCheckoutCoupons(ctx, previous)
+		return nil
+	}
+
+	logger.WarnContext(ctx, "expire previous checkout session failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+	if checkout, getErr := session.Get(sessionID, nil); getErr == nil && checkout.Status == stripe.CheckoutSessionStatusComplete {
+		return status.Error(codes.FailedPrecondition, "reservation is being paid")
+	}
+	return apperror.Internal("failed to expire Stripe session", err)
+}
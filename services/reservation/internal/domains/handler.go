@@ -2,10 +2,14 @@ package domains
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,13 +17,22 @@ import (
 
 	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
 	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
-	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
 	"github.com/stripe/stripe-go/v83"
 	"github.com/stripe/stripe-go/v83/checkout/session"
+	"github.com/stripe/stripe-go/v83/coupon"
+	"github.com/stripe/stripe-go/v83/refund"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -28,24 +41,114 @@ const (
 	ReservationTTL = ResevationMax + SafetyBuffer
 )
 
-func (r *ReserveDomainImpl) CreateReservation(ctx context.Context, req *reservationpb.CreateReservationRequest) (*reservationpb.CreateReservationResponse, error) {
-	var err error
-	var reservationID string
-	var seats []repositories.SeatInfo
+// Stripe takes checkout expiries between 30 minutes and 24 hours after the session is created, the minimum
+// keeps a minute for the round trip.
+const (
+	minCheckoutExpiry = 31 * time.Minute
+	maxCheckoutExpiry = 24 * time.Hour
+)
 
-	// rollback purpose
-	defer func() {
-		if err != nil {
-			rollbackReservation(ctx, r.repo, req.GetUserId(), req.GetEventId(), reservationID, seats)
-		}
-	}()
+// holdOptions changes how createReservation holds seats, the zero value is a regular sale.
+type holdOptions struct {
+	// ttl replaces ReservationTTL when set
+	ttl time.Duration
+	// ballot lets the ballot draw reserve for its winners before the public sale opens
+	ballot bool
+}
 
+func (o holdOptions) holdTTL() time.Duration {
+	if o.ttl > 0 {
+		return o.ttl
+	}
+	return ReservationTTL
+}
+
+func (r *ReserveDomainImpl) CreateReservation(ctx context.Context, req *reservationpb.CreateReservationRequest) (*reservationpb.CreateReservationResponse, error) {
 	if err := validateReservationRequest(req); err != nil {
 		logger.ErrorContext(ctx, "check seat availability failed", slog.Any("error", err))
 		return nil, err
 	}
 
-	seats = convertSeatsToSeatInfo(req.GetSeats())
+	idempotencyKey := req.GetIdempotencyKey()
+	if idempotencyKey == "" {
+		return r.createReservation(ctx, req, holdOptions{})
+	}
+
+	requestHash, err := hashReservationRequest(req)
+	if err != nil {
+		return nil, apperror.Internal("failed to hash reservation request", err)
+	}
+
+	stored, err := r.repo.ClaimIdempotencyKey(ctx, req.GetUserId(), idempotencyKey, requestHash)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdempotencyKeyInUse) {
+			return nil, status.Error(codes.Aborted, "a request with this idempotency key is in progress")
+		}
+		if errors.Is(err, repositories.ErrIdempotencyKeyMismatch) {
+			return nil, apperror.BadRequest("idempotency key reused with a different request", err)
+		}
+		return nil, apperror.Internal("failed to claim idempotency key", err)
+	}
+
+	if stored != nil {
+		var replay reservationpb.CreateReservationResponse
+		if err := proto.Unmarshal(stored, &replay); err != nil {
+			return nil, apperror.Internal("failed to read stored response", err)
+		}
+		logger.InfoContext(ctx, "reservation replayed", slog.String("reservationID", replay.GetId()), slog.String("userID", req.GetUserId()))
+		return &replay, nil
+	}
+
+	res, err := r.createReservation(ctx, req, holdOptions{})
+	if err != nil {
+		// failures are not stored, a retry with the same key tries again
+		if err := r.repo.ReleaseIdempotencyKey(ctx, req.GetUserId(), idempotencyKey); err != nil {
+			logger.ErrorContext(ctx, "release idempotency key failed", slog.Any("error", err))
+		}
+		return nil, err
+	}
+
+	response, err := proto.Marshal(res)
+	if err == nil {
+		err = r.repo.CompleteIdempotencyKey(ctx, req.GetUserId(), idempotencyKey, response)
+	}
+	if err != nil {
+		// the hold exists, a retry sees the key in progress until the claim times out
+		logger.ErrorContext(ctx, "store idempotent response failed", slog.Any("error", err))
+	}
+
+	return res, nil
+}
+
+func (r *ReserveDomainImpl) createReservation(ctx context.Context, req *reservationpb.CreateReservationRequest, opts holdOptions) (*reservationpb.CreateReservationResponse, error) {
+	seats := convertSeatsToSeatInfo(req.GetSeats())
+	seats = append(seats, convertGeneralAdmissionToSeatInfo(req.GetGeneralAdmission())...)
+	ttl := opts.holdTTL()
+
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: req.GetEventId(),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	if !opts.ballot {
+		if err := r.checkBallotSaleOpen(ctx, event.GetEvent()); err != nil {
+			return nil, err
+		}
+	}
+
+	if limit := event.GetEvent().GetMaxTicketsPerUser(); limit > 0 {
+		unlock, err := r.lockTicketLimit(ctx, req.GetUserId(), req.GetEventId())
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+
+		if err := r.checkTicketLimit(ctx, req.GetUserId(), req.GetEventId(), limit, len(seats)); err != nil {
+			return nil, err
+		}
+	}
 
 	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
 		EventId: req.GetEventId(),
@@ -54,83 +157,132 @@ func (r *ReserveDomainImpl) CreateReservation(ctx context.Context, req *reservat
 		return nil, apperror.Internal("failed to get event zone", err)
 	}
 
-	eventZonePriceMap := make(map[int32]float64)
+	eventZoneMap := make(map[int32]*eventpb.EventZone)
+	capacities := make(map[int32]int32)
 	for _, zone := range eventZone.List {
-		eventZonePriceMap[zone.GetZoneNumber()] = zone.GetPrice()
+		eventZoneMap[zone.GetZoneNumber()] = zone
+		if zone.GetZoneType() == string(entities.ZoneGeneralAdmission) {
+			capacities[zone.GetZoneNumber()] = zone.GetCapacity()
+		}
+	}
+
+	ticketTypes := indexTicketTypes(eventZone.List)
+
+	// the tier selling now prices the hold, the price stays locked in the seats until checkout ends
+	pricing, err := r.zonesPricing(ctx, req.GetEventId(), eventZoneMap, time.Now())
+	if err != nil {
+		logger.ErrorContext(ctx, "count sold tickets failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to count sold tickets", err)
+	}
+
+	// every zone of an event is priced in its currency, events from before currencies are in THB
+	currency := event.GetEvent().GetCurrency()
+	if currency == "" {
+		currency = money.DefaultCurrency
 	}
 
-	// quickly check by the cache
-	var totalPrice float64
-	for _, seat := range seats {
-		price, ok := eventZonePriceMap[seat.ZoneNumber]
+	var subtotal int64
+	prices := make([]int64, len(seats))
+	for i, seat := range seats {
+		zone, ok := eventZoneMap[seat.ZoneNumber]
 		if !ok {
 			return nil, apperror.BadRequest("invalid zone number", nil)
 		}
 
-		totalPrice += price
+		_, generalAdmission := capacities[seat.ZoneNumber]
+		if seat.Unnumbered() != generalAdmission {
+			if generalAdmission {
+				return nil, apperror.BadRequest(fmt.Sprintf("zone %d is general admission, request a quantity instead of seats", seat.ZoneNumber), nil)
+			}
+			return nil, apperror.BadRequest(fmt.Sprintf("zone %d is seated, pick a row and column", seat.ZoneNumber), nil)
+		}
 
-		available, err := r.repo.CheckSeatAvailable(ctx, req.GetEventId(), seat)
-		if err != nil {
-			return nil, apperror.Internal("failed to check seat availability", err)
+		if seat.TicketTypeID != "" {
+			if ticketType, ok := ticketTypes[seat.TicketTypeID]; !ok || ticketType.GetZoneNumber() != zone.GetZoneNumber() {
+				return nil, apperror.BadRequest(fmt.Sprintf("ticket type %s is not sold in zone %d", seat.TicketTypeID, seat.ZoneNumber), nil)
+			}
 		}
-		if !available {
-			return nil, apperror.BadRequest("seat already reserved", nil)
+
+		price := holdPrice(seat, pricing, ticketTypes)
+		if m := money.FromProto(price); m.Currency != currency {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("zone %d is priced in %s, the event sells in %s", seat.ZoneNumber, m.Currency, currency))
 		}
-	}
-	reservationID = uuid.New().String()
-	// cache the reservation
-	if err := r.repo.CreateReservationTemp(ctx, req.GetUserId(), reservationID, ReservationTTL); err != nil {
-		r.repo.DeleteReservation(ctx, reservationID)
-		return nil, apperror.Internal("failed to cache reservation", err)
+		seats[i].Price = price.GetAmount()
+		prices[i] = seats[i].Price
+		subtotal += prices[i]
 	}
 
-	if err := r.repo.CacheReservationSeats(ctx, reservationID, seats, ReservationTTL); err != nil {
-		logger.ErrorContext(ctx, "cache seats failed", slog.Any("error", err))
-		r.repo.DeleteReservationTemp(ctx, req.GetUserId(), reservationID)
-		r.repo.DeleteReservation(ctx, reservationID)
-		return nil, apperror.Internal("failed to cache seats", err)
+	promotions, err := r.resolvePromotions(ctx, req.GetUserId(), req.GetEventId(), currency, req.GetPromoCodes(), seats, prices)
+	if err != nil {
+		return nil, err
 	}
+	breakdown := r.fees.Policy(event.GetEvent(), currency).Apply(subtotal, totalDiscount(promotions), len(seats))
+	lineItems := checkoutLineItems(event.GetEvent(), eventZoneMap, ticketTypes, seats, promotions, breakdown)
+
+	reservationID := uuid.New().String()
 
-	// ✅ NEW: Use batch update instead of individual updates for PENDING status
-	logger.InfoContext(ctx, "CreateReservation: Marking seats as PENDING (batch mode)",
+	// claim all seats atomically, either every seat is held or none of them
+	logger.InfoContext(ctx, "CreateReservation: Holding seats",
 		slog.String("reservationID", reservationID),
 		slog.Int("seats_count", len(seats)))
 
-	// Use batch update for all seats at once
-	if err := r.repo.SetSeatsTempReservedBatch(ctx, req.GetEventId(), seats, reservationID, ReservationTTL); err != nil {
-		logger.ErrorContext(ctx, "batch temp reserve seats failed", slog.Any("error", err))
-		rollbackReservation(ctx, r.repo, req.GetUserId(), req.GetEventId(), reservationID, seats)
+	conflicts, err := r.repo.HoldSeats(ctx, req.GetEventId(), seats, reservationID, ttl)
+	if err != nil {
+		logger.ErrorContext(ctx, "hold seats failed", slog.Any("error", err))
 		return nil, apperror.Internal("failed to reserve seats", err)
 	}
+	if len(conflicts) > 0 {
+		return nil, seatConflictError(conflicts)
+	}
 
-	stripe.Key = r.stripe.SecretKey
-	params := &stripe.CheckoutSessionParams{
-		UIMode: stripe.String("embedded"),
-		LineItems: []*stripe.CheckoutSessionLineItemParams{
-			{
-				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
-					Currency: stripe.String("thb"),
-					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
-						Name: stripe.String("your reservation checkout"),
-					},
-					// handle in satang unit
-					UnitAmount: stripe.Int64(int64(totalPrice * 100)),
-				},
-				Quantity: stripe.Int64(1),
-			},
-		},
-		Mode:              stripe.String(string(stripe.CheckoutSessionModePayment)),
-		ReturnURL:         stripe.String(r.stripe.ReturnURL + "/profile?session_id={CHECKOUT_SESSION_ID}"),
-		ClientReferenceID: stripe.String(fmt.Sprintf("%s", reservationID)),
+	// rollback purpose, the seats are ours from here on
+	defer func() {
+		if err != nil {
+			rollbackReservation(ctx, r.repo, req.GetUserId(), req.GetEventId(), reservationID, seats)
+		}
+	}()
+
+	// scheduled right away so a hold abandoned by a crash below is still swept
+	if err = r.repo.ScheduleHoldExpiry(ctx, repositories.HoldExpiry{
+		ReservationID: reservationID,
+		UserID:        req.GetUserId(),
+		EventID:       req.GetEventId(),
+		Seats:         seats,
+	}, time.Now().Add(ttl)); err != nil {
+		logger.ErrorContext(ctx, "schedule hold expiry failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to schedule reservation expiry", err)
+	}
+
+	// counted after the hold is scheduled, the sweeper gives the quantities back if we crash below
+	if err = r.repo.HoldGeneralAdmission(ctx, req.GetEventId(), seats, reservationID, capacities); err != nil {
+		if errors.Is(err, repositories.ErrGeneralAdmissionSoldOut) {
+			return nil, apperror.BadRequest("not enough general admission tickets left", err)
+		}
+		logger.ErrorContext(ctx, "hold general admission failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to reserve general admission tickets", err)
 	}
 
-	session, err := session.New(params)
+	// cache the reservation
+	if err = r.repo.CreateReservationTemp(ctx, req.GetUserId(), reservationID, ttl); err != nil {
+		return nil, apperror.Internal("failed to cache reservation", err)
+	}
+
+	if err = r.repo.CacheReservationSeats(ctx, reservationID, seats, ttl); err != nil {
+		logger.ErrorContext(ctx, "cache seats failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to cache seats", err)
+	}
+
+	session, err := r.newCheckoutSession(reservationID, currency, breakdown, lineItems, time.Now().Add(ttl))
 	if err != nil {
 		return nil, apperror.Internal("Failed to create Stripe session", err)
 	}
 
-	_, err = r.repo.CreateReservation(ctx, reservationID, req.GetUserId(), req.GetEventId(), string(entities.Pending), session.ID, totalPrice)
+	_, err = r.repo.CreateReservation(ctx, reservationID, req.GetUserId(), req.GetEventId(), string(entities.Pending), session.ID, currency, breakdown, lineItems, ticketTypeClaims(seats, ticketTypes), redemptions(promotions)...)
 	if err != nil {
+		discardCheckoutSession(ctx, session)
+		if errors.Is(err, repositories.ErrPromotionExhausted) || errors.Is(err, repositories.ErrPromotionUserLimit) || errors.Is(err, repositories.ErrTicketTypeSoldOut) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		return nil, apperror.Internal("Failed to create reservation", err)
 	}
 
@@ -174,10 +326,12 @@ func (r *ReserveDomainImpl) DeleteReservation(ctx context.Context, req *reservat
 	}
 
 	seats, _ := r.repo.GetReservationSeats(ctx, reservationID)
-	for _, seat := range seats {
-		r.repo.DeleteSeatReservation(ctx, eventID, seat)
+	seats = r.OfferReleasedSeats(ctx, eventID, reservationID, seats, false)
+	if err := r.repo.ReleaseSeats(ctx, eventID, seats, reservationID); err != nil {
+		logger.ErrorContext(ctx, "release seats failed", slog.Any("error", err))
 	}
 	r.repo.DeleteReservationSeats(ctx, reservationID)
+	r.repo.RemoveHoldExpiry(ctx, reservationID)
 
 	logger.InfoContext(ctx, "reservation cancelled", slog.String("reservationID", reservationID))
 	return &reservationpb.DeleteReservationResponse{
@@ -204,9 +358,11 @@ func (r *ReserveDomainImpl) GetReservation(ctx context.Context, req *reservation
 		}
 		for _, seat := range tmpSeats {
 			seats = append(seats, &reservationpb.Seat{
-				ZoneNumber: seat.ZoneNumber,
-				Row:        seat.RowNumber,
-				Column:     seat.ColNumber,
+				ZoneNumber:   seat.ZoneNumber,
+				Row:          seat.RowNumber,
+				Column:       seat.ColNumber,
+				TicketTypeId: seat.TicketTypeID,
+				Price:        toMoneyProto(seat.Price, reservation.Currency),
 			})
 		}
 		rtTime, err := r.repo.GetReservationTimeLeft(ctx, reservation.UserID.String(), reservation.ID.String())
@@ -214,9 +370,7 @@ func (r *ReserveDomainImpl) GetReservation(ctx context.Context, req *reservation
 			return nil, apperror.Internal("failed to get reservation time left", err)
 		}
 
-		// Convert time.Duration to float64 (seconds)
-		timeLeftSeconds := rtTime.Seconds()
-		timeLeft = min(timeLeftSeconds-SafetyBuffer.Seconds(), ResevationMax.Seconds())
+		timeLeft = holdTimeLeft(rtTime)
 	case string(entities.Confirmed):
 		// Handle confirmed status
 		tickets, err := r.repo.GetTicketsByReservation(ctx, req.GetId())
@@ -226,9 +380,12 @@ func (r *ReserveDomainImpl) GetReservation(ctx context.Context, req *reservation
 		}
 		for _, ticket := range tickets {
 			seats = append(seats, &reservationpb.Seat{
-				ZoneNumber: ticket.ZoneNumber,
-				Row:        ticket.RowNumber,
-				Column:     ticket.ColNumber,
+				ZoneNumber:   ticket.ZoneNumber,
+				Row:          ticket.RowNumber,
+				Column:       ticket.ColNumber,
+				TicketId:     pgUUIDToString(ticket.ID),
+				TicketTypeId: pgUUIDToString(ticket.TicketTypeID),
+				Price:        ticketPrice(ticket, reservation.Currency),
 			})
 		}
 	case string(entities.Cancelled):
@@ -237,6 +394,47 @@ func (r *ReserveDomainImpl) GetReservation(ctx context.Context, req *reservation
 		break
 	}
 
+	history, err := r.repo.ListTicketHistory(ctx, req.GetId())
+	if err != nil {
+		logger.ErrorContext(ctx, "get ticket history failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to get ticket history", err)
+	}
+	transHistory := make([]*reservationpb.TicketHistory, 0, len(history))
+	for _, entry := range history {
+		transHistory = append(transHistory, &reservationpb.TicketHistory{
+			TicketId:       pgUUIDToString(entry.TicketID),
+			ZoneNumber:     entry.ZoneNumber,
+			Row:            entry.RowNumber,
+			Column:         entry.ColNumber,
+			Action:         entry.Action,
+			RefundedAmount: toMoneyProto(entry.RefundedAmount, reservation.Currency),
+			CreatedAt:      entry.CreatedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	applied, err := r.repo.ListReservationPromotions(ctx, req.GetId())
+	if err != nil {
+		logger.ErrorContext(ctx, "get reservation promotions failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to get reservation promotions", err)
+	}
+	promotions := make([]*reservationpb.AppliedPromotion, 0, len(applied))
+	for _, promotion := range applied {
+		promotions = append(promotions, &reservationpb.AppliedPromotion{
+			Code:   promotion.Code,
+			Amount: toMoneyProto(promotion.Amount, reservation.Currency),
+		})
+	}
+
+	items, err := r.repo.ListReservationLineItems(ctx, req.GetId())
+	if err != nil {
+		logger.ErrorContext(ctx, "get reservation line items failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to get reservation line items", err)
+	}
+	lineItems := make([]*reservationpb.LineItem, 0, len(items))
+	for _, item := range items {
+		lineItems = append(lineItems, toLineItemProto(item, reservation.Currency))
+	}
+
 	session, err := session.Get(reservation.StripeSessionID, &stripe.CheckoutSessionParams{})
 	if err != nil {
 		return nil, apperror.Internal("Failed to get Stripe Client Secret", err)
@@ -250,7 +448,12 @@ func (r *ReserveDomainImpl) GetReservation(ctx context.Context, req *reservation
 		TimeLeft:           &timeLeft,
 		StripeClientSecret: session.ClientSecret,
 		Status:             reservation.Status,
-		TotalPrice:         reservation.TotalPrice,
+		TotalPrice:         toMoneyProto(reservation.TotalPrice, reservation.Currency),
+		History:            transHistory,
+		Promotions:         promotions,
+		Currency:           reservation.Currency,
+		LineItems:          lineItems,
+		Breakdown:          toBreakdownProto(reservation),
 	}, nil
 }
 
@@ -282,9 +485,11 @@ func (r *ReserveDomainImpl) ListReservation(ctx context.Context, req *reservatio
 			}
 			for _, seat := range tmpSeats {
 				seats = append(seats, &reservationpb.Seat{
-					ZoneNumber: seat.ZoneNumber,
-					Row:        seat.RowNumber,
-					Column:     seat.ColNumber,
+					ZoneNumber:   seat.ZoneNumber,
+					Row:          seat.RowNumber,
+					Column:       seat.ColNumber,
+					TicketTypeId: seat.TicketTypeID,
+					Price:        toMoneyProto(seat.Price, reservation.Currency),
 				})
 			}
 			// Get time left for pending reservations
@@ -292,8 +497,7 @@ func (r *ReserveDomainImpl) ListReservation(ctx context.Context, req *reservatio
 			if err != nil {
 				logger.ErrorContext(ctx, "get reservation time left failed", slog.Any("error", err))
 			} else {
-				timeLeftSeconds := rtTime.Seconds()
-				timeLeftValue := min(timeLeftSeconds-SafetyBuffer.Seconds(), ResevationMax.Seconds())
+				timeLeftValue := holdTimeLeft(rtTime)
 				timeLeft = &timeLeftValue
 			}
 
@@ -306,9 +510,11 @@ func (r *ReserveDomainImpl) ListReservation(ctx context.Context, req *reservatio
 			}
 			for _, ticket := range tickets {
 				seats = append(seats, &reservationpb.Seat{
-					ZoneNumber: ticket.ZoneNumber,
-					Row:        ticket.RowNumber,
-					Column:     ticket.ColNumber,
+					ZoneNumber:   ticket.ZoneNumber,
+					Row:          ticket.RowNumber,
+					Column:       ticket.ColNumber,
+					TicketTypeId: pgUUIDToString(ticket.TicketTypeID),
+					Price:        ticketPrice(ticket, reservation.Currency),
 				})
 			}
 		default:
@@ -319,10 +525,12 @@ func (r *ReserveDomainImpl) ListReservation(ctx context.Context, req *reservatio
 			Id:         pgUUIDToString(reservation.ID),
 			UserId:     pgUUIDToString(reservation.UserID),
 			EventId:    pgUUIDToString(reservation.EventID),
-			TotalPrice: reservation.TotalPrice,
+			TotalPrice: toMoneyProto(reservation.TotalPrice, reservation.Currency),
 			Seats:      seats,
 			TimeLeft:   timeLeft,
 			Status:     reservation.Status,
+			Currency:   reservation.Currency,
+			Breakdown:  toBreakdownProto(&reservation),
 		})
 	}
 
@@ -339,6 +547,11 @@ func (r *ReserveDomainImpl) ConfirmReservation(ctx context.Context, req *reserva
 		return nil, apperror.NotFound("reservation not found", err)
 	}
 
+	// payment webhooks are delivered at least once
+	if reservation.Status == string(entities.Confirmed) {
+		return alreadyConfirmed(ctx, reservationID), nil
+	}
+
 	timeLeft, err := r.repo.GetReservationTimeLeft(ctx, reservation.UserID.String(), reservationID)
 	if err != nil || timeLeft <= 0 {
 		logger.WarnContext(ctx, "reservation not found or expired", slog.String("reservationID", reservationID))
@@ -353,51 +566,49 @@ func (r *ReserveDomainImpl) ConfirmReservation(ctx context.Context, req *reserva
 		return nil, apperror.Internal("failed to get reservation seats", err)
 	}
 
-	if _, err := r.repo.CreateTicketsWithTransaction(ctx, eventID, reservationID, seats); err != nil {
-		return nil, apperror.Internal("failed to create tickets", err)
+	confirmed, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.confirmed", entities.ConfirmedNotiReservation{
+		ID:      reservationID,
+		UserID:  reservation.UserID.String(),
+		EventID: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to build confirmation message", err)
+	}
+
+	// tickets, status and the notification are committed together
+	if _, err := r.repo.ConfirmReservationWithTickets(ctx, eventID, reservationID, seats, confirmed); err != nil {
+		if errors.Is(err, repositories.ErrSeatAlreadySold) {
+			logger.WarnContext(ctx, "seat already sold, compensating reservation",
+				slog.String("reservationID", reservationID),
+				slog.Any("error", err))
+			return r.compensateReservation(ctx, reservation, seats, "seat already sold")
+		}
+		if errors.Is(err, repositories.ErrReservationNotPending) {
+			// a concurrent delivery of the same webhook may have won the race
+			if current, _ := r.repo.GetReservationStatus(ctx, reservationID); current == string(entities.Confirmed) {
+				return alreadyConfirmed(ctx, reservationID), nil
+			}
+			return nil, apperror.BadRequest("reservation is no longer pending", err)
+		}
+		return nil, apperror.Internal("failed to confirm reservation", err)
 	}
 
-	// ✅ NEW: Use batch update instead of individual updates
+	// the purchase is committed, Redis failures below are repaired by the reconciler
 	logger.InfoContext(ctx, "ConfirmReservation: Marking seats as RESERVED (batch mode)",
 		slog.String("reservationID", reservationID),
 		slog.Int("seats_count", len(seats)))
 
-	// Use batch update for all seats at once
-	if err := r.repo.SetSeatsReservedBatch(ctx, reservation.EventID.String(), seats, reservationID); err != nil {
+	if err := r.repo.SetSeatsReservedBatch(ctx, eventID, seats, reservationID); err != nil {
 		logger.ErrorContext(ctx, "batch reserve seats failed", slog.Any("error", err))
-		rollbackReservation(ctx, r.repo, reservation.UserID.String(), reservation.EventID.String(), reservationID, seats)
-		return nil, apperror.Internal("failed to reserve seats", err)
-	}
-
-	if _, err := r.repo.UpdateReservationStatus(ctx, reservationID, string(entities.Confirmed)); err != nil {
-		logger.ErrorContext(ctx, "confirm reservation failed", slog.Any("error", err))
-		return nil, apperror.Internal("failed to confirm reservation", err)
 	}
 
 	if err := r.repo.DeleteReservationTemp(ctx, reservation.UserID.String(), reservationID); err != nil {
 		logger.ErrorContext(ctx, "cleanup temp reservation failed", slog.Any("error", err))
-		return nil, apperror.Internal("failed to cleanup temp reservation", err)
 	}
 
-	data := struct {
-		Type string `json:"type"`
-		Data any    `json:"data"`
-	}{
-		Type: "reservation.confirmed",
-		Data: entities.ConfirmedNotiReservation{
-			ID:      reservation.ID.String(),
-			UserID:  reservation.UserID.String(),
-			EventID: reservation.EventID.String(),
-		},
-	}
-
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return nil, errors.New("failed to marshal event data")
-	}
-
-	if err := rabbitmq.RabbitMQClient.PublishToQueue("notifications", jsonData); err != nil {
-		return nil, errors.New("failed to publish event to RabbitMQ")
+	if err := r.repo.RemoveHoldExpiry(ctx, reservationID); err != nil {
+		// harmless, the sweeper skips reservations that are no longer pending
+		logger.ErrorContext(ctx, "remove hold expiry failed", slog.Any("error", err))
 	}
 
 	logger.InfoContext(ctx, "reservation confirmed (batch mode)",
@@ -411,6 +622,97 @@ func (r *ReserveDomainImpl) ConfirmReservation(ctx context.Context, req *reserva
 	}, nil
 }
 
+func alreadyConfirmed(ctx context.Context, reservationID string) *reservationpb.ConfirmReservationResponse {
+	logger.InfoContext(ctx, "reservation already confirmed", slog.String("reservationID", reservationID))
+	return &reservationpb.ConfirmReservationResponse{
+		Id:      reservationID,
+		Success: true,
+		Message: "Reservation already confirmed",
+	}
+}
+
+// compensateReservation undoes a paid reservation that can not be fulfilled.
+// The payment is refunded first so a failure leaves the reservation untouched for the webhook retry.
+func (r *ReserveDomainImpl) compensateReservation(ctx context.Context, reservation *db.Reservation, seats []repositories.SeatInfo, reason string) (*reservationpb.ConfirmReservationResponse, error) {
+	reservationID := pgUUIDToString(reservation.ID)
+	userID := pgUUIDToString(reservation.UserID)
+	eventID := pgUUIDToString(reservation.EventID)
+
+	if err := r.refundPayment(reservation.StripeSessionID, reservationID, reason, 0); err != nil {
+		logger.ErrorContext(ctx, "refund payment failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+		return nil, apperror.Internal("failed to refund reservation", err)
+	}
+
+	cancelled, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.cancelled", entities.CancelledNotiReservation{
+		ID:      reservationID,
+		UserID:  userID,
+		EventID: eventID,
+		Reason:  reason,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to build cancellation message", err)
+	}
+
+	if _, err := r.repo.TransitionReservationStatus(ctx, reservationID, string(entities.Pending), string(entities.Cancelled), cancelled); err != nil {
+		logger.ErrorContext(ctx, "cancel reservation failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to cancel reservation", err)
+	}
+
+	r.repo.DeleteReservationTemp(ctx, userID, reservationID)
+	// only the holds owned by this reservation are released, sold seats keep their owner
+	if err := r.repo.ReleaseSeats(ctx, eventID, seats, reservationID); err != nil {
+		logger.ErrorContext(ctx, "release seats failed", slog.Any("error", err))
+	}
+	r.repo.DeleteReservationSeats(ctx, reservationID)
+	r.repo.RemoveHoldExpiry(ctx, reservationID)
+
+	logger.InfoContext(ctx, "reservation compensated",
+		slog.String("reservationID", reservationID),
+		slog.String("reason", reason))
+
+	return &reservationpb.ConfirmReservationResponse{
+		Id:       reservationID,
+		Success:  false,
+		Refunded: true,
+		Message:  reason + ", payment refunded",
+	}, nil
+}
+
+// refundPayment refunds amount minor units of the payment of a checkout session, 0 refunds it in full.
+// The refund is keyed by reservation and the given tickets so retries never refund twice.
+func (r *ReserveDomainImpl) refundPayment(sessionID, reservationID, reason string, amount int64, ticketIDs ...string) error {
+	stripe.Key = r.stripe.SecretKey
+
+	checkout, err := session.Get(sessionID, &stripe.CheckoutSessionParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get checkout session: %w", err)
+	}
+	if checkout.PaymentIntent == nil {
+		return errors.New("checkout session has no payment intent")
+	}
+
+	params := &stripe.RefundParams{
+		PaymentIntent: stripe.String(checkout.PaymentIntent.ID),
+	}
+	if amount > 0 {
+		params.Amount = stripe.Int64(amount)
+	}
+	key := "refund:" + reservationID
+	if len(ticketIDs) > 0 {
+		sorted := slices.Sorted(slices.Values(ticketIDs))
+		digest := sha256.Sum256([]byte(strings.Join(sorted, ",")))
+		key += ":" + hex.EncodeToString(digest[:])
+	}
+	params.SetIdempotencyKey(key)
+	params.AddMetadata("reservation_id", reservationID)
+	params.AddMetadata("reason", reason)
+
+	if _, err := refund.New(params); err != nil {
+		return fmt.Errorf("failed to create refund: %w", err)
+	}
+	return nil
+}
+
 func (r *ReserveDomainImpl) GetReservationByStripeSessionID(ctx context.Context, req *reservationpb.GetReservationByStripeSessionIDRequest) (*reservationpb.GetReservationByStripeSessionIDResponse, error) {
 	reservation, err := r.repo.GetReservationBySessionId(ctx, req.GetSessionId())
 	if err != nil {
@@ -432,9 +734,11 @@ func (r *ReserveDomainImpl) GetReservationByStripeSessionID(ctx context.Context,
 		}
 		for _, seat := range tmpSeats {
 			seats = append(seats, &reservationpb.Seat{
-				ZoneNumber: seat.ZoneNumber,
-				Row:        seat.RowNumber,
-				Column:     seat.ColNumber,
+				ZoneNumber:   seat.ZoneNumber,
+				Row:          seat.RowNumber,
+				Column:       seat.ColNumber,
+				TicketTypeId: seat.TicketTypeID,
+				Price:        toMoneyProto(seat.Price, reservation.Currency),
 			})
 		}
 	case string(entities.Confirmed):
@@ -446,9 +750,12 @@ func (r *ReserveDomainImpl) GetReservationByStripeSessionID(ctx context.Context,
 		}
 		for _, ticket := range tickets {
 			seats = append(seats, &reservationpb.Seat{
-				ZoneNumber: ticket.ZoneNumber,
-				Row:        ticket.RowNumber,
-				Column:     ticket.ColNumber,
+				ZoneNumber:   ticket.ZoneNumber,
+				Row:          ticket.RowNumber,
+				Column:       ticket.ColNumber,
+				TicketId:     pgUUIDToString(ticket.ID),
+				TicketTypeId: pgUUIDToString(ticket.TicketTypeID),
+				Price:        ticketPrice(ticket, reservation.Currency),
 			})
 		}
 	case string(entities.Cancelled):
@@ -463,7 +770,8 @@ func (r *ReserveDomainImpl) GetReservationByStripeSessionID(ctx context.Context,
 		EventId:    pgUUIDToString(reservation.EventID),
 		Seats:      seats,
 		Status:     reservation.Status,
-		TotalPrice: reservation.TotalPrice,
+		TotalPrice: toMoneyProto(reservation.TotalPrice, reservation.Currency),
+		Breakdown:  toBreakdownProto(reservation),
 	}, nil
 }
 
@@ -474,30 +782,257 @@ func validateReservationRequest(req *reservationpb.CreateReservationRequest) err
 	if req.GetEventId() == "" {
 		return apperror.BadRequest("event ID required", nil)
 	}
-	if len(req.GetSeats()) == 0 {
+	if len(req.GetSeats()) == 0 && len(req.GetGeneralAdmission()) == 0 {
 		return apperror.BadRequest("at least one seat required", nil)
 	}
+
+	requested := make(map[repositories.SeatInfo]struct{}, len(req.GetSeats()))
+	for _, seat := range convertSeatsToSeatInfo(req.GetSeats()) {
+		if seat.RowNumber <= 0 || seat.ColNumber <= 0 {
+			return apperror.BadRequest("seat row and column must be positive", nil)
+		}
+		// a seat is sold once whatever ticket type it is asked for as
+		seat.TicketTypeID = ""
+		if _, ok := requested[seat]; ok {
+			return apperror.BadRequest("duplicate seat in request", nil)
+		}
+		requested[seat] = struct{}{}
+	}
+
+	type generalAdmission struct {
+		zoneNumber   int32
+		ticketTypeID string
+	}
+	zones := make(map[generalAdmission]struct{}, len(req.GetGeneralAdmission()))
+	for _, ga := range req.GetGeneralAdmission() {
+		if ga.GetQuantity() <= 0 {
+			return apperror.BadRequest("general admission quantity must be positive", nil)
+		}
+		key := generalAdmission{zoneNumber: ga.GetZoneNumber(), ticketTypeID: ga.GetTicketTypeId()}
+		if _, ok := zones[key]; ok {
+			return apperror.BadRequest("duplicate general admission zone in request", nil)
+		}
+		zones[key] = struct{}{}
+	}
 	return nil
 }
 
+// hashReservationRequest fingerprints everything but the idempotency key,
+// so a key reused for another event or other seats is detected.
+func hashReservationRequest(req *reservationpb.CreateReservationRequest) ([]byte, error) {
+	fingerprint := proto.Clone(req).(*reservationpb.CreateReservationRequest)
+	fingerprint.IdempotencyKey = nil
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	return sum[:], nil
+}
+
+// seatConflictError reports the seats that prevented a hold. The seats are
+// attached as a SeatConflict status detail so the gateway can show them to the buyer.
+func seatConflictError(seats []repositories.SeatInfo) error {
+	detail := &reservationpb.SeatConflict{
+		Seats: make([]*reservationpb.CreateReservationSeatRequest, len(seats)),
+	}
+	for i, seat := range seats {
+		detail.Seats[i] = &reservationpb.CreateReservationSeatRequest{
+			ZoneNumber: seat.ZoneNumber,
+			Row:        seat.RowNumber,
+			Column:     seat.ColNumber,
+		}
+	}
+
+	st := status.New(codes.AlreadyExists, "seat already reserved")
+	if withDetails, err := st.WithDetails(detail); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
 func convertSeatsToSeatInfo(seats []*reservationpb.CreateReservationSeatRequest) []repositories.SeatInfo {
 	result := make([]repositories.SeatInfo, len(seats))
 	for i, seat := range seats {
 		result[i] = repositories.SeatInfo{
-			ZoneNumber: seat.GetZoneNumber(),
-			RowNumber:  seat.GetRow(),
-			ColNumber:  seat.GetColumn(),
+			ZoneNumber:   seat.GetZoneNumber(),
+			RowNumber:    seat.GetRow(),
+			ColNumber:    seat.GetColumn(),
+			TicketTypeID: seat.GetTicketTypeId(),
 		}
 	}
 	return result
 }
 
+// convertGeneralAdmissionToSeatInfo expands every quantity into unnumbered seats of the zone.
+func convertGeneralAdmissionToSeatInfo(requests []*reservationpb.GeneralAdmissionRequest) []repositories.SeatInfo {
+	var result []repositories.SeatInfo
+	for _, ga := range requests {
+		for range ga.GetQuantity() {
+			result = append(result, repositories.SeatInfo{ZoneNumber: ga.GetZoneNumber(), TicketTypeID: ga.GetTicketTypeId()})
+		}
+	}
+	return result
+}
+
+// newCheckoutSession opens the Stripe checkout the buyer pays the reservation with, in the currency of the
+// event, showing its line items. Stripe takes no negative lines, the DISCOUNT lines are taken off by a
+// coupon made for this session only. The price breakdown goes along as metadata. The session expires with
+// the hold at expiresAt, or as close to it as Stripe takes.
+func (r *ReserveDomainImpl) newCheckoutSession(reservationID, currency string, breakdown fees.Breakdown, lineItems []repositories.LineItem, expiresAt time.Time) (*stripe.CheckoutSession, error) {
+	currency = strings.ToLower(currency)
+	stripe.Key = r.stripe.SecretKey
+	params := &stripe.CheckoutSessionParams{
+		UIMode:            stripe.String("embedded"),
+		Mode:              stripe.String(string(stripe.CheckoutSessionModePayment)),
+		ReturnURL:         stripe.String(r.stripe.ReturnURL + "/profile?session_id={CHECKOUT_SESSION_ID}"),
+		ClientReferenceID: stripe.String(fmt.Sprintf("%s", reservationID)),
+		Metadata:          breakdownMetadata(breakdown),
+		ExpiresAt:         stripe.Int64(checkoutExpiry(expiresAt, time.Now()).Unix()),
+	}
+
+	var discount int64
+	var promoCodes []string
+	for _, item := range lineItems {
+		if item.Kind == entities.LineItemDiscount {
+			discount -= item.Amount()
+			promoCodes = append(promoCodes, item.Name)
+			continue
+		}
+
+		product := &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+			Name: stripe.String(item.Name),
+		}
+		if item.Description != "" {
+			product.Description = stripe.String(item.Description)
+		}
+		params.LineItems = append(params.LineItems, &stripe.CheckoutSessionLineItemParams{
+			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+				Currency:    stripe.String(currency),
+				ProductData: product,
+				// Stripe takes amounts in the minor unit too, whole yen for zero-decimal currencies such as JPY
+				UnitAmount: stripe.Int64(item.UnitAmount),
+			},
+			Quantity: stripe.Int64(int64(item.Quantity)),
+		})
+	}
+
+	if discount > 0 {
+		name := strings.Join(promoCodes, " + ")
+		if len(name) > 40 {
+			// the longest coupon name Stripe takes
+			name = name[:40]
+		}
+
+		c, err := coupon.New(&stripe.CouponParams{
+			Name:           stripe.String(name),
+			AmountOff:      stripe.Int64(discount),
+			Currency:       stripe.String(currency),
+			Duration:       stripe.String(string(stripe.CouponDurationOnce)),
+			MaxRedemptions: stripe.Int64(1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create coupon: %w", err)
+		}
+		params.Discounts = []*stripe.CheckoutSessionDiscountParams{
+			{Coupon: stripe.String(c.ID)},
+		}
+	}
+
+	return session.New(params)
+}
+
+// checkoutExpiry is when the checkout of a hold ending at holdEnd expires. Holds shorter than Stripe takes
+// outlive their checkout, the hold expiry still releases the seats on time.
+func checkoutExpiry(holdEnd, now time.Time) time.Time {
+	if holdEnd.Before(now.Add(minCheckoutExpiry)) {
+		return now.Add(minCheckoutExpiry)
+	}
+	if holdEnd.After(now.Add(maxCheckoutExpiry)) {
+		return now.Add(maxCheckoutExpiry)
+	}
+	return holdEnd
+}
+
+// discardCheckoutSession expires a checkout the reservation could not be saved for and deletes its coupon,
+// so neither can be paid or redeemed. Failures are only logged, the session expires by itself anyway.
+func discardCheckoutSession(ctx context.Context, checkout *stripe.CheckoutSession) {
+	if _, err := session.Expire(checkout.ID, nil); err != nil {
+		logger.ErrorContext(ctx, "expire checkout session failed", slog.String("sessionID", checkout.ID), slog.Any("error", err))
+	}
+	deleteCheckoutCoupons(ctx, checkout)
+}
+
+// deleteCheckoutCoupons deletes the coupons made for a checkout that can no longer be paid.
+func deleteCheckoutCoupons(ctx context.Context, checkout *stripe.CheckoutSession) {
+	for _, discount := range checkout.Discounts {
+		if discount.Coupon == nil {
+			continue
+		}
+		if _, err := coupon.Del(discount.Coupon.ID, nil); err != nil {
+			logger.ErrorContext(ctx, "delete coupon failed", slog.String("couponID", discount.Coupon.ID), slog.Any("error", err))
+		}
+	}
+}
+
+// breakdownMetadata lists the price breakdown in minor units, the way Stripe takes metadata.
+func breakdownMetadata(breakdown fees.Breakdown) map[string]string {
+	return map[string]string{
+		"subtotal":       strconv.FormatInt(breakdown.Subtotal, 10),
+		"discount":       strconv.FormatInt(breakdown.Discount, 10),
+		"booking_fee":    strconv.FormatInt(breakdown.BookingFee, 10),
+		"processing_fee": strconv.FormatInt(breakdown.ProcessingFee, 10),
+		"tax":            strconv.FormatInt(breakdown.Tax, 10),
+		"vat_rate":       strconv.FormatFloat(breakdown.VATRate, 'f', -1, 64),
+		"vat_inclusive":  strconv.FormatBool(breakdown.VATInclusive),
+		"total":          strconv.FormatInt(breakdown.Total, 10),
+	}
+}
+
 func rollbackReservation(ctx context.Context, repo repositories.ReservationRepository, userID, eventID, reservationID string, seats []repositories.SeatInfo) {
 	repo.DeleteReservationTemp(ctx, userID, reservationID)
 	repo.DeleteReservationSeats(ctx, reservationID)
 	repo.DeleteReservation(ctx, reservationID)
-	for _, seat := range seats {
-		repo.DeleteSeatReservation(ctx, eventID, seat)
+	repo.ReleaseSeats(ctx, eventID, seats, reservationID)
+	repo.RemoveHoldExpiry(ctx, reservationID)
+}
+
+// holdTimeLeft is the time the buyer has left to pay in seconds, the safety buffer is kept back.
+func holdTimeLeft(ttl time.Duration) float64 {
+	return (ttl - SafetyBuffer).Seconds()
+}
+
+// toMoneyProto wraps a stored amount, in the minor unit of the currency of its reservation.
+func toMoneyProto(amount int64, currency string) *moneypb.Money {
+	return money.ToProto(money.New(amount, currency))
+}
+
+// reservationBreakdown is the price breakdown stored on a reservation.
+func reservationBreakdown(reservation *db.Reservation) fees.Breakdown {
+	return fees.Breakdown{
+		Subtotal:            reservation.Subtotal,
+		Discount:            reservation.Discount,
+		BookingFee:          reservation.BookingFee,
+		BookingFeePerTicket: reservation.BookingFeePerTicket,
+		ProcessingFee:       reservation.ProcessingFee,
+		Tax:                 reservation.Tax,
+		VATRate:             reservation.VatRate,
+		VATInclusive:        reservation.VatInclusive,
+		Total:               reservation.TotalPrice,
+	}
+}
+
+func toBreakdownProto(reservation *db.Reservation) *reservationpb.PriceBreakdown {
+	return &reservationpb.PriceBreakdown{
+		Subtotal:      toMoneyProto(reservation.Subtotal, reservation.Currency),
+		Discount:      toMoneyProto(reservation.Discount, reservation.Currency),
+		BookingFee:    toMoneyProto(reservation.BookingFee, reservation.Currency),
+		ProcessingFee: toMoneyProto(reservation.ProcessingFee, reservation.Currency),
+		Tax:           toMoneyProto(reservation.Tax, reservation.Currency),
+		VatRate:       reservation.VatRate,
+		VatInclusive:  reservation.VatInclusive,
+		Total:         toMoneyProto(reservation.TotalPrice, reservation.Currency),
 	}
 }
 
@@ -533,9 +1068,50 @@ func (r *ReserveDomainImpl) GetEventSeats(ctx context.Context, req *reservationp
 		}
 	}
 
+	generalAdmission, err := r.getGeneralAdmissionStatus(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.InfoContext(ctx, "event seats retrieved", slog.String("eventID", eventID), slog.Int("count", len(seats)))
 
 	return &reservationpb.GetEventSeatsResponse{
-		Seats: seatStatuses,
+		Seats:            seatStatuses,
+		GeneralAdmission: generalAdmission,
 	}, nil
 }
+
+// getGeneralAdmissionStatus reports the capacity counters of the general admission zones of the event.
+func (r *ReserveDomainImpl) getGeneralAdmissionStatus(ctx context.Context, eventID string) ([]*reservationpb.GeneralAdmissionStatus, error) {
+	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event zone", err)
+	}
+
+	var zones []*eventpb.EventZone
+	var zoneNumbers []int32
+	for _, zone := range eventZone.List {
+		if zone.GetZoneType() == string(entities.ZoneGeneralAdmission) {
+			zones = append(zones, zone)
+			zoneNumbers = append(zoneNumbers, zone.GetZoneNumber())
+		}
+	}
+
+	taken, err := r.repo.GetGeneralAdmissionTaken(ctx, eventID, zoneNumbers)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to get general admission counters", slog.Any("error", err), slog.String("eventID", eventID))
+		return nil, apperror.Internal("failed to get general admission counters", err)
+	}
+
+	statuses := make([]*reservationpb.GeneralAdmissionStatus, len(zones))
+	for i, zone := range zones {
+		statuses[i] = &reservationpb.GeneralAdmissionStatus{
+			ZoneNumber: zone.GetZoneNumber(),
+			Capacity:   zone.GetCapacity(),
+			Taken:      min(taken[zone.GetZoneNumber()], zone.GetCapacity()),
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,96 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+)
+
+const (
+	// ticketLimitLease outlives a slow CreateReservation, including the Stripe call.
+	ticketLimitLease = time.Minute
+	ticketLimitWait  = 5 * time.Second
+	ticketLimitRetry = 100 * time.Millisecond
+)
+
+func ticketLimitKey(userID, eventID string) string {
+	return fmt.Sprintf("reservation:limit:%s:%s", eventID, userID)
+}
+
+// lockTicketLimit serializes the reservations of one user for one event,
+// otherwise parallel requests could all pass the limit check before any of them holds its seats.
+// The returned func releases the lock.
+func (r *ReserveDomainImpl) lockTicketLimit(ctx context.Context, userID, eventID string) (func(), error) {
+	key := ticketLimitKey(userID, eventID)
+	owner := uuid.New().String()
+	deadline := time.Now().Add(ticketLimitWait)
+
+	for {
+		held, err := r.repo.RenewLease(ctx, key, owner, ticketLimitLease)
+		if err != nil {
+			return nil, apperror.Internal("failed to lock ticket limit", err)
+		}
+		if held {
+			return func() {
+				if err := r.repo.ReleaseLease(context.WithoutCancel(ctx), key, owner); err != nil {
+					logger.ErrorContext(ctx, "release ticket limit lock failed", slog.Any("error", err))
+				}
+			}, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, ticketLimitError("another reservation for this event is in progress, please try again", 0, 0)
+		}
+
+		select {
+		case <-time.After(ticketLimitRetry):
+		case <-ctx.Done():
+			return nil, apperror.Internal("failed to lock ticket limit", ctx.Err())
+		}
+	}
+}
+
+// checkTicketLimit counts bought tickets and live holds against the per-user limit of the event.
+func (r *ReserveDomainImpl) checkTicketLimit(ctx context.Context, userID, eventID string, limit int32, requested int) error {
+	bought, err := r.repo.CountUserTickets(ctx, userID, eventID)
+	if err != nil {
+		return apperror.Internal("failed to count tickets", err)
+	}
+
+	held, err := r.repo.CountHeldSeats(ctx, userID, eventID)
+	if err != nil {
+		return apperror.Internal("failed to count held seats", err)
+	}
+
+	remaining := max(int(limit)-bought-held, 0)
+	if requested > remaining {
+		logger.WarnContext(ctx, "ticket limit reached",
+			slog.String("userID", userID),
+			slog.String("eventID", eventID),
+			slog.Int("limit", int(limit)),
+			slog.Int("bought", bought),
+			slog.Int("held", held),
+			slog.Int("requested", requested))
+		return ticketLimitError(fmt.Sprintf("ticket limit is %d per user, you can buy %d more tickets for this event", limit, remaining), limit, int32(remaining))
+	}
+	return nil
+}
+
+// ticketLimitError is a bad request against the ticket limit, the detail tells it apart from other invalid
+// arguments.
+func ticketLimitError(message string, limit, remaining int32) error {
+	st := status.New(codes.InvalidArgument, message)
+	if withDetails, err := st.WithDetails(&reservationpb.TicketLimitExceeded{Limit: limit, Remaining: remaining}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
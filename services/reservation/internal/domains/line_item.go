@@ -0,0 +1,151 @@
+package domains
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// checkoutLineItems breaks a reservation down into a TICKET line per zone and ticket type, in zone order, with
+// the seats in its description, followed by a DISCOUNT line per applied promotion, a FEE line per fee of the
+// breakdown and a TAX line for VAT added on top. Every seat must carry the price it is held at.
+func checkoutLineItems(event *eventpb.Event, zones map[int32]*eventpb.EventZone, ticketTypes map[string]*eventpb.TicketType, seats []repositories.SeatInfo, promotions []appliedPromotion, breakdown fees.Breakdown) []repositories.LineItem {
+	type ticketLine struct {
+		zoneNumber   int32
+		ticketTypeID string
+	}
+	byLine := make(map[ticketLine][]repositories.SeatInfo)
+	lines := make([]ticketLine, 0)
+	for _, seat := range seats {
+		line := ticketLine{zoneNumber: seat.ZoneNumber, ticketTypeID: seat.TicketTypeID}
+		if _, ok := byLine[line]; !ok {
+			lines = append(lines, line)
+		}
+		byLine[line] = append(byLine[line], seat)
+	}
+	// the ticket types of a zone stay in the order they were picked
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].zoneNumber < lines[j].zoneNumber })
+
+	items := make([]repositories.LineItem, 0, len(lines)+len(promotions)+3)
+	for _, line := range lines {
+		zoneNumber := line.zoneNumber
+		lineSeats := byLine[line]
+		name := zoneLineName(event, zones[zoneNumber])
+		if ticketType, ok := ticketTypes[line.ticketTypeID]; ok {
+			name += " - " + ticketType.GetName()
+		}
+		items = append(items, repositories.LineItem{
+			Kind:        entities.LineItemTicket,
+			Name:        name,
+			Description: seatsDescription(lineSeats),
+			ZoneNumber:  &zoneNumber,
+			Quantity:    int32(len(lineSeats)),
+			UnitAmount:  lineSeats[0].Price,
+		})
+	}
+
+	for _, p := range promotions {
+		items = append(items, repositories.LineItem{
+			Kind:        entities.LineItemDiscount,
+			Name:        p.promotion.Code,
+			Description: "Promo code",
+			Quantity:    1,
+			UnitAmount:  -p.amount,
+		})
+	}
+
+	if breakdown.BookingFee > 0 {
+		items = append(items, repositories.LineItem{
+			Kind:       entities.LineItemFee,
+			Name:       "Booking fee",
+			Quantity:   int32(len(seats)),
+			UnitAmount: breakdown.BookingFeePerTicket,
+		})
+	}
+	if breakdown.ProcessingFee > 0 {
+		items = append(items, repositories.LineItem{
+			Kind:       entities.LineItemFee,
+			Name:       "Processing fee",
+			Quantity:   1,
+			UnitAmount: breakdown.ProcessingFee,
+		})
+	}
+	if !breakdown.VATInclusive && breakdown.Tax > 0 {
+		items = append(items, repositories.LineItem{
+			Kind:       entities.LineItemTax,
+			Name:       fmt.Sprintf("VAT %s%%", strconv.FormatFloat(breakdown.VATRate, 'f', -1, 64)),
+			Quantity:   1,
+			UnitAmount: breakdown.Tax,
+		})
+	}
+
+	return items
+}
+
+func zoneLineName(event *eventpb.Event, zone *eventpb.EventZone) string {
+	name := zone.GetName()
+	if name == "" {
+		name = fmt.Sprintf("Zone %d", zone.GetZoneNumber())
+	}
+	if event.GetName() == "" {
+		return name
+	}
+	return event.GetName() + " - " + name
+}
+
+// seatsDescription lists the seats of one zone by row and column, general admission has none to list.
+func seatsDescription(seats []repositories.SeatInfo) string {
+	numbered := make([]repositories.SeatInfo, 0, len(seats))
+	for _, seat := range seats {
+		if !seat.Unnumbered() {
+			numbered = append(numbered, seat)
+		}
+	}
+	if len(numbered) == 0 {
+		return "General admission"
+	}
+
+	sort.Slice(numbered, func(i, j int) bool {
+		if numbered[i].RowNumber != numbered[j].RowNumber {
+			return numbered[i].RowNumber < numbered[j].RowNumber
+		}
+		return numbered[i].ColNumber < numbered[j].ColNumber
+	})
+	parts := make([]string, len(numbered))
+	for i, seat := range numbered {
+		parts[i] = fmt.Sprintf("Row %d Seat %d", seat.RowNumber, seat.ColNumber)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// fromLineItemRow reads back a stored line item, to open a new checkout for the reservation.
+func fromLineItemRow(item db.Reservationlineitem) repositories.LineItem {
+	return repositories.LineItem{
+		Kind:        entities.LineItemKind(item.Kind),
+		Name:        item.Name,
+		Description: item.Description,
+		ZoneNumber:  item.ZoneNumber,
+		Quantity:    item.Quantity,
+		UnitAmount:  item.UnitAmount,
+	}
+}
+
+func toLineItemProto(item db.Reservationlineitem, currency string) *reservationpb.LineItem {
+	return &reservationpb.LineItem{
+		Kind:        item.Kind,
+		Name:        item.Name,
+		Description: item.Description,
+		ZoneNumber:  item.ZoneNumber,
+		Quantity:    item.Quantity,
+		UnitAmount:  toMoneyProto(item.UnitAmount, currency),
+		Amount:      toMoneyProto(item.Amount, currency),
+	}
+}
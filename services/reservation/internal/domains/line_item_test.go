@@ -0,0 +1,101 @@
+package domains
+
+import (
+	"testing"
+
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+func TestCheckoutLineItemsAddUpToTotal(t *testing.T) {
+	zones := map[int32]*eventpb.EventZone{
+		1: {ZoneNumber: 1, Name: "VIP"},
+		2: {ZoneNumber: 2, Name: "Standing"},
+	}
+	promotion := func(code string, amount int64) appliedPromotion {
+		return appliedPromotion{promotion: db.Promotion{Code: code}, amount: amount}
+	}
+
+	tests := []struct {
+		name       string
+		seats      []repositories.SeatInfo
+		promotions []appliedPromotion
+		policy     fees.Policy
+		wantTotal  int64
+	}{
+		{
+			name: "vat inclusive with fees",
+			seats: []repositories.SeatInfo{
+				{ZoneNumber: 1, RowNumber: 1, ColNumber: 1, Price: 150000},
+				{ZoneNumber: 1, RowNumber: 1, ColNumber: 2, Price: 150000},
+				{ZoneNumber: 2, Price: 99900},
+			},
+			policy:    fees.Policy{BookingFee: 2000, ProcessingFee: 1500, VATRate: 7, VATInclusive: true},
+			wantTotal: 407400,
+		},
+		{
+			name:      "vat exclusive rounded up",
+			seats:     []repositories.SeatInfo{{ZoneNumber: 1, RowNumber: 1, ColNumber: 1, Price: 99999}},
+			policy:    fees.Policy{VATRate: 7},
+			wantTotal: 106999,
+		},
+		{
+			name: "vat exclusive on a discounted order",
+			seats: []repositories.SeatInfo{
+				{ZoneNumber: 2, Price: 1000},
+				{ZoneNumber: 2, Price: 1000},
+				{ZoneNumber: 2, Price: 1000},
+			},
+			promotions: []appliedPromotion{promotion("EARLY", 100)},
+			policy:     fees.Policy{ProcessingFee: 50, VATRate: 7},
+			wantTotal:  3157,
+		},
+		{
+			name: "vat inclusive with two promo codes",
+			seats: []repositories.SeatInfo{
+				{ZoneNumber: 1, RowNumber: 2, ColNumber: 5, Price: 150000},
+				{ZoneNumber: 2, Price: 99900},
+			},
+			promotions: []appliedPromotion{promotion("EARLY", 10000), promotion("FRIENDS", 4995)},
+			policy:     fees.Policy{BookingFee: 2000, VATRate: 7, VATInclusive: true},
+			wantTotal:  238905,
+		},
+		{
+			name:  "yen with the default fees",
+			seats: []repositories.SeatInfo{{ZoneNumber: 2, Price: 8800}, {ZoneNumber: 2, Price: 8800}},
+			// 300 yen is 300 in the minor unit, JPY has no decimals
+			policy:    fees.NewCalculator(fees.Config{BookingFee: 300, VATRate: 10}).Policy(nil, "JPY"),
+			wantTotal: 20020,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var subtotal, discount int64
+			for _, seat := range tt.seats {
+				subtotal += seat.Price
+			}
+			for _, p := range tt.promotions {
+				discount += p.amount
+			}
+			breakdown := tt.policy.Apply(subtotal, discount, len(tt.seats))
+			if breakdown.Total != tt.wantTotal {
+				t.Fatalf("breakdown total = %d, want %d", breakdown.Total, tt.wantTotal)
+			}
+
+			var sum int64
+			for _, item := range checkoutLineItems(nil, zones, nil, tt.seats, tt.promotions, breakdown) {
+				// Stripe refuses negative unit amounts, discounts go to it as a coupon instead
+				if item.UnitAmount < 0 && item.Kind != entities.LineItemDiscount {
+					t.Errorf("%s line %q has unit amount %d", item.Kind, item.Name, item.UnitAmount)
+				}
+				sum += item.Amount()
+			}
+			if sum != breakdown.Total {
+				t.Errorf("line items add up to %d, want the total %d", sum, breakdown.Total)
+			}
+		})
+	}
+}
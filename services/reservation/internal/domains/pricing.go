@@ -0,0 +1,152 @@
+package domains
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// zonePricing is what a seat of a zone costs at a point in time, the price of the tier selling then or
+// the zone price when no tier is.
+type zonePricing struct {
+	price   *moneypb.Money
+	current *eventpb.PriceTier
+	next    *eventpb.PriceTier
+	sold    int64
+}
+
+// resolveZonePricing picks the current and next tier of a zone. The tiers come in the order they start,
+// the first one that started and neither ended nor sold through is current and the first one after it
+// that is not over yet is next.
+func resolveZonePricing(zone *eventpb.EventZone, sold int64, now time.Time) zonePricing {
+	pricing := zonePricing{price: zone.GetPrice(), sold: sold}
+	for _, tier := range zone.GetPriceTiers() {
+		if tierOver(tier, sold, now) {
+			continue
+		}
+		if pricing.current == nil && !tierTime(tier.GetStartsAt()).After(now) {
+			pricing.current = tier
+			pricing.price = tier.GetPrice()
+			continue
+		}
+		pricing.next = tier
+		break
+	}
+	return pricing
+}
+
+// tierOver reports a tier that ended or sold through.
+func tierOver(tier *eventpb.PriceTier, sold int64, now time.Time) bool {
+	if endsAt := tierTime(tier.GetEndsAt()); !endsAt.IsZero() && !now.Before(endsAt) {
+		return true
+	}
+	return tier.GetSellThrough() > 0 && sold >= int64(tier.GetSellThrough())
+}
+
+// tierTime reads a bound of a tier, the event service only stores RFC3339 so a value that does not
+// parse is left unset.
+func tierTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// zonesPricing resolves the pricing of every zone of the event against the tickets sold so far.
+func (r *ReserveDomainImpl) zonesPricing(ctx context.Context, eventID string, zones map[int32]*eventpb.EventZone, now time.Time) (map[int32]zonePricing, error) {
+	sold, err := r.repo.CountSoldTicketsByZone(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	pricing := make(map[int32]zonePricing, len(zones))
+	for zoneNumber, zone := range zones {
+		pricing[zoneNumber] = resolveZonePricing(zone, sold[zoneNumber], now)
+	}
+	return pricing, nil
+}
+
+// holdPrice is what a seat is held at, the price of its ticket type or else the price of the tier the zone
+// sells at. Ticket types keep their own price whatever tier the zone is in.
+func holdPrice(seat repositories.SeatInfo, pricing map[int32]zonePricing, ticketTypes map[string]*eventpb.TicketType) *moneypb.Money {
+	if ticketType, ok := ticketTypes[seat.TicketTypeID]; ok {
+		return ticketType.GetPrice()
+	}
+	return pricing[seat.ZoneNumber].price
+}
+
+// ticketPrice is the price a ticket was sold at, unset for tickets sold before prices were locked in.
+func ticketPrice(ticket db.Ticket, currency string) *moneypb.Money {
+	if ticket.Price == nil {
+		return nil
+	}
+	return toMoneyProto(*ticket.Price, currency)
+}
+
+func (r *ReserveDomainImpl) GetZonePricing(ctx context.Context, req *reservationpb.GetZonePricingRequest) (*reservationpb.GetZonePricingResponse, error) {
+	eventID := req.GetEventId()
+	if eventID == "" {
+		return nil, apperror.BadRequest("event ID required", nil)
+	}
+
+	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event zone", err)
+	}
+	zones := make(map[int32]*eventpb.EventZone, len(eventZone.GetList()))
+	for _, zone := range eventZone.GetList() {
+		zones[zone.GetZoneNumber()] = zone
+	}
+
+	now := time.Now()
+	pricing, err := r.zonesPricing(ctx, eventID, zones, now)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to count sold tickets", slog.Any("error", err), slog.String("eventID", eventID))
+		return nil, apperror.Internal("failed to count sold tickets", err)
+	}
+
+	response := make([]*reservationpb.ZonePricing, 0, len(eventZone.GetList()))
+	for _, zone := range eventZone.GetList() {
+		p := pricing[zone.GetZoneNumber()]
+		response = append(response, &reservationpb.ZonePricing{
+			ZoneNumber:  zone.GetZoneNumber(),
+			Price:       p.price,
+			CurrentTier: toZonePriceTierProto(p.current, p.sold),
+			NextTier:    toZonePriceTierProto(p.next, p.sold),
+			Sold:        int32(p.sold),
+		})
+	}
+
+	return &reservationpb.GetZonePricingResponse{
+		Zones:      response,
+		ResolvedAt: now.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func toZonePriceTierProto(tier *eventpb.PriceTier, sold int64) *reservationpb.ZonePriceTier {
+	if tier == nil {
+		return nil
+	}
+	proto := &reservationpb.ZonePriceTier{
+		Id:       tier.GetId(),
+		Name:     tier.GetName(),
+		Price:    tier.GetPrice(),
+		StartsAt: tier.GetStartsAt(),
+		EndsAt:   tier.GetEndsAt(),
+	}
+	if tier.GetSellThrough() > 0 {
+		remaining := int32(max(int64(tier.GetSellThrough())-sold, 0))
+		proto.Remaining = &remaining
+	}
+	return proto
+}
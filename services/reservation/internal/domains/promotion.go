@@ -0,0 +1,335 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// maxPromoCodes bounds the codes a single reservation can stack
+const maxPromoCodes = 3
+
+// appliedPromotion is the discount a promotion gives a reservation, in the minor unit of its currency.
+type appliedPromotion struct {
+	promotion db.Promotion
+	amount    int64
+}
+
+// redemptions turns the applied promotions into the redemptions stored with the reservation.
+func redemptions(applied []appliedPromotion) []repositories.PromotionRedemption {
+	result := make([]repositories.PromotionRedemption, len(applied))
+	for i, a := range applied {
+		result[i] = repositories.PromotionRedemption{
+			PromotionID:           pgUUIDToString(a.promotion.ID),
+			Amount:                a.amount,
+			MaxRedemptionsPerUser: a.promotion.MaxRedemptionsPerUser,
+		}
+	}
+	return result
+}
+
+func totalDiscount(applied []appliedPromotion) int64 {
+	var total int64
+	for _, a := range applied {
+		total += a.amount
+	}
+	return total
+}
+
+func toPromotionProto(promotion *db.Promotion) *reservationpb.Promotion {
+	promotionProto := &reservationpb.Promotion{
+		Id:                    pgUUIDToString(promotion.ID),
+		Code:                  promotion.Code,
+		DiscountType:          promotion.DiscountType,
+		PercentOff:            promotion.PercentOff,
+		AmountOff:             money.ToProto(money.New(promotion.AmountOff, promotion.Currency)),
+		EventId:               pgUUIDToString(promotion.EventID),
+		ZoneNumber:            promotion.ZoneNumber,
+		MaxRedemptions:        promotion.MaxRedemptions,
+		MaxRedemptionsPerUser: promotion.MaxRedemptionsPerUser,
+		RedemptionCount:       promotion.RedemptionCount,
+		Stackable:             promotion.Stackable,
+		Active:                promotion.Active,
+		CreatedAt:             promotion.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if promotion.StartsAt.Valid {
+		promotionProto.StartsAt = promotion.StartsAt.Time.Format(time.RFC3339)
+	}
+	if promotion.EndsAt.Valid {
+		promotionProto.EndsAt = promotion.EndsAt.Time.Format(time.RFC3339)
+	}
+	return promotionProto
+}
+
+// normalizePromoCode makes codes case insensitive, they are stored upper case.
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func parsePromotionTime(value string) (pgtype.Timestamptz, error) {
+	if value == "" {
+		return pgtype.Timestamptz{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return pgtype.Timestamptz{}, err
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}, nil
+}
+
+func (r *ReserveDomainImpl) CreatePromotion(ctx context.Context, req *reservationpb.CreatePromotionRequest) (*reservationpb.CreatePromotionResponse, error) {
+	code := normalizePromoCode(req.GetCode())
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code required")
+	}
+
+	var percentOff float64
+	var amountOff int64
+	currency := money.DefaultCurrency
+	switch entities.DiscountType(req.GetDiscountType()) {
+	case entities.DiscountPercentage:
+		percentOff = req.GetPercentOff()
+		if percentOff <= 0 || percentOff > 100 {
+			return nil, status.Error(codes.InvalidArgument, "a percentage discount must be above 0 and at most 100")
+		}
+	case entities.DiscountFixed:
+		amount := money.FromProto(req.GetAmountOff())
+		if amount.Currency != "" {
+			currency = strings.ToUpper(amount.Currency)
+		}
+		if !money.Supported(currency) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported currency: %s", currency))
+		}
+		amountOff = amount.Amount
+		if amountOff <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "a fixed discount must be positive")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "discountType must be PERCENTAGE or FIXED")
+	}
+
+	if req.GetMaxRedemptions() < 0 || req.GetMaxRedemptionsPerUser() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "redemption caps must not be negative")
+	}
+	if req.ZoneNumber != nil && req.GetEventId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "a zone scope needs an event")
+	}
+
+	var eventID pgtype.UUID
+	if req.GetEventId() != "" {
+		if err := eventID.Scan(req.GetEventId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid event ID")
+		}
+	}
+
+	startsAt, err := parsePromotionTime(req.GetStartsAt())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "startsAt must be RFC3339")
+	}
+	endsAt, err := parsePromotionTime(req.GetEndsAt())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "endsAt must be RFC3339")
+	}
+	if startsAt.Valid && endsAt.Valid && !startsAt.Time.Before(endsAt.Time) {
+		return nil, status.Error(codes.InvalidArgument, "startsAt must be before endsAt")
+	}
+
+	promotion, err := r.repo.CreatePromotion(ctx, db.CreatePromotionParams{
+		Code:                  code,
+		DiscountType:          req.GetDiscountType(),
+		PercentOff:            percentOff,
+		AmountOff:             amountOff,
+		EventID:               eventID,
+		ZoneNumber:            req.ZoneNumber,
+		MaxRedemptions:        req.GetMaxRedemptions(),
+		MaxRedemptionsPerUser: req.GetMaxRedemptionsPerUser(),
+		Stackable:             req.GetStackable(),
+		StartsAt:              startsAt,
+		EndsAt:                endsAt,
+		Currency:              currency,
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrPromotionCodeTaken) {
+			return nil, status.Error(codes.AlreadyExists, "promo code already exists")
+		}
+		logger.ErrorContext(ctx, "create promotion failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to create promotion", err)
+	}
+
+	logger.InfoContext(ctx, "promotion created", slog.String("promotionID", pgUUIDToString(promotion.ID)), slog.String("code", code))
+	return &reservationpb.CreatePromotionResponse{
+		Promotion: toPromotionProto(promotion),
+	}, nil
+}
+
+func (r *ReserveDomainImpl) ListPromotions(ctx context.Context, req *reservationpb.ListPromotionsRequest) (*reservationpb.ListPromotionsResponse, error) {
+	promotions, err := r.repo.ListPromotions(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "list promotions failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to list promotions", err)
+	}
+
+	promotionsProto := make([]*reservationpb.Promotion, 0, len(promotions))
+	for i := range promotions {
+		promotionsProto = append(promotionsProto, toPromotionProto(&promotions[i]))
+	}
+	return &reservationpb.ListPromotionsResponse{
+		Promotions: promotionsProto,
+	}, nil
+}
+
+// DeactivatePromotion stops a promotion from being redeemed, reservations that already redeemed it keep
+// their discount.
+func (r *ReserveDomainImpl) DeactivatePromotion(ctx context.Context, req *reservationpb.DeactivatePromotionRequest) (*reservationpb.DeactivatePromotionResponse, error) {
+	deactivated, err := r.repo.DeactivatePromotion(ctx, req.GetId())
+	if err != nil {
+		logger.ErrorContext(ctx, "deactivate promotion failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to deactivate promotion", err)
+	}
+	if !deactivated {
+		return nil, status.Error(codes.NotFound, "promotion not found")
+	}
+
+	logger.InfoContext(ctx, "promotion deactivated", slog.String("promotionID", req.GetId()))
+	return &reservationpb.DeactivatePromotionResponse{
+		Id: req.GetId(),
+	}, nil
+}
+
+// resolvePromotions checks the promo codes of a reservation and works out the discount each of them gives
+// the seats, prices[i] being the price of seats[i]. The caps are checked here for a clear error, they are enforced when the reservation is stored.
+// A fixed amount only comes off reservations in its own currency.
+func (r *ReserveDomainImpl) resolvePromotions(ctx context.Context, userID, eventID, currency string, promoCodes []string, seats []repositories.SeatInfo, prices []int64) ([]appliedPromotion, error) {
+	if len(promoCodes) == 0 {
+		return nil, nil
+	}
+	if len(promoCodes) > maxPromoCodes {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d promo codes can be used together", maxPromoCodes))
+	}
+
+	normalized := make([]string, 0, len(promoCodes))
+	for _, code := range promoCodes {
+		code = normalizePromoCode(code)
+		if slices.Contains(normalized, code) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s is used twice", code))
+		}
+		normalized = append(normalized, code)
+	}
+
+	found, err := r.repo.ListPromotionsByCodes(ctx, normalized)
+	if err != nil {
+		return nil, apperror.Internal("failed to get promotions", err)
+	}
+	byCode := make(map[string]db.Promotion, len(found))
+	for _, promotion := range found {
+		byCode[promotion.Code] = promotion
+	}
+
+	now := time.Now()
+	promotions := make([]db.Promotion, 0, len(normalized))
+	for _, code := range normalized {
+		promotion, ok := byCode[code]
+		if !ok || !promotion.Active {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s is not valid", code))
+		}
+		if promotion.StartsAt.Valid && now.Before(promotion.StartsAt.Time) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s is not valid yet", code))
+		}
+		if promotion.EndsAt.Valid && !now.Before(promotion.EndsAt.Time) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s has expired", code))
+		}
+		if promotion.EventID.Valid && pgUUIDToString(promotion.EventID) != eventID {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s is not valid for this event", code))
+		}
+		if promotion.DiscountType == string(entities.DiscountFixed) && promotion.Currency != currency {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s is in %s, the event sells in %s", code, promotion.Currency, currency))
+		}
+		if !promotion.Stackable && len(normalized) > 1 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s cannot be combined with other codes", code))
+		}
+
+		if promotion.MaxRedemptions > 0 && promotion.RedemptionCount >= promotion.MaxRedemptions {
+			return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("promo code %s is fully redeemed", code))
+		}
+		if promotion.MaxRedemptionsPerUser > 0 {
+			count, err := r.repo.CountUserPromotionRedemptions(ctx, pgUUIDToString(promotion.ID), userID)
+			if err != nil {
+				return nil, apperror.Internal("failed to count promotion redemptions", err)
+			}
+			if count >= int(promotion.MaxRedemptionsPerUser) {
+				return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("promo code %s was already used as often as allowed", code))
+			}
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	applied := discountSeats(promotions, seats, prices)
+	for _, a := range applied {
+		if a.amount <= 0 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s does not apply to the selected seats", a.promotion.Code))
+		}
+	}
+	return applied, nil
+}
+
+// discountSeats takes the promotions off the prices of the seats, prices[i] being the price of seats[i].
+// Percentages go first, each off what is left of the seats in its scope, then fixed amounts are taken off
+// the seats in scope one after the other until they are used up, so no seat goes below zero and the
+// outcome does not depend on the order the codes were given in. Amounts are in minor units,
+// a percentage of a seat is rounded half up.
+func discountSeats(promotions []db.Promotion, seats []repositories.SeatInfo, prices []int64) []appliedPromotion {
+	remaining := slices.Clone(prices)
+
+	ordered := slices.Clone(promotions)
+	slices.SortStableFunc(ordered, func(a, b db.Promotion) int {
+		if a.DiscountType == b.DiscountType {
+			return strings.Compare(a.Code, b.Code)
+		}
+		if a.DiscountType == string(entities.DiscountPercentage) {
+			return -1
+		}
+		return 1
+	})
+
+	applied := make([]appliedPromotion, 0, len(ordered))
+	for _, promotion := range ordered {
+		var amount int64
+		left := promotion.AmountOff
+		for i, seat := range seats {
+			if promotion.ZoneNumber != nil && *promotion.ZoneNumber != seat.ZoneNumber {
+				continue
+			}
+
+			var off int64
+			if promotion.DiscountType == string(entities.DiscountPercentage) {
+				off = money.Percent(remaining[i], promotion.PercentOff, money.RoundHalfUp)
+			} else {
+				off = min(left, remaining[i])
+				left -= off
+			}
+			remaining[i] -= off
+			amount += off
+		}
+		applied = append(applied, appliedPromotion{
+			promotion: promotion,
+			amount:    amount,
+		})
+	}
+	return applied
+}
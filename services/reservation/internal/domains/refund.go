@@ -0,0 +1,359 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// refundLease outlives the Stripe refund and the database transaction that records it.
+const refundLease = time.Minute
+
+func refundLockKey(reservationID string) string {
+	return fmt.Sprintf("reservation:refund:%s", reservationID)
+}
+
+// lockRefund keeps refunds of one reservation apart, overlapping refunds would
+// otherwise both pay out before either is recorded. The returned func releases the lock.
+func (r *ReserveDomainImpl) lockRefund(ctx context.Context, reservationID string) (func(), error) {
+	key := refundLockKey(reservationID)
+	owner := uuid.New().String()
+
+	held, err := r.repo.RenewLease(ctx, key, owner, refundLease)
+	if err != nil {
+		return nil, apperror.Internal("failed to lock reservation", err)
+	}
+	if !held {
+		return nil, status.Error(codes.FailedPrecondition, "another refund for this reservation is in progress")
+	}
+
+	return func() {
+		if err := r.repo.ReleaseLease(context.WithoutCancel(ctx), key, owner); err != nil {
+			logger.ErrorContext(ctx, "release refund lock failed", slog.Any("error", err))
+		}
+	}, nil
+}
+
+// refundPolicy returns the share of the price the event pays back, or an error once refunds are closed.
+func (r *ReserveDomainImpl) refundPolicy(ctx context.Context, eventID string) (int32, error) {
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		return 0, apperror.Internal("failed to get event", err)
+	}
+
+	percentage := event.GetEvent().GetRefundPercentage()
+	if percentage <= 0 {
+		return 0, status.Error(codes.FailedPrecondition, "refunds are not offered for this event")
+	}
+
+	eventDate, err := time.Parse(time.RFC3339, event.GetEvent().GetEventDate())
+	if err != nil {
+		return 0, apperror.Internal("failed to parse event date", err)
+	}
+	deadline := eventDate.Add(-time.Duration(event.GetEvent().GetRefundDeadlineHours()) * time.Hour)
+	if time.Now().After(deadline) {
+		return 0, status.Error(codes.FailedPrecondition, "refund deadline has passed")
+	}
+
+	return percentage, nil
+}
+
+// refundableReservation loads a reservation that its owner may still get money back for.
+func (r *ReserveDomainImpl) refundableReservation(ctx context.Context, reservationID, userID string) (*db.Reservation, error) {
+	reservation, err := r.repo.GetReservation(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.NotFound("reservation not found", err)
+	}
+
+	if pgUUIDToString(reservation.UserID) != userID {
+		return nil, apperror.Forbidden("reservation belongs to another user", nil)
+	}
+	if reservation.Status != string(entities.Confirmed) {
+		return nil, status.Error(codes.FailedPrecondition, "only confirmed reservations can be refunded")
+	}
+	return reservation, nil
+}
+
+// refundShare is the share of a price in minor units paid back under the refund percentage, rounded down so a
+// refund never exceeds the policy.
+func refundShare(price int64, percentage int32) int64 {
+	return price * int64(percentage) / 100
+}
+
+// RefundReservation cancels a confirmed booking of its owner and pays back what the
+// refund policy of the event allows. The tickets are soft-deleted and the seats sold again.
+func (r *ReserveDomainImpl) RefundReservation(ctx context.Context, req *reservationpb.RefundReservationRequest) (*reservationpb.RefundReservationResponse, error) {
+	reservationID := req.GetId()
+
+	unlock, err := r.lockRefund(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	reservation, err := r.refundableReservation(ctx, reservationID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID := pgUUIDToString(reservation.UserID)
+	eventID := pgUUIDToString(reservation.EventID)
+
+	tickets, err := r.repo.GetTicketsByReservation(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.Internal("failed to get tickets", err)
+	}
+	for _, ticket := range tickets {
+		if pgUUIDToString(ticket.OwnerID) != userID {
+			return nil, status.Error(codes.FailedPrecondition, "reservation has tickets transferred to other users, cancel the remaining tickets instead")
+		}
+		if ticket.CheckedInAt.Valid {
+			return nil, status.Error(codes.FailedPrecondition, "reservation has checked in tickets, cancel the remaining tickets instead")
+		}
+	}
+
+	percentage, err := r.refundPolicy(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := refundShare(reservation.TotalPrice, percentage)
+
+	// the payment is refunded first, a failure below is retried by the buyer with the same refund
+	if err := r.refundPayment(reservation.StripeSessionID, reservationID, "requested by customer", amount); err != nil {
+		logger.ErrorContext(ctx, "refund payment failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+		return nil, apperror.Internal("failed to refund reservation", err)
+	}
+
+	refunded, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.refunded", entities.RefundedNotiReservation{
+		ID:      reservationID,
+		UserID:  userID,
+		EventID: eventID,
+		Amount:  money.New(amount, reservation.Currency),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to build refund message", err)
+	}
+
+	tickets, err = r.repo.RefundReservationWithTickets(ctx, reservationID, amount, refunded)
+	if err != nil {
+		if errors.Is(err, repositories.ErrReservationNotConfirmed) {
+			return nil, status.Error(codes.FailedPrecondition, "reservation is no longer confirmed")
+		}
+		logger.ErrorContext(ctx, "refund reservation failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to refund reservation", err)
+	}
+
+	r.releaseTicketSeats(ctx, eventID, reservationID, tickets)
+
+	logger.InfoContext(ctx, "reservation refunded",
+		slog.String("reservationID", reservationID),
+		slog.Int("seats_count", len(tickets)),
+		slog.Int64("amount", amount))
+
+	return &reservationpb.RefundReservationResponse{
+		Id:             reservationID,
+		RefundedAmount: toMoneyProto(amount, reservation.Currency),
+		Status:         string(entities.Refunded),
+	}, nil
+}
+
+// CancelTickets gives back some tickets of a confirmed booking. Their zone prices are taken off
+// the total and refunded under the refund policy of the event, the other tickets stay valid.
+func (r *ReserveDomainImpl) CancelTickets(ctx context.Context, req *reservationpb.CancelTicketsRequest) (*reservationpb.CancelTicketsResponse, error) {
+	reservationID := req.GetId()
+
+	if len(req.GetTicketIds()) == 0 {
+		return nil, apperror.BadRequest("at least one ticket is required", nil)
+	}
+
+	unlock, err := r.lockRefund(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	reservation, err := r.refundableReservation(ctx, reservationID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	eventID := pgUUIDToString(reservation.EventID)
+
+	tickets, err := r.repo.GetTicketsByReservation(ctx, reservationID)
+	if err != nil {
+		return nil, apperror.Internal("failed to get tickets", err)
+	}
+	live := make(map[string]db.Ticket, len(tickets))
+	for _, ticket := range tickets {
+		live[pgUUIDToString(ticket.ID)] = ticket
+	}
+
+	cancelled := make(map[string]db.Ticket, len(req.GetTicketIds()))
+	for _, ticketID := range req.GetTicketIds() {
+		ticket, ok := live[ticketID]
+		if !ok {
+			return nil, apperror.BadRequest(fmt.Sprintf("ticket %s is not a ticket of this reservation", ticketID), nil)
+		}
+		if _, ok := cancelled[ticketID]; ok {
+			return nil, apperror.BadRequest(fmt.Sprintf("ticket %s is listed twice", ticketID), nil)
+		}
+		if pgUUIDToString(ticket.OwnerID) != req.GetUserId() {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("ticket %s was transferred to another user", ticketID))
+		}
+		if ticket.CheckedInAt.Valid {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("ticket %s was checked in", ticketID))
+		}
+		cancelled[ticketID] = ticket
+	}
+	if len(cancelled) == len(live) {
+		return nil, apperror.BadRequest("refund the reservation to cancel every ticket", nil)
+	}
+
+	percentage, err := r.refundPolicy(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event zone", err)
+	}
+	zones := make(map[int32]*eventpb.EventZone, len(eventZone.GetList()))
+	for _, zone := range eventZone.GetList() {
+		zones[zone.GetZoneNumber()] = zone
+	}
+	ticketTypes := indexTicketTypes(eventZone.GetList())
+
+	shares := ticketShares(tickets, reservation.Discount, func(ticket db.Ticket) int64 {
+		// tickets sold before prices were locked in count at the price of their zone or type today
+		if ticket.Price != nil {
+			return *ticket.Price
+		}
+		seat := repositories.SeatInfo{ZoneNumber: ticket.ZoneNumber, TicketTypeID: pgUUIDToString(ticket.TicketTypeID)}
+		return seatPrice(seat, zones, ticketTypes).GetAmount()
+	})
+
+	var removedSubtotal, removedDiscount int64
+	paid := make([]int64, len(req.GetTicketIds()))
+	for i, ticketID := range req.GetTicketIds() {
+		share := shares[ticketID]
+		removedSubtotal += share.price
+		removedDiscount += share.discount
+		paid[i] = share.paid()
+	}
+
+	// the tickets left are charged again under the fees the reservation was made with, the booking fee
+	// stays per ticket. Prices of unlocked tickets may have changed since the purchase, so nothing goes
+	// below zero and no more is paid back than was charged.
+	policy := fees.Policy{
+		BookingFee:    reservation.BookingFeePerTicket,
+		ProcessingFee: reservation.ProcessingFee,
+		VATRate:       reservation.VatRate,
+		VATInclusive:  reservation.VatInclusive,
+	}
+	breakdown := policy.Apply(
+		max(reservation.Subtotal-removedSubtotal, 0),
+		max(reservation.Discount-removedDiscount, 0),
+		len(live)-len(cancelled),
+	)
+	breakdown.Total = min(breakdown.Total, reservation.TotalPrice)
+	amount := refundShare(reservation.TotalPrice-breakdown.Total, percentage)
+
+	refunds := make(map[string]int64, len(cancelled))
+	for i, refund := range money.Allocate(amount, paid) {
+		refunds[req.GetTicketIds()[i]] = refund
+	}
+
+	if amount > 0 {
+		if err := r.refundPayment(reservation.StripeSessionID, reservationID, "tickets cancelled by customer", amount, req.GetTicketIds()...); err != nil {
+			logger.ErrorContext(ctx, "refund payment failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+			return nil, apperror.Internal("failed to refund tickets", err)
+		}
+	}
+
+	removed, err := r.repo.CancelTickets(ctx, reservationID, refunds, breakdown)
+	if err != nil {
+		if errors.Is(err, repositories.ErrReservationNotConfirmed) {
+			return nil, status.Error(codes.FailedPrecondition, "reservation is no longer confirmed")
+		}
+		if errors.Is(err, repositories.ErrTicketNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "tickets were cancelled or checked in meanwhile")
+		}
+		logger.ErrorContext(ctx, "cancel tickets failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to cancel tickets", err)
+	}
+
+	r.releaseTicketSeats(ctx, eventID, reservationID, removed)
+
+	logger.InfoContext(ctx, "tickets cancelled",
+		slog.String("reservationID", reservationID),
+		slog.Int("seats_count", len(removed)),
+		slog.Int64("amount", amount))
+
+	return &reservationpb.CancelTicketsResponse{
+		Id:             reservationID,
+		RefundedAmount: toMoneyProto(amount, reservation.Currency),
+		TotalPrice:     toMoneyProto(breakdown.Total, reservation.Currency),
+	}, nil
+}
+
+// ticketShare is the price of a ticket and its share of the discount of its reservation.
+type ticketShare struct {
+	price    int64
+	discount int64
+}
+
+// paid is what the ticket was paid, its price less its share of the discount.
+func (s ticketShare) paid() int64 {
+	return max(s.price-s.discount, 0)
+}
+
+// ticketShares splits the discount of a reservation over its live tickets in proportion to their prices.
+func ticketShares(tickets []db.Ticket, discount int64, price func(db.Ticket) int64) map[string]ticketShare {
+	prices := make([]int64, len(tickets))
+	for i, ticket := range tickets {
+		prices[i] = price(ticket)
+	}
+	discounts := money.Allocate(discount, prices)
+
+	shares := make(map[string]ticketShare, len(tickets))
+	for i, ticket := range tickets {
+		shares[pgUUIDToString(ticket.ID)] = ticketShare{price: prices[i], discount: discounts[i]}
+	}
+	return shares
+}
+
+// releaseTicketSeats offers the seats of given back tickets to the waitlist and puts the rest on sale again.
+// The tickets are committed as deleted already, a Redis failure only keeps the seats off sale.
+func (r *ReserveDomainImpl) releaseTicketSeats(ctx context.Context, eventID, reservationID string, tickets []db.Ticket) {
+	seats := make([]repositories.SeatInfo, len(tickets))
+	for i, ticket := range tickets {
+		seats[i] = repositories.SeatInfo{ZoneNumber: ticket.ZoneNumber, RowNumber: ticket.RowNumber, ColNumber: ticket.ColNumber}
+	}
+	seats = r.OfferReleasedSeats(ctx, eventID, reservationID, seats, true)
+	if err := r.repo.ReleaseSoldSeats(ctx, eventID, seats, reservationID); err != nil {
+		logger.ErrorContext(ctx, "release sold seats failed", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,91 @@
+package domains
+
+import (
+	"maps"
+	"testing"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestRefundShare(t *testing.T) {
+	tests := []struct {
+		name       string
+		price      int64
+		percentage int32
+		want       int64
+	}{
+		{name: "full refund", price: 150000, percentage: 100, want: 150000},
+		{name: "no refund", price: 150000, percentage: 0, want: 0},
+		{name: "exact share", price: 150000, percentage: 80, want: 120000},
+		{name: "half a unit rounds down", price: 101, percentage: 50, want: 50},
+		{name: "almost a unit rounds down", price: 199, percentage: 1, want: 1},
+		{name: "nothing paid", price: 0, percentage: 80, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refundShare(tt.price, tt.percentage); got != tt.want {
+				t.Errorf("refundShare(%d, %d) = %d, want %d", tt.price, tt.percentage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTicketShares(t *testing.T) {
+	ticket := func(id byte, price int64) db.Ticket {
+		return db.Ticket{ID: pgtype.UUID{Bytes: [16]byte{id}, Valid: true}, Price: &price}
+	}
+	id := func(id byte) string {
+		return pgUUIDToString(pgtype.UUID{Bytes: [16]byte{id}, Valid: true})
+	}
+	price := func(ticket db.Ticket) int64 { return *ticket.Price }
+
+	tests := []struct {
+		name     string
+		tickets  []db.Ticket
+		discount int64
+		want     map[string]ticketShare
+		paid     map[string]int64
+	}{
+		{
+			name:    "no discount",
+			tickets: []db.Ticket{ticket(1, 100000), ticket(2, 50000)},
+			want:    map[string]ticketShare{id(1): {price: 100000}, id(2): {price: 50000}},
+			paid:    map[string]int64{id(1): 100000, id(2): 50000},
+		},
+		{
+			name:     "discount in proportion to the prices",
+			tickets:  []db.Ticket{ticket(1, 100000), ticket(2, 50000)},
+			discount: 30000,
+			want:     map[string]ticketShare{id(1): {price: 100000, discount: 20000}, id(2): {price: 50000, discount: 10000}},
+			paid:     map[string]int64{id(1): 80000, id(2): 40000},
+		},
+		{
+			name:     "discount adds up exactly",
+			tickets:  []db.Ticket{ticket(1, 1000), ticket(2, 1000), ticket(3, 1000)},
+			discount: 100,
+			want:     map[string]ticketShare{id(1): {price: 1000, discount: 34}, id(2): {price: 1000, discount: 33}, id(3): {price: 1000, discount: 33}},
+			paid:     map[string]int64{id(1): 966, id(2): 967, id(3): 967},
+		},
+		{
+			name:     "a free ticket takes no discount",
+			tickets:  []db.Ticket{ticket(1, 0), ticket(2, 1000)},
+			discount: 1000,
+			want:     map[string]ticketShare{id(1): {price: 0}, id(2): {price: 1000, discount: 1000}},
+			paid:     map[string]int64{id(1): 0, id(2): 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ticketShares(tt.tickets, tt.discount, price)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("ticketShares() = %v, want %v", got, tt.want)
+			}
+			for ticketID, share := range got {
+				if share.paid() != tt.paid[ticketID] {
+					t.Errorf("paid() of %s = %d, want %d", ticketID, share.paid(), tt.paid[ticketID])
+				}
+			}
+		})
+	}
+}
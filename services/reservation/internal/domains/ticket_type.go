@@ -0,0 +1,50 @@
+package domains
+
+import (
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	moneypb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/money"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// indexTicketTypes maps the ticket types of every zone by their ID.
+func indexTicketTypes(zones []*eventpb.EventZone) map[string]*eventpb.TicketType {
+	ticketTypes := make(map[string]*eventpb.TicketType)
+	for _, zone := range zones {
+		for _, ticketType := range zone.GetTicketTypes() {
+			ticketTypes[ticketType.GetId()] = ticketType
+		}
+	}
+	return ticketTypes
+}
+
+// seatPrice is the price of the ticket type of a seat, or the zone price when it has none.
+func seatPrice(seat repositories.SeatInfo, zones map[int32]*eventpb.EventZone, ticketTypes map[string]*eventpb.TicketType) *moneypb.Money {
+	if ticketType, ok := ticketTypes[seat.TicketTypeID]; ok {
+		return ticketType.GetPrice()
+	}
+	return zones[seat.ZoneNumber].GetPrice()
+}
+
+// ticketTypeClaims counts the seats taken of every ticket type, in the order the types were first picked.
+func ticketTypeClaims(seats []repositories.SeatInfo, ticketTypes map[string]*eventpb.TicketType) []repositories.TicketTypeClaim {
+	var claims []repositories.TicketTypeClaim
+	index := make(map[string]int)
+	for _, seat := range seats {
+		ticketType, ok := ticketTypes[seat.TicketTypeID]
+		if !ok {
+			continue
+		}
+		i, ok := index[seat.TicketTypeID]
+		if !ok {
+			i = len(claims)
+			index[seat.TicketTypeID] = i
+			claims = append(claims, repositories.TicketTypeClaim{
+				TicketTypeID:         ticketType.GetId(),
+				QuantityCap:          ticketType.GetQuantityCap(),
+				RequiresVerification: ticketType.GetRequiresVerification(),
+			})
+		}
+		claims[i].Quantity++
+	}
+	return claims
+}
@@ -0,0 +1,289 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/credential"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// transferEvent gets the event of a ticket being transferred, it returns an error once the transfer
+// cutoff of the event has passed.
+func (r *ReserveDomainImpl) transferEvent(ctx context.Context, eventID string) (*eventpb.Event, error) {
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	eventDate, err := time.Parse(time.RFC3339, event.GetEvent().GetEventDate())
+	if err != nil {
+		return nil, apperror.Internal("failed to parse event date", err)
+	}
+	cutoff := eventDate.Add(-time.Duration(event.GetEvent().GetTransferCutoffHours()) * time.Hour)
+	if time.Now().After(cutoff) {
+		return nil, status.Error(codes.FailedPrecondition, "transfers for this event are closed")
+	}
+	return event.GetEvent(), nil
+}
+
+// ticketProto describes a ticket to its owner together with a freshly signed credential.
+func (r *ReserveDomainImpl) ticketProto(ticket *db.Ticket) (*reservationpb.Ticket, error) {
+	token, err := r.signer.Sign(credential.Claims{
+		TicketID:   pgUUIDToString(ticket.ID),
+		EventID:    pgUUIDToString(ticket.EventID),
+		ZoneNumber: ticket.ZoneNumber,
+		RowNumber:  ticket.RowNumber,
+		ColNumber:  ticket.ColNumber,
+		OwnerID:    pgUUIDToString(ticket.OwnerID),
+		Version:    ticket.CredentialVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transTicket := &reservationpb.Ticket{
+		Id:                   pgUUIDToString(ticket.ID),
+		EventId:              pgUUIDToString(ticket.EventID),
+		ReservationId:        pgUUIDToString(ticket.ReservationID),
+		ZoneNumber:           ticket.ZoneNumber,
+		Row:                  ticket.RowNumber,
+		Column:               ticket.ColNumber,
+		CredentialVersion:    ticket.CredentialVersion,
+		Credential:           token,
+		TicketTypeId:         pgUUIDToString(ticket.TicketTypeID),
+		RequiresVerification: ticket.RequiresVerification,
+	}
+	if ticket.CheckedInAt.Valid {
+		transTicket.CheckedInAt = ticket.CheckedInAt.Time.Format(time.RFC3339)
+	}
+	return transTicket, nil
+}
+
+func toTicketTransferProto(transfer *db.Tickettransfer, ticket *db.Ticket) *reservationpb.TicketTransfer {
+	return &reservationpb.TicketTransfer{
+		Id:         pgUUIDToString(transfer.ID),
+		TicketId:   pgUUIDToString(transfer.TicketID),
+		EventId:    pgUUIDToString(ticket.EventID),
+		FromUserId: pgUUIDToString(transfer.FromUserID),
+		ToUserId:   pgUUIDToString(transfer.ToUserID),
+		Status:     transfer.Status,
+		ZoneNumber: ticket.ZoneNumber,
+		Row:        ticket.RowNumber,
+		Column:     ticket.ColNumber,
+		CreatedAt:  transfer.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// ListTickets returns the live tickets a user owns, including tickets transferred to them.
+func (r *ReserveDomainImpl) ListTickets(ctx context.Context, req *reservationpb.ListTicketsRequest) (*reservationpb.ListTicketsResponse, error) {
+	tickets, err := r.repo.ListTicketsByOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to list tickets", err)
+	}
+
+	transTickets := make([]*reservationpb.Ticket, 0, len(tickets))
+	for i := range tickets {
+		transTicket, err := r.ticketProto(&tickets[i])
+		if err != nil {
+			return nil, apperror.Internal("failed to sign ticket credential", err)
+		}
+		transTickets = append(transTickets, transTicket)
+	}
+
+	return &reservationpb.ListTicketsResponse{
+		Tickets: transTickets,
+	}, nil
+}
+
+// OfferTicketTransfer offers a ticket of its owner to another user, who owns it once they accept.
+// Offering the ticket again withdraws the previous offer.
+func (r *ReserveDomainImpl) OfferTicketTransfer(ctx context.Context, req *reservationpb.OfferTicketTransferRequest) (*reservationpb.OfferTicketTransferResponse, error) {
+	userID := req.GetUserId()
+	toUserID := req.GetToUserId()
+
+	if _, err := uuid.Parse(toUserID); err != nil {
+		return nil, apperror.BadRequest("invalid recipient", err)
+	}
+	if toUserID == userID {
+		return nil, apperror.BadRequest("ticket can not be transferred to its owner", nil)
+	}
+
+	ticket, err := r.repo.GetTicket(ctx, req.GetTicketId())
+	if err != nil {
+		return nil, apperror.NotFound("ticket not found", err)
+	}
+	if pgUUIDToString(ticket.OwnerID) != userID {
+		return nil, apperror.Forbidden("ticket belongs to another user", nil)
+	}
+	if ticket.CheckedInAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "checked in tickets can not be transferred")
+	}
+
+	ticketID := pgUUIDToString(ticket.ID)
+	eventID := pgUUIDToString(ticket.EventID)
+
+	if _, err := r.transferEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	transferID := uuid.New().String()
+	offered, err := outbox.NewMessage(repositories.NotificationsQueue, "ticket.transfer_offered", entities.TransferOfferedNotiTicket{
+		ID:         transferID,
+		TicketID:   ticketID,
+		EventID:    eventID,
+		FromUserID: userID,
+		ToUserID:   toUserID,
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to build transfer message", err)
+	}
+
+	transfer, err := r.repo.OfferTicketTransfer(ctx, transferID, ticketID, userID, toUserID, offered)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferPending) {
+			return nil, status.Error(codes.FailedPrecondition, "ticket was offered meanwhile")
+		}
+		logger.ErrorContext(ctx, "offer ticket transfer failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to offer ticket transfer", err)
+	}
+
+	logger.InfoContext(ctx, "ticket transfer offered",
+		slog.String("transferID", transferID),
+		slog.String("ticketID", ticketID))
+
+	return &reservationpb.OfferTicketTransferResponse{
+		Transfer: toTicketTransferProto(transfer, ticket),
+	}, nil
+}
+
+// AcceptTicketTransfer hands the ticket to the recipient of a pending offer. The ticket gets a new
+// credential version, so credentials issued to the previous owner are no longer valid.
+func (r *ReserveDomainImpl) AcceptTicketTransfer(ctx context.Context, req *reservationpb.AcceptTicketTransferRequest) (*reservationpb.AcceptTicketTransferResponse, error) {
+	transfer, err := r.repo.GetTicketTransfer(ctx, req.GetId())
+	if err != nil {
+		return nil, apperror.NotFound("transfer not found", err)
+	}
+
+	if pgUUIDToString(transfer.ToUserID) != req.GetUserId() {
+		return nil, apperror.Forbidden("transfer was offered to another user", nil)
+	}
+	if transfer.Status != string(entities.TransferPending) {
+		return nil, status.Error(codes.FailedPrecondition, "transfer is no longer pending")
+	}
+
+	ticket, err := r.repo.GetTicket(ctx, pgUUIDToString(transfer.TicketID))
+	if err != nil {
+		return nil, apperror.NotFound("ticket not found", err)
+	}
+
+	// a refund of the reservation checks who owns its tickets before paying out, keep it from overlapping
+	unlock, err := r.lockRefund(ctx, pgUUIDToString(ticket.ReservationID))
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	transferID := pgUUIDToString(transfer.ID)
+	ticketID := pgUUIDToString(ticket.ID)
+	eventID := pgUUIDToString(ticket.EventID)
+
+	event, err := r.transferEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the ticket counts towards the limit per user of the recipient like one they bought
+	if limit := event.GetMaxTicketsPerUser(); limit > 0 {
+		unlock, err := r.lockTicketLimit(ctx, req.GetUserId(), eventID)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+
+		if err := r.checkTicketLimit(ctx, req.GetUserId(), eventID, limit, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	transferred, err := outbox.NewMessage(repositories.NotificationsQueue, "ticket.transferred", entities.TransferredNotiTicket{
+		TransferID: transferID,
+		TicketID:   ticketID,
+		EventID:    eventID,
+		FromUserID: pgUUIDToString(transfer.FromUserID),
+		ToUserID:   pgUUIDToString(transfer.ToUserID),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to build transfer message", err)
+	}
+
+	ticket, err = r.repo.AcceptTicketTransfer(ctx, *transfer, transferred)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferNotPending) {
+			return nil, status.Error(codes.FailedPrecondition, "transfer is no longer pending")
+		}
+		if errors.Is(err, repositories.ErrTicketNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "ticket was given back or transferred meanwhile")
+		}
+		logger.ErrorContext(ctx, "accept ticket transfer failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to accept ticket transfer", err)
+	}
+	transfer.Status = string(entities.TransferAccepted)
+
+	transTicket, err := r.ticketProto(ticket)
+	if err != nil {
+		return nil, apperror.Internal("failed to sign ticket credential", err)
+	}
+
+	logger.InfoContext(ctx, "ticket transferred",
+		slog.String("transferID", transferID),
+		slog.String("ticketID", ticketID))
+
+	return &reservationpb.AcceptTicketTransferResponse{
+		Transfer: toTicketTransferProto(transfer, ticket),
+		Ticket:   transTicket,
+	}, nil
+}
+
+// ListTicketTransfers returns the pending transfers offered by or to the user.
+func (r *ReserveDomainImpl) ListTicketTransfers(ctx context.Context, req *reservationpb.ListTicketTransfersRequest) (*reservationpb.ListTicketTransfersResponse, error) {
+	transfers, err := r.repo.ListPendingTicketTransfers(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to list ticket transfers", err)
+	}
+
+	transTransfers := make([]*reservationpb.TicketTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		transTransfers = append(transTransfers, &reservationpb.TicketTransfer{
+			Id:         pgUUIDToString(transfer.ID),
+			TicketId:   pgUUIDToString(transfer.TicketID),
+			EventId:    pgUUIDToString(transfer.EventID),
+			FromUserId: pgUUIDToString(transfer.FromUserID),
+			ToUserId:   pgUUIDToString(transfer.ToUserID),
+			Status:     transfer.Status,
+			ZoneNumber: transfer.ZoneNumber,
+			Row:        transfer.RowNumber,
+			Column:     transfer.ColNumber,
+			CreatedAt:  transfer.CreatedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	return &reservationpb.ListTicketTransfersResponse{
+		Transfers: transTransfers,
+	}, nil
+}
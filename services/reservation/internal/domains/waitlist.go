@@ -0,0 +1,322 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/apperror"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+	reservationpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/reservation"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+const (
+	// WaitlistOfferWindow is the time a waitlisted user has to pay for the seats offered to them.
+	WaitlistOfferWindow = 15 * time.Minute
+	WaitlistOfferTTL    = WaitlistOfferWindow + SafetyBuffer
+
+	// waitlistOfferBatch bounds the entries looked at per zone when seats are given back.
+	waitlistOfferBatch = 50
+)
+
+func toWaitlistEntryProto(entry *db.Waitlistentry) *reservationpb.WaitlistEntry {
+	entryProto := &reservationpb.WaitlistEntry{
+		Id:            pgUUIDToString(entry.ID),
+		EventId:       pgUUIDToString(entry.EventID),
+		ZoneNumber:    entry.ZoneNumber,
+		Quantity:      entry.Quantity,
+		Status:        entry.Status,
+		ReservationId: pgUUIDToString(entry.ReservationID),
+		CreatedAt:     entry.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if entry.OfferExpiresAt.Valid {
+		entryProto.OfferExpiresAt = entry.OfferExpiresAt.Time.Format(time.RFC3339)
+	}
+	return entryProto
+}
+
+// JoinWaitlist puts the user in line for seats given back in a zone of an event, zone 0 takes any zone.
+func (r *ReserveDomainImpl) JoinWaitlist(ctx context.Context, req *reservationpb.JoinWaitlistRequest) (*reservationpb.JoinWaitlistResponse, error) {
+	if _, err := uuid.Parse(req.GetEventId()); err != nil {
+		return nil, apperror.BadRequest("invalid event id", err)
+	}
+	if req.GetQuantity() <= 0 {
+		return nil, apperror.BadRequest("quantity must be positive", nil)
+	}
+	if req.GetZoneNumber() < 0 {
+		return nil, apperror.BadRequest("invalid zone number", nil)
+	}
+
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: req.GetEventId(),
+	})
+	if err != nil {
+		return nil, apperror.Internal("failed to get event", err)
+	}
+
+	if req.GetZoneNumber() > 0 {
+		eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+			EventId: req.GetEventId(),
+		})
+		if err != nil {
+			return nil, apperror.Internal("failed to get event zone", err)
+		}
+
+		found := false
+		for _, zone := range eventZone.GetList() {
+			if zone.GetZoneNumber() == req.GetZoneNumber() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, apperror.BadRequest("invalid zone number", nil)
+		}
+	}
+
+	if limit := event.GetEvent().GetMaxTicketsPerUser(); limit > 0 {
+		if err := r.checkTicketLimit(ctx, req.GetUserId(), req.GetEventId(), limit, int(req.GetQuantity())); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := r.repo.JoinWaitlist(ctx, req.GetUserId(), req.GetEventId(), req.GetZoneNumber(), req.GetQuantity())
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlreadyWaitlisted) {
+			return nil, status.Error(codes.AlreadyExists, "already on the waitlist for this zone")
+		}
+		logger.ErrorContext(ctx, "join waitlist failed", slog.Any("error", err))
+		return nil, apperror.Internal("failed to join waitlist", err)
+	}
+
+	logger.InfoContext(ctx, "waitlist joined",
+		slog.String("entryID", pgUUIDToString(entry.ID)),
+		slog.String("eventID", req.GetEventId()),
+		slog.Int("zone", int(req.GetZoneNumber())))
+
+	return &reservationpb.JoinWaitlistResponse{
+		Entry: toWaitlistEntryProto(entry),
+	}, nil
+}
+
+// LeaveWaitlist takes a waiting entry off the waitlist. An entry holding an offer is settled
+// by paying or cancelling its reservation instead.
+func (r *ReserveDomainImpl) LeaveWaitlist(ctx context.Context, req *reservationpb.LeaveWaitlistRequest) (*reservationpb.LeaveWaitlistResponse, error) {
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, apperror.BadRequest("invalid waitlist entry id", err)
+	}
+
+	left, err := r.repo.LeaveWaitlist(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to leave waitlist", err)
+	}
+	if !left {
+		entry, err := r.repo.GetWaitlistEntry(ctx, req.GetId())
+		if err != nil || pgUUIDToString(entry.UserID) != req.GetUserId() {
+			return nil, apperror.NotFound("waitlist entry not found", err)
+		}
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("waitlist entry is %s", entry.Status))
+	}
+
+	return &reservationpb.LeaveWaitlistResponse{
+		Id: req.GetId(),
+	}, nil
+}
+
+// ListWaitlistEntries returns the entries of a user that still wait or hold an offer.
+func (r *ReserveDomainImpl) ListWaitlistEntries(ctx context.Context, req *reservationpb.ListWaitlistEntriesRequest) (*reservationpb.ListWaitlistEntriesResponse, error) {
+	entries, err := r.repo.ListWaitlistEntries(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apperror.Internal("failed to list waitlist entries", err)
+	}
+
+	entriesProto := make([]*reservationpb.WaitlistEntry, 0, len(entries))
+	for i := range entries {
+		entriesProto = append(entriesProto, toWaitlistEntryProto(&entries[i]))
+	}
+
+	return &reservationpb.ListWaitlistEntriesResponse{
+		Entries: entriesProto,
+	}, nil
+}
+
+// OfferReleasedSeats offers the seats a reservation gives back to the waitlist before they go on sale.
+// Entries are served first come first, an entry is skipped while its quantity does not fit in the seats
+// left of a zone. Each offer is a PENDING reservation holding the seats for WaitlistOfferWindow, when it
+// expires unpaid its seats come back here for the next entry. Sold seats are those of refunded tickets.
+// The seats nobody was offered are returned for the caller to release.
+func (r *ReserveDomainImpl) OfferReleasedSeats(ctx context.Context, eventID, reservationID string, seats []repositories.SeatInfo, sold bool) []repositories.SeatInfo {
+	// the reservation may itself be an offer that was not taken
+	if err := r.repo.CloseWaitlistOffer(ctx, reservationID, entities.WaitlistExpired); err != nil {
+		logger.ErrorContext(ctx, "close waitlist offer failed", slog.String("reservationID", reservationID), slog.Any("error", err))
+	}
+
+	if len(seats) == 0 {
+		return seats
+	}
+
+	event, err := r.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
+		Id: eventID,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "get event for waitlist failed", slog.Any("error", err))
+		return seats
+	}
+
+	eventZone, err := r.eventClient.GetEventZoneByEventId(ctx, &eventpb.GetEventZoneByEventIdRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "get event zone for waitlist failed", slog.Any("error", err))
+		return seats
+	}
+
+	eventZones := make(map[int32]*eventpb.EventZone)
+	for _, zone := range eventZone.GetList() {
+		eventZones[zone.GetZoneNumber()] = zone
+	}
+
+	pricing, err := r.zonesPricing(ctx, eventID, eventZones, time.Now())
+	if err != nil {
+		logger.ErrorContext(ctx, "count sold tickets for waitlist failed", slog.Any("error", err))
+		return seats
+	}
+
+	byZone := make(map[int32][]repositories.SeatInfo)
+	zones := make([]int32, 0)
+	for _, seat := range seats {
+		// waitlist offers are sold at the price the zone sells at now, whatever ticket type or tier the
+		// seat was sold as before
+		seat.TicketTypeID = ""
+		seat.Price = pricing[seat.ZoneNumber].price.GetAmount()
+		if _, ok := byZone[seat.ZoneNumber]; !ok {
+			zones = append(zones, seat.ZoneNumber)
+		}
+		byZone[seat.ZoneNumber] = append(byZone[seat.ZoneNumber], seat)
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i] < zones[j] })
+
+	for _, zone := range zones {
+		entries, err := r.repo.ListWaitingEntries(ctx, eventID, zone, waitlistOfferBatch)
+		if err != nil {
+			logger.ErrorContext(ctx, "list waiting entries failed", slog.Any("error", err))
+			continue
+		}
+
+		for _, entry := range entries {
+			free := byZone[zone]
+			if len(free) == 0 {
+				break
+			}
+			if int(entry.Quantity) > len(free) {
+				continue
+			}
+
+			// a failed offer releases its seats itself, they are not offered again
+			byZone[zone] = free[entry.Quantity:]
+			if err := r.offerWaitlistEntry(ctx, entry, reservationID, free[:entry.Quantity], event.GetEvent(), eventZones, sold); err != nil {
+				logger.ErrorContext(ctx, "offer waitlist entry failed",
+					slog.String("entryID", pgUUIDToString(entry.ID)),
+					slog.Any("error", err))
+			}
+		}
+	}
+
+	leftover := make([]repositories.SeatInfo, 0, len(seats))
+	for _, zone := range zones {
+		leftover = append(leftover, byZone[zone]...)
+	}
+	return leftover
+}
+
+// offerWaitlistEntry hands the seats given back by a reservation over to a new PENDING reservation of
+// the waitlisted user and notifies them. On failure the seats handed over are released.
+func (r *ReserveDomainImpl) offerWaitlistEntry(ctx context.Context, entry db.Waitlistentry, fromReservationID string, seats []repositories.SeatInfo, event *eventpb.Event, zones map[int32]*eventpb.EventZone, sold bool) (err error) {
+	reservationID := uuid.New().String()
+	userID := pgUUIDToString(entry.UserID)
+	eventID := pgUUIDToString(entry.EventID)
+	expiresAt := time.Now().Add(WaitlistOfferWindow)
+
+	// scheduled before the handover so seats stranded by a crash below are still swept
+	if err = r.repo.ScheduleHoldExpiry(ctx, repositories.HoldExpiry{
+		ReservationID: reservationID,
+		UserID:        userID,
+		EventID:       eventID,
+		Seats:         seats,
+	}, expiresAt.Add(SafetyBuffer)); err != nil {
+		return fmt.Errorf("failed to schedule hold expiry: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackReservation(ctx, r.repo, userID, eventID, reservationID, seats)
+		}
+	}()
+
+	moved, err := r.repo.HandOverSeats(ctx, eventID, seats, fromReservationID, reservationID, sold, WaitlistOfferTTL)
+	if err != nil {
+		return fmt.Errorf("failed to hand over seats: %w", err)
+	}
+	if len(moved) < len(seats) {
+		return fmt.Errorf("%d of %d seats were taken meanwhile", len(seats)-len(moved), len(seats))
+	}
+
+	if err = r.repo.CreateReservationTemp(ctx, userID, reservationID, WaitlistOfferTTL); err != nil {
+		return fmt.Errorf("failed to cache reservation: %w", err)
+	}
+	if err = r.repo.CacheReservationSeats(ctx, reservationID, seats, WaitlistOfferTTL); err != nil {
+		return fmt.Errorf("failed to cache seats: %w", err)
+	}
+
+	currency := money.FromProto(zones[seats[0].ZoneNumber].GetPrice()).Currency
+	var subtotal int64
+	for _, seat := range seats {
+		subtotal += seat.Price
+	}
+	breakdown := r.fees.Policy(event, currency).Apply(subtotal, 0, len(seats))
+	lineItems := checkoutLineItems(event, zones, nil, seats, nil, breakdown)
+	totalPrice := money.New(breakdown.Total, currency)
+	session, err := r.newCheckoutSession(reservationID, currency, breakdown, lineItems, expiresAt.Add(SafetyBuffer))
+	if err != nil {
+		return fmt.Errorf("failed to create stripe session: %w", err)
+	}
+
+	message, err := outbox.NewMessage(repositories.NotificationsQueue, "waitlist.offered", entities.OfferedNotiWaitlist{
+		ID:            pgUUIDToString(entry.ID),
+		ReservationID: reservationID,
+		UserID:        userID,
+		EventID:       eventID,
+		ZoneNumber:    seats[0].ZoneNumber,
+		Quantity:      entry.Quantity,
+		TotalPrice:    totalPrice,
+		ExpiresAt:     expiresAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		discardCheckoutSession(ctx, session)
+		return err
+	}
+
+	if err = r.repo.OfferWaitlistEntry(ctx, entry, reservationID, session.ID, currency, breakdown, lineItems, expiresAt, message); err != nil {
+		discardCheckoutSession(ctx, session)
+		return fmt.Errorf("failed to offer waitlist entry: %w", err)
+	}
+
+	logger.InfoContext(ctx, "waitlist seats offered",
+		slog.String("entryID", pgUUIDToString(entry.ID)),
+		slog.String("reservationID", reservationID),
+		slog.Int("seats_count", len(seats)))
+	return nil
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "time"
+import (
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+)
 
 type ReservationStatus string
 
@@ -8,6 +12,71 @@ const (
 	Confirmed ReservationStatus = "CONFIRMED"
 	Pending   ReservationStatus = "PENDING"
 	Cancelled ReservationStatus = "CANCELLED"
+	Refunded  ReservationStatus = "REFUNDED"
+)
+
+type TicketAction string
+
+const (
+	TicketIssued      TicketAction = "ISSUED"
+	TicketCancelled   TicketAction = "CANCELLED"
+	TicketRefunded    TicketAction = "REFUNDED"
+	TicketTransferred TicketAction = "TRANSFERRED"
+	TicketCheckedIn   TicketAction = "CHECKED_IN"
+)
+
+type TransferStatus string
+
+const (
+	TransferPending   TransferStatus = "PENDING"
+	TransferAccepted  TransferStatus = "ACCEPTED"
+	TransferCancelled TransferStatus = "CANCELLED"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting WaitlistStatus = "WAITING"
+	WaitlistOffered WaitlistStatus = "OFFERED"
+	WaitlistClaimed WaitlistStatus = "CLAIMED"
+	WaitlistExpired WaitlistStatus = "EXPIRED"
+	WaitlistLeft    WaitlistStatus = "LEFT"
+)
+
+type BallotStatus string
+
+const (
+	BallotApplied   BallotStatus = "APPLIED"
+	BallotWon       BallotStatus = "WON"
+	BallotLost      BallotStatus = "LOST"
+	BallotWithdrawn BallotStatus = "WITHDRAWN"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+type LineItemKind string
+
+const (
+	LineItemTicket   LineItemKind = "TICKET"
+	LineItemFee      LineItemKind = "FEE"
+	LineItemDiscount LineItemKind = "DISCOUNT"
+	LineItemTax      LineItemKind = "TAX"
+)
+
+type ScanStatus string
+
+const (
+	ScanAdmitted   ScanStatus = "ADMITTED"
+	ScanDuplicate  ScanStatus = "DUPLICATE"
+	ScanRevoked    ScanStatus = "REVOKED"
+	ScanSuperseded ScanStatus = "SUPERSEDED"
+	ScanUnpaid     ScanStatus = "UNPAID"
+	ScanInvalid    ScanStatus = "INVALID"
 )
 
 type SeatStatus string
@@ -18,6 +87,13 @@ const (
 	SeatReserved  SeatStatus = "RESERVED"
 )
 
+type ZoneType string
+
+const (
+	ZoneSeated           ZoneType = "SEATED"
+	ZoneGeneralAdmission ZoneType = "GENERAL_ADMISSION"
+)
+
 type Reservation struct {
 	ID         string            `json:"id"`
 	UserID     string            `json:"user_id"`
@@ -26,16 +102,16 @@ type Reservation struct {
 	CreatedAt  time.Time         `json:"created_at"`
 	UpdatedAt  time.Time         `json:"updated_at"`
 	Status     ReservationStatus `json:"status"`
-	TotalPrice float64           `json:"total_price"`
+	TotalPrice money.Money       `json:"total_price"`
 }
 
 type Ticket struct {
-	ID            string    `json:"id"`
-	EventID       string    `json:"event_id"`
-	ReservationID string    `json:"reservation_id"`
-	Price         float64   `json:"price"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            string      `json:"id"`
+	EventID       string      `json:"event_id"`
+	ReservationID string      `json:"reservation_id"`
+	Price         money.Money `json:"price"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
 }
 
 type Event struct {
@@ -55,6 +131,68 @@ type ConfirmedNotiReservation struct {
 }
 
 type CancelledNotiReservation struct {
-	ID     string `json:"id"`
-	UserID string `json:"user_id"`
+	ID      string `json:"id"`
+	UserID  string `json:"user_id"`
+	EventID string `json:"event_id,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type ExtendedNotiReservation struct {
+	ID       string  `json:"id"`
+	UserID   string  `json:"user_id"`
+	EventID  string  `json:"event_id"`
+	TimeLeft float64 `json:"time_left"`
+}
+
+type RefundedNotiReservation struct {
+	ID      string      `json:"id"`
+	UserID  string      `json:"user_id"`
+	EventID string      `json:"event_id"`
+	Amount  money.Money `json:"amount"`
+}
+
+type TransferOfferedNotiTicket struct {
+	ID         string `json:"id"`
+	TicketID   string `json:"ticket_id"`
+	EventID    string `json:"event_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+type TransferredNotiTicket struct {
+	TransferID string `json:"transfer_id"`
+	TicketID   string `json:"ticket_id"`
+	EventID    string `json:"event_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+}
+
+type OfferedNotiWaitlist struct {
+	ID            string      `json:"id"`
+	ReservationID string      `json:"reservation_id"`
+	UserID        string      `json:"user_id"`
+	EventID       string      `json:"event_id"`
+	ZoneNumber    int32       `json:"zone_number"`
+	Quantity      int32       `json:"quantity"`
+	TotalPrice    money.Money `json:"total_price"`
+	ExpiresAt     string      `json:"expires_at"`
+}
+
+type WonNotiBallot struct {
+	ID              string `json:"id"`
+	ReservationID   string `json:"reservation_id"`
+	UserID          string `json:"user_id"`
+	EventID         string `json:"event_id"`
+	ZoneNumber      int32  `json:"zone_number"`
+	Quantity        int32  `json:"quantity"`
+	PaymentDeadline string `json:"payment_deadline"`
+}
+
+type LostNotiBallot struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	EventID    string `json:"event_id"`
+	ZoneNumber int32  `json:"zone_number"`
+	Quantity   int32  `json:"quantity"`
+	Reason     string `json:"reason,omitempty"`
 }
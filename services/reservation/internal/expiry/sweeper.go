@@ -0,0 +1,174 @@
+package expiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+const (
+	leaderLeaseKey = "reservation:expiry:leader"
+	leaderLease    = 10 * time.Second
+	sweepInterval  = time.Second
+	sweepBatchSize = 100
+)
+
+// WaitlistOfferer offers seats given back by a reservation to the waitlist and returns the seats left over.
+type WaitlistOfferer interface {
+	OfferReleasedSeats(ctx context.Context, eventID, reservationID string, seats []repositories.SeatInfo, sold bool) []repositories.SeatInfo
+}
+
+// Sweeper cancels reservations whose hold deadline passed.
+// Only the replica holding the leader lease sweeps, the others stand by to take over.
+type Sweeper struct {
+	repo     repositories.ReservationRepository
+	waitlist WaitlistOfferer
+	instance string
+	isLeader bool
+}
+
+func NewSweeper(repo repositories.ReservationRepository, waitlist WaitlistOfferer) *Sweeper {
+	return &Sweeper{
+		repo:     repo,
+		waitlist: waitlist,
+		instance: uuid.New().String(),
+	}
+}
+
+// Start sweeps due holds on every interval until ctx is done.
+// Deadlines missed while no replica was running are picked up on the first sweep.
+func (s *Sweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	logger.InfoContext(ctx, "hold expiry sweeper started", slog.String("instance", s.instance))
+
+	for {
+		select {
+		case <-ticker.C:
+			if !s.lead(ctx) {
+				continue
+			}
+			if err := s.Sweep(ctx); err != nil {
+				logger.ErrorContext(ctx, "hold expiry sweep failed", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "hold expiry sweeper stopped")
+			return
+		}
+	}
+}
+
+func (s *Sweeper) lead(ctx context.Context) bool {
+	held, err := s.repo.RenewLease(ctx, leaderLeaseKey, s.instance, leaderLease)
+	if err != nil {
+		logger.ErrorContext(ctx, "renew sweeper lease failed", slog.Any("error", err))
+		held = false
+	}
+
+	if held != s.isLeader {
+		logger.InfoContext(ctx, "hold expiry sweeper leadership changed",
+			slog.String("instance", s.instance),
+			slog.Bool("leader", held))
+		s.isLeader = held
+	}
+	return held
+}
+
+// Sweep expires every hold whose deadline has passed.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	for {
+		ids, err := s.repo.ListDueHoldExpiries(ctx, time.Now(), sweepBatchSize)
+		if err != nil {
+			return err
+		}
+
+		failed := false
+		for _, id := range ids {
+			if err := s.expire(ctx, id); err != nil {
+				// the entry stays in the set and is retried on the next sweep
+				logger.ErrorContext(ctx, "expire hold failed", slog.String("reservationID", id), slog.Any("error", err))
+				failed = true
+			}
+		}
+
+		if failed || len(ids) < sweepBatchSize {
+			return nil
+		}
+	}
+}
+
+// expire is idempotent, an entry is only removed from the set after all its steps succeeded.
+func (s *Sweeper) expire(ctx context.Context, reservationID string) error {
+	hold, err := s.repo.GetHoldExpiry(ctx, reservationID)
+	if err != nil {
+		return err
+	}
+	if hold == nil {
+		return s.repo.RemoveHoldExpiry(ctx, reservationID)
+	}
+
+	// the hold may have been extended after the deadline was read
+	timeLeft, err := s.repo.GetReservationTimeLeft(ctx, hold.UserID, reservationID)
+	if err != nil {
+		return err
+	}
+	if timeLeft > 0 {
+		return s.repo.RescheduleHoldExpiry(ctx, reservationID, time.Now().Add(timeLeft))
+	}
+
+	message, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.cancelled", entities.CancelledNotiReservation{
+		ID:      reservationID,
+		UserID:  hold.UserID,
+		EventID: hold.EventID,
+		Reason:  "hold expired",
+	})
+	if err != nil {
+		return err
+	}
+
+	cancelled, err := s.repo.TransitionReservationStatus(ctx, reservationID, string(entities.Pending), string(entities.Cancelled), message)
+	if err != nil {
+		return err
+	}
+
+	release := cancelled
+	if !cancelled {
+		// a retried sweep finds the reservation cancelled already, a hold without
+		// a row was abandoned half way through CreateReservation, confirmed seats stay
+		current, err := s.repo.GetReservationStatus(ctx, reservationID)
+		if err != nil {
+			return err
+		}
+		release = current == "" || current == string(entities.Cancelled)
+	}
+
+	if release {
+		seats := hold.Seats
+		if cancelled {
+			// a retry releases all seats, those handed over to an offer are not owned anymore
+			seats = s.waitlist.OfferReleasedSeats(ctx, hold.EventID, reservationID, seats, false)
+		}
+		if err := s.repo.ReleaseExpiredSeats(ctx, hold.EventID, seats, reservationID); err != nil {
+			return err
+		}
+	}
+
+	if cancelled {
+		logger.InfoContext(ctx, "reservation hold expired",
+			slog.String("reservationID", reservationID),
+			slog.Int("seats_count", len(hold.Seats)))
+	}
+
+	s.repo.DeleteReservationTemp(ctx, hold.UserID, reservationID)
+	s.repo.DeleteReservationSeats(ctx, reservationID)
+
+	return s.repo.RemoveHoldExpiry(ctx, reservationID)
+}
@@ -0,0 +1,104 @@
+// Package fees works out the booking fee, processing fee and VAT a reservation is charged on top of, or
+// including, the price of its tickets.
+package fees
+
+import (
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	eventpb "github.com/cp-rektmart/aconcert-microservice/pkg/proto/event"
+)
+
+// Config is what events are charged unless they set their own fees. Fees are in major units of the
+// currency of the event, 20 is 20 baht for an event sold in THB.
+type Config struct {
+	BookingFee    float64 `env:"BOOKING_FEE" envDefault:"0"`
+	ProcessingFee float64 `env:"PROCESSING_FEE" envDefault:"0"`
+	VATRate       float64 `env:"VAT_RATE" envDefault:"7"`
+	VATInclusive  bool    `env:"VAT_INCLUSIVE" envDefault:"true"`
+}
+
+// Policy is what one event charges, fees in the minor unit of its currency. The booking fee is charged
+// per ticket, the processing fee once per order and VATRate is in percent.
+type Policy struct {
+	BookingFee    int64
+	ProcessingFee int64
+	VATRate       float64
+	VATInclusive  bool
+}
+
+// Breakdown is what a reservation is charged, in the minor unit of its currency. Total is Subtotal less
+// Discount plus the fees, plus Tax unless VATInclusive, in which case Tax is the VAT already inside it.
+// BookingFee is BookingFeePerTicket for every ticket.
+type Breakdown struct {
+	Subtotal            int64
+	Discount            int64
+	BookingFee          int64
+	BookingFeePerTicket int64
+	ProcessingFee       int64
+	Tax                 int64
+	VATRate             float64
+	VATInclusive        bool
+	Total               int64
+}
+
+type Calculator struct {
+	defaults Config
+}
+
+func NewCalculator(defaults Config) *Calculator {
+	return &Calculator{
+		defaults: defaults,
+	}
+}
+
+// Policy is what an event sold in currency charges, the fees it sets in place of the defaults.
+func (c *Calculator) Policy(event *eventpb.Event, currency string) Policy {
+	policy := Policy{
+		BookingFee:    money.FromMajor(c.defaults.BookingFee, currency).Amount,
+		ProcessingFee: money.FromMajor(c.defaults.ProcessingFee, currency).Amount,
+		VATRate:       c.defaults.VATRate,
+		VATInclusive:  c.defaults.VATInclusive,
+	}
+
+	overrides := event.GetFees()
+	if overrides == nil {
+		return policy
+	}
+	if overrides.BookingFee != nil {
+		policy.BookingFee = overrides.GetBookingFee()
+	}
+	if overrides.ProcessingFee != nil {
+		policy.ProcessingFee = overrides.GetProcessingFee()
+	}
+	if overrides.VatRate != nil {
+		policy.VATRate = overrides.GetVatRate()
+	}
+	if overrides.VatInclusive != nil {
+		policy.VATInclusive = overrides.GetVatInclusive()
+	}
+	return policy
+}
+
+// Apply breaks down an order of tickets costing subtotal, with discount taken off it by promo codes. The
+// VAT is charged on the discounted tickets and the fees, rounded to the nearest minor unit.
+func (p Policy) Apply(subtotal, discount int64, tickets int) Breakdown {
+	b := Breakdown{
+		Subtotal:            subtotal,
+		Discount:            discount,
+		BookingFee:          p.BookingFee * int64(tickets),
+		BookingFeePerTicket: p.BookingFee,
+		ProcessingFee:       p.ProcessingFee,
+		VATRate:             p.VATRate,
+		VATInclusive:        p.VATInclusive,
+	}
+
+	taxable := subtotal - discount + b.BookingFee + b.ProcessingFee
+	if p.VATInclusive {
+		// the part of a price including VAT that is the VAT, 7/107 of it at 7%
+		b.Tax = money.Percent(taxable, 100*p.VATRate/(100+p.VATRate), money.RoundHalfUp)
+		b.Total = taxable
+	} else {
+		b.Tax = money.Percent(taxable, p.VATRate, money.RoundHalfUp)
+		b.Total = taxable + b.Tax
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+package fees
+
+import "testing"
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   Policy
+		subtotal int64
+		discount int64
+		tickets  int
+		want     Breakdown
+	}{
+		{
+			name:     "no fees and no vat",
+			policy:   Policy{},
+			subtotal: 300000,
+			tickets:  2,
+			want:     Breakdown{Subtotal: 300000, Total: 300000},
+		},
+		{
+			name:     "vat inclusive is taken out of the total",
+			policy:   Policy{BookingFee: 2000, ProcessingFee: 1000, VATRate: 7, VATInclusive: true},
+			subtotal: 100000,
+			tickets:  2,
+			// taxable 100000 + 4000 + 1000 = 105000, 7/107 of it is 6869.16
+			want: Breakdown{Subtotal: 100000, BookingFee: 4000, BookingFeePerTicket: 2000, ProcessingFee: 1000, Tax: 6869, VATRate: 7, VATInclusive: true, Total: 105000},
+		},
+		{
+			name:     "vat inclusive rounds half up",
+			policy:   Policy{VATRate: 7, VATInclusive: true},
+			subtotal: 1605,
+			tickets:  1,
+			// 7/107 of 1605 is exactly 105
+			want: Breakdown{Subtotal: 1605, Tax: 105, VATRate: 7, VATInclusive: true, Total: 1605},
+		},
+		{
+			name:     "vat exclusive is added on top",
+			policy:   Policy{BookingFee: 2000, ProcessingFee: 1000, VATRate: 7},
+			subtotal: 100000,
+			tickets:  2,
+			want:     Breakdown{Subtotal: 100000, BookingFee: 4000, BookingFeePerTicket: 2000, ProcessingFee: 1000, Tax: 7350, VATRate: 7, Total: 112350},
+		},
+		{
+			name:     "vat exclusive rounds half up",
+			policy:   Policy{VATRate: 7},
+			subtotal: 150,
+			tickets:  1,
+			// 7% of 150 is 10.5
+			want: Breakdown{Subtotal: 150, Tax: 11, VATRate: 7, Total: 161},
+		},
+		{
+			name:     "vat exclusive rounds below half down",
+			policy:   Policy{VATRate: 7},
+			subtotal: 135,
+			tickets:  1,
+			// 7% of 135 is 9.45
+			want: Breakdown{Subtotal: 135, Tax: 9, VATRate: 7, Total: 144},
+		},
+		{
+			name:     "vat is charged on the discounted price",
+			policy:   Policy{BookingFee: 1000, VATRate: 7},
+			subtotal: 50000,
+			discount: 10000,
+			tickets:  1,
+			want:     Breakdown{Subtotal: 50000, Discount: 10000, BookingFee: 1000, BookingFeePerTicket: 1000, Tax: 2870, VATRate: 7, Total: 43870},
+		},
+		{
+			name:     "no tickets charges no booking fee",
+			policy:   Policy{BookingFee: 1000, ProcessingFee: 500},
+			subtotal: 0,
+			tickets:  0,
+			want:     Breakdown{BookingFeePerTicket: 1000, ProcessingFee: 500, Total: 500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Apply(tt.subtotal, tt.discount, tt.tickets); got != tt.want {
+				t.Errorf("Apply(%d, %d, %d) = %+v, want %+v", tt.subtotal, tt.discount, tt.tickets, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package reconciliation
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/repositories"
+)
+
+// gracePeriod protects holds and reservations that are still being created,
+// seat keys are written before the reservation:temp key and the database row.
+const gracePeriod = time.Minute
+
+// Summary counts the corrections made by one reconciliation pass.
+type Summary struct {
+	SeatsSeeded           int
+	OrphanedHoldsCleared  int
+	ReservationsCancelled int
+}
+
+// Reconciler brings the Redis seat state back in line with Postgres.
+// Postgres is the source of truth for sold seats, Redis for live holds.
+type Reconciler struct {
+	repo     repositories.ReservationRepository
+	interval time.Duration
+	holdTTL  time.Duration
+}
+
+func New(repo repositories.ReservationRepository, interval, holdTTL time.Duration) *Reconciler {
+	return &Reconciler{
+		repo:     repo,
+		interval: interval,
+		holdTTL:  holdTTL,
+	}
+}
+
+// Start runs a reconciliation pass on every interval until ctx is done.
+func (r *Reconciler) Start(ctx context.Context) {
+	if r.interval <= 0 {
+		logger.InfoContext(ctx, "reconciliation schedule disabled")
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.Run(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Run performs a single reconciliation pass and logs what it corrected.
+func (r *Reconciler) Run(ctx context.Context) {
+	startedAt := time.Now()
+
+	summary, err := r.Reconcile(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "reconciliation failed", slog.Any("error", err))
+	}
+
+	logger.InfoContext(ctx, "reconciliation finished",
+		slog.Int("seats_seeded", summary.SeatsSeeded),
+		slog.Int("orphaned_holds_cleared", summary.OrphanedHoldsCleared),
+		slog.Int("reservations_cancelled", summary.ReservationsCancelled),
+		slog.Duration("duration", time.Since(startedAt)))
+}
+
+// Reconcile re-seeds sold seats, clears holds without a live reservation and
+// cancels reservations that stayed PENDING past their hold.
+func (r *Reconciler) Reconcile(ctx context.Context) (Summary, error) {
+	var summary Summary
+
+	seeded, err := r.seedReservedSeats(ctx)
+	summary.SeatsSeeded = seeded
+	if err != nil {
+		return summary, err
+	}
+
+	cleared, err := r.clearOrphanedHolds(ctx)
+	summary.OrphanedHoldsCleared = cleared
+	if err != nil {
+		return summary, err
+	}
+
+	cancelled, err := r.cancelStaleReservations(ctx)
+	summary.ReservationsCancelled = cancelled
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+func (r *Reconciler) seedReservedSeats(ctx context.Context) (int, error) {
+	eventIDs, err := r.repo.ListTicketEventIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, eventID := range eventIDs {
+		tickets, err := r.repo.ListTicketsByEventID(ctx, eventID)
+		if err != nil {
+			return total, err
+		}
+
+		seeded, err := r.repo.SeedReservedSeats(ctx, eventID, tickets)
+		total += len(seeded)
+		for _, seat := range seeded {
+			logger.WarnContext(ctx, "reconciliation: re-seeded sold seat",
+				slog.String("eventID", eventID),
+				slog.Int("zone", int(seat.ZoneNumber)),
+				slog.Int("row", int(seat.RowNumber)),
+				slog.Int("col", int(seat.ColNumber)))
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+func (r *Reconciler) clearOrphanedHolds(ctx context.Context) (int, error) {
+	// holds are listed before live reservations, a hold is always written before its reservation:temp key
+	holds, err := r.repo.ListSeatHolds(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	live, err := r.repo.ListLiveReservationIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var cleared int
+	for _, hold := range holds {
+		if _, ok := live[hold.ReservationID]; ok {
+			continue
+		}
+		if hold.TTL > r.holdTTL-gracePeriod {
+			continue
+		}
+
+		seats := []repositories.SeatInfo{hold.Seat}
+		if err := r.repo.ReleaseSeats(ctx, hold.EventID, seats, hold.ReservationID); err != nil {
+			return cleared, err
+		}
+		cleared++
+
+		logger.WarnContext(ctx, "reconciliation: cleared orphaned seat hold",
+			slog.String("eventID", hold.EventID),
+			slog.String("reservationID", hold.ReservationID),
+			slog.Int("zone", int(hold.Seat.ZoneNumber)),
+			slog.Int("row", int(hold.Seat.RowNumber)),
+			slog.Int("col", int(hold.Seat.ColNumber)))
+	}
+
+	return cleared, nil
+}
+
+func (r *Reconciler) cancelStaleReservations(ctx context.Context) (int, error) {
+	live, err := r.repo.ListLiveReservationIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reservations, err := r.repo.ListStalePendingReservations(ctx, time.Now().Add(-r.holdTTL-gracePeriod))
+	if err != nil {
+		return 0, err
+	}
+
+	var cancelled int
+	for _, reservation := range reservations {
+		reservationID := reservation.ID.String()
+		if _, ok := live[reservationID]; ok {
+			continue
+		}
+
+		message, err := outbox.NewMessage(repositories.NotificationsQueue, "reservation.cancelled", entities.CancelledNotiReservation{
+			ID:      reservationID,
+			UserID:  reservation.UserID.String(),
+			EventID: reservation.EventID.String(),
+			Reason:  "hold expired",
+		})
+		if err != nil {
+			return cancelled, err
+		}
+
+		changed, err := r.repo.TransitionReservationStatus(ctx, reservationID, string(entities.Pending), string(entities.Cancelled), message)
+		if err != nil {
+			return cancelled, err
+		}
+		if !changed {
+			continue
+		}
+		cancelled++
+
+		// the cached seat list is usually gone by now, orphaned holds are cleared on the next pass otherwise
+		if seats, err := r.repo.GetReservationSeats(ctx, reservationID); err == nil {
+			r.repo.ReleaseSeats(ctx, reservation.EventID.String(), seats, reservationID)
+			r.repo.DeleteReservationSeats(ctx, reservationID)
+		}
+
+		logger.WarnContext(ctx, "reconciliation: cancelled stale pending reservation",
+			slog.String("reservationID", reservationID),
+			slog.String("userID", reservation.UserID.String()),
+			slog.Time("createdAt", reservation.CreatedAt.Time))
+	}
+
+	return cancelled, nil
+}
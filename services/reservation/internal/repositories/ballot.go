@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ballotApplicationActiveIndex = "ballot_application_active_idx"
+
+var (
+	// ErrAlreadyApplied is returned when the user already has an application in the ballot of the event.
+	ErrAlreadyApplied = errors.New("already applied to the ballot")
+	// ErrBallotApplicationSettled is returned when an application was drawn or withdrawn meanwhile.
+	ErrBallotApplicationSettled = errors.New("ballot application is already settled")
+)
+
+func isAlreadyAppliedViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == ballotApplicationActiveIndex
+}
+
+// ApplyBallot enters the user in the ballot of an event for a quantity of tickets in a zone.
+func (r *ReservationImpl) ApplyBallot(ctx context.Context, userID, eventID string, zoneNumber, quantity int32) (*db.Ballotapplication, error) {
+	application, err := r.db.CreateBallotApplication(ctx, db.CreateBallotApplicationParams{
+		EventID:    stringToUUID(eventID),
+		UserID:     stringToUUID(userID),
+		ZoneNumber: zoneNumber,
+		Quantity:   quantity,
+	})
+	if err != nil {
+		if isAlreadyAppliedViolation(err) {
+			return nil, ErrAlreadyApplied
+		}
+		return nil, err
+	}
+	return &application, nil
+}
+
+func (r *ReservationImpl) GetBallotApplication(ctx context.Context, id string) (*db.Ballotapplication, error) {
+	application, err := r.db.GetBallotApplication(ctx, stringToUUID(id))
+	if err != nil {
+		return nil, err
+	}
+	return &application, nil
+}
+
+// ListBallotApplications returns the applications of the user, latest first.
+func (r *ReservationImpl) ListBallotApplications(ctx context.Context, userID string) ([]db.Ballotapplication, error) {
+	return r.db.ListBallotApplicationsByUserID(ctx, stringToUUID(userID))
+}
+
+// WithdrawBallotApplication takes an application of the user out of the ballot. It reports false if the
+// application is not the user's or was already drawn or withdrawn.
+func (r *ReservationImpl) WithdrawBallotApplication(ctx context.Context, id, userID string) (bool, error) {
+	affected, err := r.db.WithdrawBallotApplication(ctx, db.WithdrawBallotApplicationParams{
+		ID:     stringToUUID(id),
+		UserID: stringToUUID(userID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// ListBallotEventIDs returns the events with applications still waiting for the draw.
+func (r *ReservationImpl) ListBallotEventIDs(ctx context.Context) ([]string, error) {
+	ids, err := r.db.ListBallotEventIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		eventIDs = append(eventIDs, uuidToString(id))
+	}
+	return eventIDs, nil
+}
+
+// CountAppliedBallotApplications returns how many applications of the event wait for the draw.
+func (r *ReservationImpl) CountAppliedBallotApplications(ctx context.Context, eventID string) (int, error) {
+	count, err := r.db.CountAppliedBallotApplications(ctx, stringToUUID(eventID))
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// ListAppliedBallotApplicationIDs returns the applications of the event waiting for the draw, sorted by id.
+func (r *ReservationImpl) ListAppliedBallotApplicationIDs(ctx context.Context, eventID string) ([]string, error) {
+	ids, err := r.db.ListAppliedBallotApplicationIDs(ctx, stringToUUID(eventID))
+	if err != nil {
+		return nil, err
+	}
+
+	applicationIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		applicationIDs = append(applicationIDs, uuidToString(id))
+	}
+	return applicationIDs, nil
+}
+
+// CreateBallotDraw stores the draw of an event unless one is stored already, the stored draw is returned
+// so replicas racing for the same event settle it in the same order.
+func (r *ReservationImpl) CreateBallotDraw(ctx context.Context, eventID string, seed int64, applicationIDs []string) (*db.Ballotdraw, error) {
+	ids := make([]pgtype.UUID, 0, len(applicationIDs))
+	for _, id := range applicationIDs {
+		ids = append(ids, stringToUUID(id))
+	}
+
+	if err := r.db.CreateBallotDraw(ctx, db.CreateBallotDrawParams{
+		EventID:        stringToUUID(eventID),
+		Seed:           seed,
+		ApplicationIds: ids,
+	}); err != nil {
+		return nil, err
+	}
+	return r.GetBallotDraw(ctx, eventID)
+}
+
+// GetBallotDraw returns the draw of an event, or nil if it was not drawn yet.
+func (r *ReservationImpl) GetBallotDraw(ctx context.Context, eventID string) (*db.Ballotdraw, error) {
+	draw, err := r.db.GetBallotDraw(ctx, stringToUUID(eventID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &draw, nil
+}
+
+func (r *ReservationImpl) CompleteBallotDraw(ctx context.Context, eventID string) error {
+	return r.db.CompleteBallotDraw(ctx, stringToUUID(eventID))
+}
+
+// SettleBallotApplication records the outcome of an application at its place in the draw and stores the
+// outbox messages in one transaction. A won application points to the reservation holding its seats.
+// ErrBallotApplicationSettled is returned if the application was settled meanwhile.
+func (r *ReservationImpl) SettleBallotApplication(ctx context.Context, id string, status entities.BallotStatus, reservationID string, position int32, messages ...outbox.Message) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		affected, err := queries.SettleBallotApplication(ctx, db.SettleBallotApplicationParams{
+			NewStatus:     string(status),
+			ReservationID: stringToUUID(reservationID),
+			DrawPosition:  &position,
+			ID:            stringToUUID(id),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to settle ballot application: %w", err)
+		}
+		if affected == 0 {
+			return ErrBallotApplicationSettled
+		}
+
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrTicketCheckedIn is returned together with the ticket when it was checked in before.
+	ErrTicketCheckedIn = errors.New("ticket already checked in")
+	// ErrCredentialSuperseded is returned when the ticket changed owner after the credential was issued.
+	ErrCredentialSuperseded = errors.New("credential superseded")
+	// ErrTicketNotPaid is returned for tickets of reservations that are not confirmed.
+	ErrTicketNotPaid = errors.New("ticket not paid")
+)
+
+// OfflineScan is an admission recorded by a door device while it could not reach the service.
+// The domain verified the credential it was read from.
+type OfflineScan struct {
+	TicketID          string
+	OwnerID           string
+	CredentialVersion int32
+	GateID            string
+	ScannedAt         time.Time
+}
+
+// CheckInTicket marks a live ticket checked in at the gate and records it in the ticket history in one
+// transaction. The owner and credential version must still match the scanned credential.
+// ErrTicketNotFound is returned if the ticket was given back, ErrCredentialSuperseded if it was
+// transferred since, ErrTicketNotPaid if its reservation is not confirmed and ErrTicketCheckedIn,
+// with the ticket as checked in before, on a second scan.
+func (r *ReservationImpl) CheckInTicket(ctx context.Context, ticketID, ownerID string, credentialVersion int32, gateID string) (*db.Ticket, error) {
+	var ticket db.Ticket
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		var err error
+		ticket, err = queries.CheckInTicket(ctx, db.CheckInTicketParams{
+			GateID:            &gateID,
+			ID:                stringToUUID(ticketID),
+			OwnerID:           stringToUUID(ownerID),
+			CredentialVersion: credentialVersion,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return explainCheckInRefusal(ctx, queries, ticketID, ownerID, credentialVersion, &ticket)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to check in ticket: %w", err)
+		}
+
+		return insertTicketHistory(ctx, queries, []db.Ticket{ticket}, entities.TicketCheckedIn, nil)
+	})
+
+	if err != nil {
+		if errors.Is(err, ErrTicketCheckedIn) {
+			return &ticket, err
+		}
+		return nil, err
+	}
+
+	return &ticket, nil
+}
+
+// ListEventScanTickets lists the tickets of an event that were paid for, given back ones included as revoked.
+func (r *ReservationImpl) ListEventScanTickets(ctx context.Context, eventID string) ([]db.ListEventScanTicketsRow, error) {
+	return r.db.ListEventScanTickets(ctx, stringToUUID(eventID))
+}
+
+// RecordOfflineCheckIn records an offline scan as the check-in of the ticket. Scans are resolved by their
+// timestamp: a scan earlier than the recorded check-in replaces it, so the check-in always names the gate
+// that admitted the ticket first. The ticket is returned with its state before the scan, the previous
+// check-in is set there if it was replaced. The errors are those of CheckInTicket, ErrTicketCheckedIn is
+// returned together with the ticket when it was checked in at or before the time of the scan.
+func (r *ReservationImpl) RecordOfflineCheckIn(ctx context.Context, scan OfflineScan) (*db.Ticket, *db.Ticket, error) {
+	var ticket, previous db.Ticket
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		var err error
+		previous, err = queries.GetTicketForUpdate(ctx, stringToUUID(scan.TicketID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTicketNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock ticket: %w", err)
+		}
+
+		ticket, err = queries.RecordOfflineCheckIn(ctx, db.RecordOfflineCheckInParams{
+			ScannedAt:         pgtype.Timestamptz{Time: scan.ScannedAt, Valid: true},
+			GateID:            &scan.GateID,
+			ID:                previous.ID,
+			OwnerID:           stringToUUID(scan.OwnerID),
+			CredentialVersion: scan.CredentialVersion,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			ticket = previous
+			return checkInRefusal(previous, scan.OwnerID, scan.CredentialVersion)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record offline check in: %w", err)
+		}
+
+		// an earlier scan corrects the recorded check-in, the ticket history already has it
+		if previous.CheckedInAt.Valid {
+			return nil
+		}
+		return insertTicketHistory(ctx, queries, []db.Ticket{ticket}, entities.TicketCheckedIn, nil)
+	})
+
+	if err != nil {
+		if errors.Is(err, ErrTicketCheckedIn) {
+			return &ticket, nil, err
+		}
+		return nil, nil, err
+	}
+
+	return &ticket, &previous, nil
+}
+
+// explainCheckInRefusal finds out why a ticket could not be checked in and loads it into ticket.
+func explainCheckInRefusal(ctx context.Context, queries *db.Queries, ticketID, ownerID string, credentialVersion int32, ticket *db.Ticket) error {
+	current, err := queries.GetTicketByID(ctx, stringToUUID(ticketID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTicketNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	*ticket = current
+	return checkInRefusal(current, ownerID, credentialVersion)
+}
+
+// checkInRefusal tells why a credential for the owner and credential version did not check in the ticket.
+func checkInRefusal(ticket db.Ticket, ownerID string, credentialVersion int32) error {
+	switch {
+	case ticket.DeletedAt.Valid:
+		return ErrTicketNotFound
+	case uuidToString(ticket.OwnerID) != ownerID || ticket.CredentialVersion != credentialVersion:
+		return ErrCredentialSuperseded
+	case ticket.CheckedInAt.Valid:
+		return ErrTicketCheckedIn
+	default:
+		// live tickets are refused otherwise only while their reservation awaits payment
+		return ErrTicketNotPaid
+	}
+}
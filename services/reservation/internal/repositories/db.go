@@ -2,12 +2,38 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/cp-rektmart/aconcert-microservice/pkg/money"
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const ticketSeatUniqueIndex = "ticket_event_seat_unique_idx"
+
+var (
+	// ErrSeatAlreadySold is returned when a ticket already exists for one of the requested seats.
+	ErrSeatAlreadySold = errors.New("seat already sold")
+	// ErrReservationNotPending is returned when a reservation left PENDING before it could be confirmed.
+	ErrReservationNotPending = errors.New("reservation is not pending")
+	// ErrReservationNotConfirmed is returned when a reservation left CONFIRMED before it could be refunded.
+	ErrReservationNotConfirmed = errors.New("reservation is not confirmed")
+	// ErrTicketNotFound is returned when a ticket to cancel is not a live ticket of the reservation.
+	ErrTicketNotFound = errors.New("ticket not found")
+)
+
+func isSeatUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == ticketSeatUniqueIndex
+}
+
 func (r *ReservationImpl) GetReservation(ctx context.Context, id string) (*db.Reservation, error) {
 	uuid := stringToUUID(id)
 	reservation, err := r.db.GetReservation(ctx, uuid)
@@ -17,23 +43,54 @@ func (r *ReservationImpl) GetReservation(ctx context.Context, id string) (*db.Re
 	return &reservation, nil
 }
 
-func (r *ReservationImpl) CreateReservation(ctx context.Context, reservationID string, userID, eventID, status, stripeSessionID string, totalPrice float64) (*db.Reservation, error) {
-	params := db.CreateReservationParams{
-		ID:              stringToUUID(reservationID),
-		UserID:          stringToUUID(userID),
-		EventID:         stringToUUID(eventID),
-		Status:          status,
-		TotalPrice:      totalPrice,
-		StripeSessionID: stripeSessionID,
-	}
+// CreateReservation stores a pending reservation charged breakdown, together with its line items and the
+// promo codes it redeems. A promotion whose cap is reached meanwhile fails the whole reservation.
+func (r *ReservationImpl) CreateReservation(ctx context.Context, reservationID string, userID, eventID, status, stripeSessionID, currency string, breakdown fees.Breakdown, lineItems []LineItem, claims []TicketTypeClaim, redemptions ...PromotionRedemption) (*db.Reservation, error) {
+	params := newReservationParams(stringToUUID(reservationID), stringToUUID(userID), stringToUUID(eventID), status, stripeSessionID, currency, breakdown)
 
-	reservation, err := r.db.CreateReservation(ctx, params)
+	var reservation db.Reservation
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		var err error
+		reservation, err = queries.CreateReservation(ctx, params)
+		if err != nil {
+			return err
+		}
+		if err := insertLineItems(ctx, queries, params.ID, lineItems); err != nil {
+			return err
+		}
+		if err := claimTicketTypes(ctx, queries, params.ID, claims); err != nil {
+			return err
+		}
+		return redeemPromotions(ctx, queries, params.ID, params.UserID, redemptions)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &reservation, nil
 }
 
+func newReservationParams(id, userID, eventID pgtype.UUID, status, stripeSessionID, currency string, breakdown fees.Breakdown) db.CreateReservationParams {
+	return db.CreateReservationParams{
+		ID:                  id,
+		UserID:              userID,
+		EventID:             eventID,
+		Status:              status,
+		TotalPrice:          breakdown.Total,
+		StripeSessionID:     stripeSessionID,
+		Currency:            currency,
+		Subtotal:            breakdown.Subtotal,
+		Discount:            breakdown.Discount,
+		BookingFee:          breakdown.BookingFee,
+		ProcessingFee:       breakdown.ProcessingFee,
+		Tax:                 breakdown.Tax,
+		VatRate:             breakdown.VATRate,
+		VatInclusive:        breakdown.VATInclusive,
+		BookingFeePerTicket: breakdown.BookingFeePerTicket,
+	}
+}
+
 func (r *ReservationImpl) UpdateReservationStatus(ctx context.Context, id, status string) (*db.Reservation, error) {
 	params := db.UpdateReservationStatusParams{
 		ID:     stringToUUID(id),
@@ -47,9 +104,37 @@ func (r *ReservationImpl) UpdateReservationStatus(ctx context.Context, id, statu
 	return &reservation, nil
 }
 
+// DeleteReservation removes a reservation and gives its promo codes and ticket types back.
+// ReplaceStripeSession points a pending reservation at a new checkout session, the payment of the old one
+// no longer finds it.
+func (r *ReservationImpl) ReplaceStripeSession(ctx context.Context, reservationID, sessionID string) error {
+	affected, err := r.db.UpdateReservationStripeSession(ctx, db.UpdateReservationStripeSessionParams{
+		StripeSessionID: sessionID,
+		ID:              stringToUUID(reservationID),
+		Status:          string(entities.Pending),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update stripe session: %w", err)
+	}
+	if affected == 0 {
+		return ErrReservationNotPending
+	}
+	return nil
+}
+
 func (r *ReservationImpl) DeleteReservation(ctx context.Context, id string) error {
 	uuid := stringToUUID(id)
-	return r.db.DeleteReservation(ctx, uuid)
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		if err := queries.DeleteReservation(ctx, uuid); err != nil {
+			return err
+		}
+		if err := queries.ReleaseTicketTypeClaims(ctx, uuid); err != nil {
+			return err
+		}
+		return queries.ReleasePromotionRedemptions(ctx, uuid)
+	})
 }
 
 func (r *ReservationImpl) CreateTicket(ctx context.Context, eventID, reservationID string, seat SeatInfo) (*db.Ticket, error) {
@@ -59,6 +144,8 @@ func (r *ReservationImpl) CreateTicket(ctx context.Context, eventID, reservation
 		RowNumber:     seat.RowNumber,
 		ColNumber:     seat.ColNumber,
 		EventID:       stringToUUID(eventID),
+		TicketTypeID:  stringToUUID(seat.TicketTypeID),
+		Price:         &seat.Price,
 	}
 
 	ticket, err := r.db.CreateTicket(ctx, params)
@@ -73,6 +160,9 @@ func (r *ReservationImpl) CreateTickets(ctx context.Context, eventID, reservatio
 
 	for _, seat := range seats {
 		ticket, err := r.CreateTicket(ctx, eventID, reservationID, seat)
+		if isSeatUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: seat %+v", ErrSeatAlreadySold, seat)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to create ticket for seat %+v: %w", seat, err)
 		}
@@ -94,6 +184,7 @@ func (r *ReservationImpl) ListReservationsByUserID(ctx context.Context, userID s
 
 // CreateTicketsWithTransaction creates tickets within a database transaction
 // It checks seat availability for the event and creates tickets atomically
+// ErrSeatAlreadySold is returned if any seat already has a live ticket
 func (r *ReservationImpl) CreateTicketsWithTransaction(ctx context.Context, eventID, reservationID string, seats []SeatInfo) ([]db.Ticket, error) {
 	if r.pool == nil {
 		// Fallback to non-transactional if pool is not available
@@ -103,52 +194,109 @@ func (r *ReservationImpl) CreateTicketsWithTransaction(ctx context.Context, even
 	var tickets []db.Ticket
 
 	// Start a database transaction
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		tickets, err = createTicketsTx(ctx, r.db.WithTx(tx), eventID, reservationID, seats)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+// ConfirmReservationWithTickets creates the tickets, moves the reservation from PENDING to CONFIRMED
+// and stores the outbox messages in one transaction.
+// ErrReservationNotPending is returned if the reservation was confirmed or cancelled meanwhile.
+func (r *ReservationImpl) ConfirmReservationWithTickets(ctx context.Context, eventID, reservationID string, seats []SeatInfo, messages ...outbox.Message) ([]db.Ticket, error) {
+	var tickets []db.Ticket
+
 	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
 		queries := r.db.WithTx(tx)
-		eventUUID := stringToUUID(eventID)
-		reservationUUID := stringToUUID(reservationID)
-
-		// Check availability for all seats first
-		for _, seat := range seats {
-			params := db.CheckSeatAvailabilityForEventParams{
-				EventID:    eventUUID,
-				ZoneNumber: seat.ZoneNumber,
-				RowNumber:  seat.RowNumber,
-				ColNumber:  seat.ColNumber,
-			}
 
-			isTaken, err := queries.CheckSeatAvailabilityForEvent(ctx, params)
-			if err != nil {
-				return fmt.Errorf("failed to check seat availability for zone=%d row=%d col=%d: %w",
-					seat.ZoneNumber, seat.RowNumber, seat.ColNumber, err)
-			}
+		var err error
+		tickets, err = createTicketsTx(ctx, queries, eventID, reservationID, seats)
+		if err != nil {
+			return err
+		}
+		if err := insertTicketHistory(ctx, queries, tickets, entities.TicketIssued, nil); err != nil {
+			return err
+		}
 
-			if isTaken {
-				return fmt.Errorf("seat already taken: zone=%d row=%d col=%d",
-					seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
-			}
+		affected, err := queries.TransitionReservationStatus(ctx, db.TransitionReservationStatusParams{
+			NewStatus:     string(entities.Confirmed),
+			ID:            stringToUUID(reservationID),
+			CurrentStatus: string(entities.Pending),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to confirm reservation: %w", err)
+		}
+		if affected == 0 {
+			return ErrReservationNotPending
 		}
 
-		// If all seats are available, create tickets
-		for _, seat := range seats {
-			params := db.CreateTicketParams{
-				ReservationID: reservationUUID,
-				ZoneNumber:    seat.ZoneNumber,
-				RowNumber:     seat.RowNumber,
-				ColNumber:     seat.ColNumber,
-				EventID:       eventUUID,
-			}
+		// a reservation offered to the waitlist settles its offer once paid
+		if err := queries.CloseWaitlistOffer(ctx, db.CloseWaitlistOfferParams{
+			NewStatus:     string(entities.WaitlistClaimed),
+			ReservationID: stringToUUID(reservationID),
+		}); err != nil {
+			return fmt.Errorf("failed to claim waitlist offer: %w", err)
+		}
 
-			ticket, err := queries.CreateTicket(ctx, params)
-			if err != nil {
-				return fmt.Errorf("failed to create ticket for seat zone=%d row=%d col=%d: %w",
-					seat.ZoneNumber, seat.RowNumber, seat.ColNumber, err)
-			}
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+
+	if err != nil {
+		return nil, err
+	}
 
-			tickets = append(tickets, ticket)
+	return tickets, nil
+}
+
+// RefundReservationWithTickets moves the reservation from CONFIRMED to REFUNDED, soft-deletes its tickets,
+// gives its ticket types back and stores the outbox messages in one transaction. The refunded amount is recorded in the ticket
+// history split evenly over the tickets. The deleted tickets are returned.
+// ErrReservationNotConfirmed is returned if the reservation was refunded meanwhile.
+func (r *ReservationImpl) RefundReservationWithTickets(ctx context.Context, reservationID string, refundedAmount int64, messages ...outbox.Message) ([]db.Ticket, error) {
+	var tickets []db.Ticket
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		affected, err := queries.TransitionReservationStatus(ctx, db.TransitionReservationStatusParams{
+			NewStatus:     string(entities.Refunded),
+			ID:            stringToUUID(reservationID),
+			CurrentStatus: string(entities.Confirmed),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to refund reservation: %w", err)
+		}
+		if affected == 0 {
+			return ErrReservationNotConfirmed
+		}
+
+		tickets, err = queries.ListTicketsByReservationID(ctx, stringToUUID(reservationID))
+		if err != nil {
+			return fmt.Errorf("failed to list tickets: %w", err)
+		}
+		if err := queries.DeleteTicketsByReservationID(ctx, stringToUUID(reservationID)); err != nil {
+			return fmt.Errorf("failed to delete tickets: %w", err)
+		}
+		if err := queries.ReleaseTicketTypeClaims(ctx, stringToUUID(reservationID)); err != nil {
+			return fmt.Errorf("failed to release ticket types: %w", err)
 		}
 
-		return nil
+		refunds := make(map[string]int64, len(tickets))
+		for i, share := range money.Split(refundedAmount, len(tickets)) {
+			refunds[uuidToString(tickets[i].ID)] = share
+		}
+		if err := insertTicketHistory(ctx, queries, tickets, entities.TicketRefunded, refunds); err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, queries, messages)
 	})
 
 	if err != nil {
@@ -158,6 +306,122 @@ func (r *ReservationImpl) CreateTicketsWithTransaction(ctx context.Context, even
 	return tickets, nil
 }
 
+// CancelTickets soft-deletes some tickets of a confirmed reservation, stores the breakdown of the tickets
+// left and gives their ticket types back in one transaction. refunds maps every ticket ID to the amount paid
+// back for it.
+// ErrReservationNotConfirmed is returned if the reservation is not confirmed and
+// ErrTicketNotFound if a ticket is not a live ticket of the reservation.
+func (r *ReservationImpl) CancelTickets(ctx context.Context, reservationID string, refunds map[string]int64, breakdown fees.Breakdown) ([]db.Ticket, error) {
+	var tickets []db.Ticket
+
+	ids := make([]pgtype.UUID, 0, len(refunds))
+	for id := range refunds {
+		ids = append(ids, stringToUUID(id))
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		// locks the reservation row, concurrent cancellations queue up here
+		affected, err := queries.UpdateReservationBreakdown(ctx, db.UpdateReservationBreakdownParams{
+			Subtotal:      breakdown.Subtotal,
+			Discount:      breakdown.Discount,
+			BookingFee:    breakdown.BookingFee,
+			ProcessingFee: breakdown.ProcessingFee,
+			Tax:           breakdown.Tax,
+			TotalPrice:    breakdown.Total,
+			ID:            stringToUUID(reservationID),
+			Status:        string(entities.Confirmed),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update reservation breakdown: %w", err)
+		}
+		if affected == 0 {
+			return ErrReservationNotConfirmed
+		}
+
+		tickets, err = queries.CancelReservationTickets(ctx, db.CancelReservationTicketsParams{
+			ReservationID: stringToUUID(reservationID),
+			Ids:           ids,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cancel tickets: %w", err)
+		}
+		if len(tickets) != len(ids) {
+			return ErrTicketNotFound
+		}
+		if err := returnTicketTypes(ctx, queries, stringToUUID(reservationID), tickets); err != nil {
+			return err
+		}
+
+		return insertTicketHistory(ctx, queries, tickets, entities.TicketCancelled, refunds)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+func createTicketsTx(ctx context.Context, queries *db.Queries, eventID, reservationID string, seats []SeatInfo) ([]db.Ticket, error) {
+	eventUUID := stringToUUID(eventID)
+	reservationUUID := stringToUUID(reservationID)
+
+	// Check availability for all seats first, general admission tickets share their seat
+	for _, seat := range seats {
+		if seat.Unnumbered() {
+			continue
+		}
+		params := db.CheckSeatAvailabilityForEventParams{
+			EventID:    eventUUID,
+			ZoneNumber: seat.ZoneNumber,
+			RowNumber:  seat.RowNumber,
+			ColNumber:  seat.ColNumber,
+		}
+
+		isTaken, err := queries.CheckSeatAvailabilityForEvent(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check seat availability for zone=%d row=%d col=%d: %w",
+				seat.ZoneNumber, seat.RowNumber, seat.ColNumber, err)
+		}
+
+		if isTaken {
+			return nil, fmt.Errorf("%w: zone=%d row=%d col=%d",
+				ErrSeatAlreadySold, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
+		}
+	}
+
+	// If all seats are available, create tickets
+	tickets := make([]db.Ticket, 0, len(seats))
+	for _, seat := range seats {
+		params := db.CreateTicketParams{
+			ReservationID: reservationUUID,
+			ZoneNumber:    seat.ZoneNumber,
+			RowNumber:     seat.RowNumber,
+			ColNumber:     seat.ColNumber,
+			EventID:       eventUUID,
+			TicketTypeID:  stringToUUID(seat.TicketTypeID),
+			Price:         &seat.Price,
+		}
+
+		ticket, err := queries.CreateTicket(ctx, params)
+		if isSeatUniqueViolation(err) {
+			// a concurrent confirmation won the seat between the check and the insert
+			return nil, fmt.Errorf("%w: zone=%d row=%d col=%d",
+				ErrSeatAlreadySold, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ticket for seat zone=%d row=%d col=%d: %w",
+				seat.ZoneNumber, seat.RowNumber, seat.ColNumber, err)
+		}
+
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
+
 func (r *ReservationImpl) GetReservationBySessionId(ctx context.Context, sessionID string) (*db.Reservation, error) {
 	reservation, err := r.db.GetReservationByStripeSessionID(ctx, sessionID)
 	if err != nil {
@@ -166,3 +430,93 @@ func (r *ReservationImpl) GetReservationBySessionId(ctx context.Context, session
 
 	return &reservation, nil
 }
+
+// ListTicketEventIDs returns every event that has at least one live ticket.
+func (r *ReservationImpl) ListTicketEventIDs(ctx context.Context) ([]string, error) {
+	ids, err := r.db.ListTicketEventIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]string, len(ids))
+	for i, id := range ids {
+		eventIDs[i] = uuidToString(id)
+	}
+	return eventIDs, nil
+}
+
+func (r *ReservationImpl) ListTicketsByEventID(ctx context.Context, eventID string) ([]db.Ticket, error) {
+	return r.db.ListTicketsByEventID(ctx, stringToUUID(eventID))
+}
+
+// ListStalePendingReservations returns PENDING reservations created before the given time.
+func (r *ReservationImpl) ListStalePendingReservations(ctx context.Context, before time.Time) ([]db.Reservation, error) {
+	return r.db.ListReservationsByStatusCreatedBefore(ctx, db.ListReservationsByStatusCreatedBeforeParams{
+		Status:    string(entities.Pending),
+		CreatedAt: pgtype.Timestamptz{Time: before, Valid: true},
+	})
+}
+
+// CountSoldTicketsByZone counts the live tickets of every zone of the event, zones without any are left out.
+func (r *ReservationImpl) CountSoldTicketsByZone(ctx context.Context, eventID string) (map[int32]int64, error) {
+	rows, err := r.db.CountTicketsByZone(ctx, stringToUUID(eventID))
+	if err != nil {
+		return nil, err
+	}
+	sold := make(map[int32]int64, len(rows))
+	for _, row := range rows {
+		sold[row.ZoneNumber] = row.Sold
+	}
+	return sold, nil
+}
+
+// TransitionReservationStatus changes the status only if it still is the expected one.
+// The outbox messages are stored in the same transaction and only if the status changed.
+// It reports whether the reservation was changed.
+func (r *ReservationImpl) TransitionReservationStatus(ctx context.Context, id, from, to string, messages ...outbox.Message) (bool, error) {
+	var changed bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		affected, err := queries.TransitionReservationStatus(ctx, db.TransitionReservationStatusParams{
+			NewStatus:     to,
+			ID:            stringToUUID(id),
+			CurrentStatus: from,
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		changed = true
+		if to == string(entities.Cancelled) {
+			// a reservation cancelled before it was paid gives its promo codes and ticket types back
+			if err := queries.ReleasePromotionRedemptions(ctx, stringToUUID(id)); err != nil {
+				return err
+			}
+			if err := queries.ReleaseTicketTypeClaims(ctx, stringToUUID(id)); err != nil {
+				return err
+			}
+		}
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// GetReservationStatus returns the status of a live reservation, or an empty string if there is none.
+func (r *ReservationImpl) GetReservationStatus(ctx context.Context, id string) (string, error) {
+	reservation, err := r.db.GetReservation(ctx, stringToUUID(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return reservation.Status, nil
+}
@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+)
+
+// ticketStore stands in for the Ticket table of one event, with the partial unique index on numbered seats.
+type ticketStore struct {
+	live map[[3]int32]int
+}
+
+type storeRow struct {
+	scan func(dest ...any) error
+}
+
+func (r storeRow) Scan(dest ...any) error {
+	return r.scan(dest...)
+}
+
+func (s *ticketStore) QueryRow(_ context.Context, query string, args ...any) pgx.Row {
+	switch {
+	case strings.Contains(query, "name: CheckSeatAvailabilityForEvent"):
+		seat := [3]int32{args[1].(int32), args[2].(int32), args[3].(int32)}
+		// the check has to leave out unnumbered seats the way the index does
+		numberedOnly := strings.Contains(query, "row_number > 0")
+		return storeRow{scan: func(dest ...any) error {
+			*dest[0].(*bool) = (seat[1] > 0 || !numberedOnly) && s.live[seat] > 0
+			return nil
+		}}
+	case strings.Contains(query, "name: CreateTicket"):
+		seat := [3]int32{args[1].(int32), args[2].(int32), args[3].(int32)}
+		return storeRow{scan: func(dest ...any) error {
+			if seat[1] > 0 && s.live[seat] > 0 {
+				return &pgconn.PgError{Code: "23505", ConstraintName: ticketSeatUniqueIndex}
+			}
+			s.live[seat]++
+			return nil
+		}}
+	}
+	return storeRow{scan: func(...any) error { return errors.New("unexpected query") }}
+}
+
+func (s *ticketStore) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, errors.New("unexpected exec")
+}
+
+func (s *ticketStore) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query")
+}
+
+func (s *ticketStore) CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error) {
+	return 0, errors.New("unexpected copy")
+}
+
+func TestCreateTicketsTx(t *testing.T) {
+	const eventID = "00000000-0000-0000-0000-000000000001"
+	ga := SeatInfo{ZoneNumber: 2}
+	seat := SeatInfo{ZoneNumber: 1, RowNumber: 3, ColNumber: 4}
+
+	tests := []struct {
+		name         string
+		reservations [][]SeatInfo
+		wantSold     bool
+	}{
+		{
+			name:         "two general admission reservations in the same zone",
+			reservations: [][]SeatInfo{{ga, ga}, {ga}},
+		},
+		{
+			name:         "a numbered seat sold twice",
+			reservations: [][]SeatInfo{{seat}, {seat}},
+			wantSold:     true,
+		},
+		{
+			name:         "general admission next to a sold numbered seat",
+			reservations: [][]SeatInfo{{seat}, {ga}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := db.New(&ticketStore{live: make(map[[3]int32]int)})
+
+			var err error
+			for i, seats := range tt.reservations {
+				reservationID := "00000000-0000-0000-0000-00000000001" + string(rune('0'+i))
+				if _, err = createTicketsTx(context.Background(), queries, eventID, reservationID, seats); err != nil {
+					break
+				}
+			}
+			if sold := errors.Is(err, ErrSeatAlreadySold); sold != tt.wantSold || (err != nil && !sold) {
+				t.Errorf("createTicketsTx() error = %v, want seat already sold %v", err, tt.wantSold)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/redis/go-redis/v9"
+)
+
+// holdExpiryKey is a sorted set of reservation IDs scored by their hold deadline in unix milliseconds.
+// Unlike keyspace notifications it survives restarts, so missed deadlines are swept later.
+const holdExpiryKey = "reservation:expiry"
+
+// renewLeaseScript extends a lease in KEYS[1] held by ARGV[1] or takes it if it is free.
+// ARGV[2] is the lease in milliseconds. It returns 1 when the caller holds the lease.
+var renewLeaseScript = redis.NewScript(`
+local owner = redis.call('GET', KEYS[1])
+if owner == ARGV[1] then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 1
+end
+if owner == false then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript deletes the lease in KEYS[1] only if it is still held by ARGV[1].
+var releaseLeaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// releaseExpiredSeatsScript deletes the seat keys still owned by ARGV[1].
+// It returns the 1-based indexes of the keys that are free afterwards, including keys that already expired.
+var releaseExpiredSeatsScript = redis.NewScript(`
+local released = {}
+for i, key in ipairs(KEYS) do
+	local owner = redis.call('GET', key)
+	if owner == ARGV[1] then
+		redis.call('DEL', key)
+		table.insert(released, i)
+	elseif owner == false then
+		table.insert(released, i)
+	end
+end
+return released
+`)
+
+// extendHoldScript adds ARGV[2] milliseconds to a hold that was not extended before.
+// KEYS[1] is the reservation:temp key, KEYS[2] the cached seats, KEYS[3] the hold and
+// KEYS[4..] the seat keys, which must all still be owned by ARGV[1]. It returns the new
+// TTL in milliseconds, -1 if the hold is gone, -2 if it was extended already and -3 if
+// a seat key was lost.
+var extendHoldScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[3]) == 0 then
+	return -1
+end
+if redis.call('HEXISTS', KEYS[3], 'extended') == 1 then
+	return -2
+end
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl <= 0 then
+	return -1
+end
+for i = 4, #KEYS do
+	if redis.call('GET', KEYS[i]) ~= ARGV[1] then
+		return -3
+	end
+end
+ttl = ttl + tonumber(ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ttl)
+redis.call('PEXPIRE', KEYS[2], ttl)
+for i = 4, #KEYS do
+	redis.call('PEXPIRE', KEYS[i], ttl)
+end
+redis.call('HSET', KEYS[3], 'extended', 1)
+return ttl
+`)
+
+var (
+	ErrHoldExpired         = errors.New("hold expired")
+	ErrHoldAlreadyExtended = errors.New("hold already extended")
+	ErrHoldSeatLost        = errors.New("hold lost a seat")
+)
+
+// HoldExpiry is what the sweeper needs to undo a hold once its deadline passed.
+type HoldExpiry struct {
+	ReservationID string
+	UserID        string
+	EventID       string
+	Seats         []SeatInfo
+}
+
+func holdKey(reservationID string) string {
+	return fmt.Sprintf("reservation:hold:%s", reservationID)
+}
+
+// ScheduleHoldExpiry records the hold and its deadline in one transaction.
+func (r *ReservationImpl) ScheduleHoldExpiry(ctx context.Context, hold HoldExpiry, deadline time.Time) error {
+	seats, err := json.Marshal(hold.Seats)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, holdKey(hold.ReservationID),
+			"user_id", hold.UserID,
+			"event_id", hold.EventID,
+			"seats", seats)
+		pipe.ZAdd(ctx, holdExpiryKey, redis.Z{
+			Score:  float64(deadline.UnixMilli()),
+			Member: hold.ReservationID,
+		})
+		return nil
+	})
+	return err
+}
+
+// RescheduleHoldExpiry moves the deadline of an already scheduled hold.
+func (r *ReservationImpl) RescheduleHoldExpiry(ctx context.Context, reservationID string, deadline time.Time) error {
+	return r.redisClient.ZAddXX(ctx, holdExpiryKey, redis.Z{
+		Score:  float64(deadline.UnixMilli()),
+		Member: reservationID,
+	}).Err()
+}
+
+// ExtendHold moves the expiry of the reservation:temp, reservation:seats and seat keys of a hold
+// together and returns the TTL they share afterwards. A hold can be extended only once.
+func (r *ReservationImpl) ExtendHold(ctx context.Context, userID, reservationID, eventID string, seats []SeatInfo, extension time.Duration) (time.Duration, error) {
+	numbered, _ := splitSeats(seats)
+
+	keys := make([]string, 0, len(numbered)+3)
+	keys = append(keys,
+		fmt.Sprintf("reservation:temp:%s:%s", userID, reservationID),
+		fmt.Sprintf("reservation:seats:%s", reservationID),
+		holdKey(reservationID))
+	for _, seat := range numbered {
+		keys = append(keys, seatKey(eventID, seat))
+	}
+
+	ttl, err := extendHoldScript.Run(ctx, r.redisClient, keys, reservationID, extension.Milliseconds()).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	switch ttl {
+	case -1:
+		return 0, ErrHoldExpired
+	case -2:
+		return 0, ErrHoldAlreadyExtended
+	case -3:
+		return 0, ErrHoldSeatLost
+	}
+	return time.Duration(ttl) * time.Millisecond, nil
+}
+
+// ListDueHoldExpiries returns up to limit reservation IDs whose deadline is not after now.
+func (r *ReservationImpl) ListDueHoldExpiries(ctx context.Context, now time.Time, limit int64) ([]string, error) {
+	return r.redisClient.ZRangeByScore(ctx, holdExpiryKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: limit,
+	}).Result()
+}
+
+// GetHoldExpiry returns the recorded hold, or nil if it was already removed.
+func (r *ReservationImpl) GetHoldExpiry(ctx context.Context, reservationID string) (*HoldExpiry, error) {
+	fields, err := r.redisClient.HGetAll(ctx, holdKey(reservationID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	hold := &HoldExpiry{
+		ReservationID: reservationID,
+		UserID:        fields["user_id"],
+		EventID:       fields["event_id"],
+	}
+	if err := json.Unmarshal([]byte(fields["seats"]), &hold.Seats); err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// RemoveHoldExpiry forgets the hold, it is called once the hold was confirmed, cancelled or swept.
+func (r *ReservationImpl) RemoveHoldExpiry(ctx context.Context, reservationID string) error {
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, holdExpiryKey, reservationID)
+		pipe.Del(ctx, holdKey(reservationID))
+		return nil
+	})
+	return err
+}
+
+// ReleaseExpiredSeats frees the seats of an expired hold and announces every seat that is available again.
+// Seats that were claimed by another reservation in the meantime are left alone.
+func (r *ReservationImpl) ReleaseExpiredSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error {
+	seats, quantities := splitSeats(seats)
+	if err := r.releaseGeneralAdmission(ctx, eventID, quantities, reservationID); err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(seats))
+	for i, seat := range seats {
+		keys[i] = seatKey(eventID, seat)
+	}
+
+	indexes, err := releaseExpiredSeatsScript.Run(ctx, r.redisClient, keys, reservationID).Int64Slice()
+	if err != nil {
+		return err
+	}
+
+	released := make([]SeatInfo, 0, len(indexes))
+	for _, idx := range indexes {
+		released = append(released, seats[idx-1])
+	}
+	r.publishSeatUpdatesBatch(ctx, eventID, released, entities.SeatAvailable)
+
+	return nil
+}
+
+// RenewLease takes or extends a lease shared by all replicas, e.g. to elect a single sweeper.
+func (r *ReservationImpl) RenewLease(ctx context.Context, name, owner string, lease time.Duration) (bool, error) {
+	held, err := renewLeaseScript.Run(ctx, r.redisClient, []string{name}, owner, lease.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return held == 1, nil
+}
+
+// ReleaseLease gives up a lease taken with RenewLease, a lease taken over by another owner is left alone.
+func (r *ReservationImpl) ReleaseLease(ctx context.Context, name, owner string) error {
+	return releaseLeaseScript.Run(ctx, r.redisClient, []string{name}, owner).Err()
+}
@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrGeneralAdmissionSoldOut = errors.New("general admission zone sold out")
+
+// holdGeneralAdmissionScript takes quantities from the capacity counters of every zone or from none of them.
+// KEYS[1] is the hold of the reservation, KEYS[2..] the zone counters. ARGV holds a
+// zone number, quantity and capacity triple per counter. It returns the 1-based index
+// of the first counter without enough capacity left, or 0 once everything is held.
+var holdGeneralAdmissionScript = redis.NewScript(`
+for i = 2, #KEYS do
+	local taken = tonumber(redis.call('GET', KEYS[i]) or '0')
+	local base = (i - 2) * 3
+	if taken + tonumber(ARGV[base + 2]) > tonumber(ARGV[base + 3]) then
+		return i - 1
+	end
+end
+for i = 2, #KEYS do
+	local base = (i - 2) * 3
+	redis.call('INCRBY', KEYS[i], ARGV[base + 2])
+	redis.call('HSET', KEYS[1], ARGV[base + 1], ARGV[base + 2])
+end
+return 0
+`)
+
+// releaseGeneralAdmissionScript gives the quantities held in KEYS[1] back to the counters in KEYS[2..].
+// ARGV are the zone numbers of the counters. The hold is deleted, so a second release is a no-op.
+var releaseGeneralAdmissionScript = redis.NewScript(`
+for i = 2, #KEYS do
+	local held = tonumber(redis.call('HGET', KEYS[1], ARGV[i - 1]) or '0')
+	if held > 0 and redis.call('DECRBY', KEYS[i], held) < 0 then
+		redis.call('SET', KEYS[i], 0)
+	end
+end
+return redis.call('DEL', KEYS[1])
+`)
+
+// releaseSoldGeneralAdmissionScript takes the quantities in ARGV off the counters in KEYS.
+// Counters never drop below zero.
+var releaseSoldGeneralAdmissionScript = redis.NewScript(`
+for i, key in ipairs(KEYS) do
+	if redis.call('DECRBY', key, ARGV[i]) < 0 then
+		redis.call('SET', key, 0)
+	end
+end
+return #KEYS
+`)
+
+// seedGeneralAdmissionScript raises every counter in KEYS to at least the sold count in ARGV.
+// It returns the 1-based indexes of the counters that had to be raised.
+var seedGeneralAdmissionScript = redis.NewScript(`
+local seeded = {}
+for i, key in ipairs(KEYS) do
+	if tonumber(redis.call('GET', key) or '0') < tonumber(ARGV[i]) then
+		redis.call('SET', key, ARGV[i])
+		table.insert(seeded, i)
+	end
+end
+return seeded
+`)
+
+// generalAdmissionKey counts the held and sold tickets of a general admission zone.
+func generalAdmissionKey(eventID string, zoneNumber int32) string {
+	return fmt.Sprintf("ga:%s:%d", eventID, zoneNumber)
+}
+
+// generalAdmissionHoldKey remembers what a pending reservation took from each counter.
+func generalAdmissionHoldKey(reservationID string) string {
+	return fmt.Sprintf("reservation:ga:%s", reservationID)
+}
+
+// splitSeats separates numbered seats from the per zone quantities of unnumbered ones.
+func splitSeats(seats []SeatInfo) ([]SeatInfo, map[int32]int32) {
+	numbered := make([]SeatInfo, 0, len(seats))
+	quantities := make(map[int32]int32)
+	for _, seat := range seats {
+		if seat.Unnumbered() {
+			quantities[seat.ZoneNumber]++
+			continue
+		}
+		numbered = append(numbered, seat)
+	}
+	return numbered, quantities
+}
+
+// HoldGeneralAdmission takes the unnumbered seats from the capacity counters of their zones.
+// ErrGeneralAdmissionSoldOut is returned if any zone lacks capacity, nothing is held then.
+func (r *ReservationImpl) HoldGeneralAdmission(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, capacities map[int32]int32) error {
+	_, quantities := splitSeats(seats)
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	zones := make([]int32, 0, len(quantities))
+	keys := []string{generalAdmissionHoldKey(reservationID)}
+	args := make([]any, 0, len(quantities)*3)
+	for zone, quantity := range quantities {
+		zones = append(zones, zone)
+		keys = append(keys, generalAdmissionKey(eventID, zone))
+		args = append(args, zone, quantity, capacities[zone])
+	}
+
+	idx, err := holdGeneralAdmissionScript.Run(ctx, r.redisClient, keys, args...).Int()
+	if err != nil {
+		return err
+	}
+	if idx > 0 {
+		return fmt.Errorf("%w: zone %d", ErrGeneralAdmissionSoldOut, zones[idx-1])
+	}
+	return nil
+}
+
+// releaseGeneralAdmission gives back what the reservation still holds from the counters.
+func (r *ReservationImpl) releaseGeneralAdmission(ctx context.Context, eventID string, quantities map[int32]int32, reservationID string) error {
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	keys := []string{generalAdmissionHoldKey(reservationID)}
+	args := make([]any, 0, len(quantities))
+	for zone := range quantities {
+		keys = append(keys, generalAdmissionKey(eventID, zone))
+		args = append(args, zone)
+	}
+
+	return releaseGeneralAdmissionScript.Run(ctx, r.redisClient, keys, args...).Err()
+}
+
+// releaseSoldGeneralAdmission gives sold quantities back to the counters once their tickets are gone.
+func (r *ReservationImpl) releaseSoldGeneralAdmission(ctx context.Context, eventID string, quantities map[int32]int32) error {
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(quantities))
+	args := make([]any, 0, len(quantities))
+	for zone, quantity := range quantities {
+		keys = append(keys, generalAdmissionKey(eventID, zone))
+		args = append(args, quantity)
+	}
+
+	return releaseSoldGeneralAdmissionScript.Run(ctx, r.redisClient, keys, args...).Err()
+}
+
+// GetGeneralAdmissionTaken returns the held and sold count of every zone.
+func (r *ReservationImpl) GetGeneralAdmissionTaken(ctx context.Context, eventID string, zones []int32) (map[int32]int32, error) {
+	taken := make(map[int32]int32, len(zones))
+	if len(zones) == 0 {
+		return taken, nil
+	}
+
+	keys := make([]string, len(zones))
+	for i, zone := range zones {
+		keys[i] = generalAdmissionKey(eventID, zone)
+	}
+
+	values, err := r.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, value := range values {
+		count, _ := value.(string)
+		n, _ := strconv.Atoi(count)
+		taken[zones[i]] = int32(n)
+	}
+	return taken, nil
+}
+
+// seedGeneralAdmission makes sure no counter is below the number of tickets sold in its zone.
+// Held tickets are not known here, so a counter is only ever raised.
+func (r *ReservationImpl) seedGeneralAdmission(ctx context.Context, eventID string, tickets []db.Ticket) ([]SeatInfo, error) {
+	sold := make(map[int32]int)
+	for _, ticket := range tickets {
+		seat := SeatInfo{ZoneNumber: ticket.ZoneNumber, RowNumber: ticket.RowNumber, ColNumber: ticket.ColNumber}
+		if seat.Unnumbered() {
+			sold[seat.ZoneNumber]++
+		}
+	}
+	if len(sold) == 0 {
+		return nil, nil
+	}
+
+	zones := make([]int32, 0, len(sold))
+	keys := make([]string, 0, len(sold))
+	args := make([]any, 0, len(sold))
+	for zone, count := range sold {
+		zones = append(zones, zone)
+		keys = append(keys, generalAdmissionKey(eventID, zone))
+		args = append(args, count)
+	}
+
+	indexes, err := seedGeneralAdmissionScript.Run(ctx, r.redisClient, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	seeded := make([]SeatInfo, 0, len(indexes))
+	for _, idx := range indexes {
+		seeded = append(seeded, SeatInfo{ZoneNumber: zones[idx-1]})
+	}
+	return seeded, nil
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+)
+
+// insertTicketHistory records the same action for every ticket, refunds maps ticket IDs to the amount paid back.
+func insertTicketHistory(ctx context.Context, queries *db.Queries, tickets []db.Ticket, action entities.TicketAction, refunds map[string]int64) error {
+	for _, ticket := range tickets {
+		if err := queries.CreateTicketHistory(ctx, db.CreateTicketHistoryParams{
+			TicketID:       ticket.ID,
+			ReservationID:  ticket.ReservationID,
+			Action:         string(action),
+			RefundedAmount: refunds[uuidToString(ticket.ID)],
+		}); err != nil {
+			return fmt.Errorf("failed to record ticket history: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListTicketHistory returns every change to the tickets of a reservation, oldest first.
+func (r *ReservationImpl) ListTicketHistory(ctx context.Context, reservationID string) ([]db.ListTicketHistoryByReservationIDRow, error) {
+	return r.db.ListTicketHistoryByReservationID(ctx, stringToUUID(reservationID))
+}
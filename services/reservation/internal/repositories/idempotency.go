@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// idempotencyClaimTimeout frees a key whose request died before completing it.
+	idempotencyClaimTimeout = time.Minute
+	// idempotencyKeyRetention is how long a completed response is replayed.
+	idempotencyKeyRetention = 24 * time.Hour
+)
+
+var (
+	// ErrIdempotencyKeyInUse is returned while another request with the same key is still running.
+	ErrIdempotencyKeyInUse = errors.New("idempotency key is in use")
+	// ErrIdempotencyKeyMismatch is returned when a key is reused for a different request.
+	ErrIdempotencyKeyMismatch = errors.New("idempotency key was used for a different request")
+)
+
+// ClaimIdempotencyKey reserves the key for the calling request.
+// It returns the stored response if the same request already completed, or nil once the key is claimed.
+func (r *ReservationImpl) ClaimIdempotencyKey(ctx context.Context, userID, key string, requestHash []byte) ([]byte, error) {
+	now := time.Now()
+	claimed, err := r.db.ClaimIdempotencyKey(ctx, db.ClaimIdempotencyKeyParams{
+		UserID:          stringToUUID(userID),
+		IdempotencyKey:  key,
+		RequestHash:     requestHash,
+		AbandonedBefore: pgtype.Timestamptz{Time: now.Add(-idempotencyClaimTimeout), Valid: true},
+		ExpiredBefore:   pgtype.Timestamptz{Time: now.Add(-idempotencyKeyRetention), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if claimed > 0 {
+		return nil, nil
+	}
+
+	existing, err := r.db.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		UserID:         stringToUUID(userID),
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, ErrIdempotencyKeyMismatch
+	}
+	if existing.Response == nil {
+		return nil, ErrIdempotencyKeyInUse
+	}
+	return existing.Response, nil
+}
+
+// CompleteIdempotencyKey stores the response replayed to retries of the request.
+func (r *ReservationImpl) CompleteIdempotencyKey(ctx context.Context, userID, key string, response []byte) error {
+	return r.db.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		UserID:         stringToUUID(userID),
+		IdempotencyKey: key,
+		Response:       response,
+	})
+}
+
+// ReleaseIdempotencyKey frees a claimed key after its request failed, so it can be retried.
+func (r *ReservationImpl) ReleaseIdempotencyKey(ctx context.Context, userID, key string) error {
+	return r.db.DeleteIdempotencyKey(ctx, db.DeleteIdempotencyKeyParams{
+		UserID:         stringToUUID(userID),
+		IdempotencyKey: key,
+	})
+}
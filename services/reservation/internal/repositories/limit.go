@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+)
+
+// CountUserTickets returns how many live tickets the user owns for the event, transferred tickets count for their new owner.
+func (r *ReservationImpl) CountUserTickets(ctx context.Context, userID, eventID string) (int, error) {
+	count, err := r.db.CountTicketsByUserAndEvent(ctx, db.CountTicketsByUserAndEventParams{
+		OwnerID: stringToUUID(userID),
+		EventID: stringToUUID(eventID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// CountHeldSeats returns how many seats the user currently holds for the event.
+// A hold counts until it is confirmed, cancelled or swept, so the count errs on the high side.
+func (r *ReservationImpl) CountHeldSeats(ctx context.Context, userID, eventID string) (int, error) {
+	reservations, err := r.db.ListReservationsByUserEventStatus(ctx, db.ListReservationsByUserEventStatusParams{
+		UserID:  stringToUUID(userID),
+		EventID: stringToUUID(eventID),
+		Status:  string(entities.Pending),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var held int
+	for _, reservation := range reservations {
+		hold, err := r.GetHoldExpiry(ctx, uuidToString(reservation.ID))
+		if err != nil {
+			return 0, err
+		}
+		if hold != nil {
+			held += len(hold.Seats)
+		}
+	}
+	return held, nil
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LineItem is one line of the breakdown a reservation is charged by, in the minor unit of its currency.
+// ZoneNumber is set on the TICKET line of a zone only.
+type LineItem struct {
+	Kind        entities.LineItemKind
+	Name        string
+	Description string
+	ZoneNumber  *int32
+	Quantity    int32
+	UnitAmount  int64
+}
+
+// Amount is what the line adds to the total, it is negative for a discount.
+func (l LineItem) Amount() int64 {
+	return l.UnitAmount * int64(l.Quantity)
+}
+
+func (r *ReservationImpl) ListReservationLineItems(ctx context.Context, reservationID string) ([]db.Reservationlineitem, error) {
+	return r.db.ListReservationLineItems(ctx, stringToUUID(reservationID))
+}
+
+func insertLineItems(ctx context.Context, queries *db.Queries, reservationID pgtype.UUID, items []LineItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	params := make([]db.BulkCreateReservationLineItemsParams, len(items))
+	for i, item := range items {
+		params[i] = db.BulkCreateReservationLineItemsParams{
+			ReservationID: reservationID,
+			Position:      int32(i),
+			Kind:          string(item.Kind),
+			Name:          item.Name,
+			Description:   item.Description,
+			ZoneNumber:    item.ZoneNumber,
+			Quantity:      item.Quantity,
+			UnitAmount:    item.UnitAmount,
+			Amount:        item.Amount(),
+		}
+	}
+
+	if _, err := queries.BulkCreateReservationLineItems(ctx, params); err != nil {
+		return fmt.Errorf("failed to create line items: %w", err)
+	}
+	return nil
+}
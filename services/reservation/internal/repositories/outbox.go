@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NotificationsQueue is consumed by the notification service.
+const NotificationsQueue = "notifications"
+
+func insertOutboxMessages(ctx context.Context, queries *db.Queries, messages []outbox.Message) error {
+	for _, message := range messages {
+		if err := queries.CreateOutboxMessage(ctx, db.CreateOutboxMessageParams{
+			ID:      stringToUUID(message.ID),
+			Queue:   message.Queue,
+			Type:    message.Type,
+			Payload: message.Payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishOutboxMessages stores messages that do not belong to a database change of their own.
+func (r *ReservationImpl) PublishOutboxMessages(ctx context.Context, messages ...outbox.Message) error {
+	return insertOutboxMessages(ctx, r.db, messages)
+}
+
+func (r *ReservationImpl) ClaimOutboxMessages(ctx context.Context, limit int32, lockedUntil time.Time) ([]outbox.Message, error) {
+	rows, err := r.db.ClaimOutboxMessages(ctx, db.ClaimOutboxMessagesParams{
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]outbox.Message, len(rows))
+	for i, row := range rows {
+		messages[i] = outbox.Message{
+			ID:       uuidToString(row.ID),
+			Queue:    row.Queue,
+			Type:     row.Type,
+			Payload:  row.Payload,
+			Attempts: row.Attempts,
+		}
+	}
+	return messages, nil
+}
+
+func (r *ReservationImpl) MarkOutboxMessagePublished(ctx context.Context, id string) error {
+	return r.db.MarkOutboxMessagePublished(ctx, stringToUUID(id))
+}
+
+func (r *ReservationImpl) MarkOutboxMessageFailed(ctx context.Context, id string, nextAttemptAt time.Time, cause error) error {
+	lastError := cause.Error()
+	return r.db.MarkOutboxMessageFailed(ctx, db.MarkOutboxMessageFailedParams{
+		ID:            stringToUUID(id),
+		LastError:     &lastError,
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+	})
+}
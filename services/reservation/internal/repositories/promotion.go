@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const promotionCodeIndex = "promotion_code_idx"
+
+var (
+	// ErrPromotionCodeTaken is returned when another promotion already uses the code.
+	ErrPromotionCodeTaken = errors.New("promo code already exists")
+	// ErrPromotionExhausted is returned when a promotion reached its redemption cap or was deactivated meanwhile.
+	ErrPromotionExhausted = errors.New("promo code is fully redeemed")
+	// ErrPromotionUserLimit is returned when the user already redeemed a promotion as often as allowed.
+	ErrPromotionUserLimit = errors.New("promo code redemption limit reached for user")
+)
+
+// PromotionRedemption is the discount a promotion gives a reservation.
+type PromotionRedemption struct {
+	PromotionID           string
+	Amount                int64
+	MaxRedemptionsPerUser int32
+}
+
+func isPromotionCodeViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == promotionCodeIndex
+}
+
+func (r *ReservationImpl) CreatePromotion(ctx context.Context, params db.CreatePromotionParams) (*db.Promotion, error) {
+	promotion, err := r.db.CreatePromotion(ctx, params)
+	if err != nil {
+		if isPromotionCodeViolation(err) {
+			return nil, ErrPromotionCodeTaken
+		}
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+// ListPromotions returns every promotion, latest first.
+func (r *ReservationImpl) ListPromotions(ctx context.Context) ([]db.Promotion, error) {
+	return r.db.ListPromotions(ctx)
+}
+
+// ListPromotionsByCodes returns the promotions with the given codes, unknown codes are left out.
+func (r *ReservationImpl) ListPromotionsByCodes(ctx context.Context, codes []string) ([]db.Promotion, error) {
+	return r.db.ListPromotionsByCodes(ctx, codes)
+}
+
+// DeactivatePromotion stops a promotion from being redeemed, it reports false if there is none.
+func (r *ReservationImpl) DeactivatePromotion(ctx context.Context, id string) (bool, error) {
+	affected, err := r.db.DeactivatePromotion(ctx, stringToUUID(id))
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CountUserPromotionRedemptions counts the redemptions of a promotion by the user that were not released.
+func (r *ReservationImpl) CountUserPromotionRedemptions(ctx context.Context, promotionID, userID string) (int, error) {
+	count, err := r.db.CountUserPromotionRedemptions(ctx, db.CountUserPromotionRedemptionsParams{
+		PromotionID: stringToUUID(promotionID),
+		UserID:      stringToUUID(userID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// ListReservationPromotions returns the promo codes a reservation redeemed with their discount.
+func (r *ReservationImpl) ListReservationPromotions(ctx context.Context, reservationID string) ([]db.ListReservationPromotionsRow, error) {
+	return r.db.ListReservationPromotions(ctx, stringToUUID(reservationID))
+}
+
+// redeemPromotions claims a redemption of every promotion for the reservation. The global cap is taken by
+// a conditional increment, which also locks the promotion until the transaction ends, so the per-user
+// count afterwards sees the redemptions of concurrent reservations.
+func redeemPromotions(ctx context.Context, queries *db.Queries, reservationID, userID pgtype.UUID, redemptions []PromotionRedemption) error {
+	for _, redemption := range redemptions {
+		promotionID := stringToUUID(redemption.PromotionID)
+
+		claimed, err := queries.ClaimPromotionRedemption(ctx, promotionID)
+		if err != nil {
+			return fmt.Errorf("failed to claim promotion redemption: %w", err)
+		}
+		if claimed == 0 {
+			return ErrPromotionExhausted
+		}
+
+		if err := queries.CreatePromotionRedemption(ctx, db.CreatePromotionRedemptionParams{
+			PromotionID:   promotionID,
+			ReservationID: reservationID,
+			UserID:        userID,
+			Amount:        redemption.Amount,
+		}); err != nil {
+			return fmt.Errorf("failed to create promotion redemption: %w", err)
+		}
+
+		if redemption.MaxRedemptionsPerUser > 0 {
+			count, err := queries.CountUserPromotionRedemptions(ctx, db.CountUserPromotionRedemptionsParams{
+				PromotionID: promotionID,
+				UserID:      userID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to count promotion redemptions: %w", err)
+			}
+			if count > int64(redemption.MaxRedemptionsPerUser) {
+				return ErrPromotionUserLimit
+			}
+		}
+	}
+	return nil
+}
@@ -3,15 +3,69 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cp-rektmart/aconcert-microservice/pkg/logger"
-	"github.com/cp-rektmart/aconcert-microservice/pkg/rabbitmq"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/redis/go-redis/v9"
 )
 
+// holdSeatsScript claims every seat key with NX semantics or none of them.
+// KEYS are seat keys, ARGV[1] is the reservation ID and ARGV[2] the TTL in milliseconds.
+// It returns the 1-based indexes of the keys that are already taken.
+var holdSeatsScript = redis.NewScript(`
+local conflicts = {}
+for i, key in ipairs(KEYS) do
+	if redis.call('EXISTS', key) == 1 then
+		table.insert(conflicts, i)
+	end
+end
+if #conflicts > 0 then
+	return conflicts
+end
+for _, key in ipairs(KEYS) do
+	redis.call('SET', key, ARGV[1], 'PX', ARGV[2])
+end
+return {}
+`)
+
+// releaseSeatsScript deletes the seat keys that are still owned by the reservation in ARGV[1].
+// It returns the 1-based indexes of the keys that were released.
+var releaseSeatsScript = redis.NewScript(`
+local released = {}
+for i, key in ipairs(KEYS) do
+	if redis.call('GET', key) == ARGV[1] then
+		redis.call('DEL', key)
+		table.insert(released, i)
+	end
+end
+return released
+`)
+
+// seedSeatsScript makes every seat key a permanent key owned by the matching ARGV value.
+// It returns the 1-based indexes of the keys that had to be corrected.
+var seedSeatsScript = redis.NewScript(`
+local seeded = {}
+for i, key in ipairs(KEYS) do
+	if redis.call('GET', key) ~= ARGV[i] or redis.call('PTTL', key) ~= -1 then
+		redis.call('SET', key, ARGV[i])
+		table.insert(seeded, i)
+	end
+end
+return seeded
+`)
+
+// seedBatchSize bounds the number of keys a single seed script call touches.
+const seedBatchSize = 500
+
+func seatKey(eventID string, seat SeatInfo) string {
+	return fmt.Sprintf("seat:%s:%d:%d:%d", eventID, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
+}
+
 func (r *ReservationImpl) CreateReservationTemp(ctx context.Context, userID, reservationID string, ttl time.Duration) error {
 	key := fmt.Sprintf("reservation:temp:%s:%s", userID, reservationID)
 	return r.redisClient.Set(ctx, key, reservationID, ttl).Err()
@@ -144,8 +198,13 @@ func (r *ReservationImpl) GetAllEventSeats(ctx context.Context, eventID string)
 		return seats, nil
 	}
 
-	// Add tickets as RESERVED seats
+	// Add tickets as RESERVED seats, general admission tickets have no seat to show
+	var reserved int
 	for _, ticket := range tickets {
+		if ticket.RowNumber == 0 && ticket.ColNumber == 0 {
+			continue
+		}
+		reserved++
 		seats = append(seats, SeatStatusInfo{
 			ZoneNumber: ticket.ZoneNumber,
 			RowNumber:  ticket.RowNumber,
@@ -157,8 +216,8 @@ func (r *ReservationImpl) GetAllEventSeats(ctx context.Context, eventID string)
 	logger.InfoContext(ctx, "Retrieved event seats",
 		"eventID", eventID,
 		"total", len(seats),
-		"pending", len(seats)-len(tickets),
-		"reserved", len(tickets))
+		"pending", len(seats)-reserved,
+		"reserved", reserved)
 
 	return seats, nil
 }
@@ -266,257 +325,285 @@ func (r *ReservationImpl) publishSeatUpdatesBatch(ctx context.Context, eventID s
 	}()
 }
 
-// StartExpirationListener listens for Redis key expiration events
-// and publishes seat-available updates when seat reservations expire.
-// It batches multiple expired keys together to reduce pub/sub message count.
-func (r *ReservationImpl) StartExpirationListener(ctx context.Context) {
-	// Subscribe to keyspace notifications for expired keys
-	// Pattern: __keyevent@0__:expired
-	pubsub := r.redisClient.PSubscribe(ctx, "__keyevent@0__:expired")
-	defer pubsub.Close()
-
-	ch := pubsub.Channel()
-
-	logger.InfoContext(ctx, "StartExpirationListener: Listening for expired Redis keys (BATCH MODE)")
-	logger.InfoContext(ctx, "StartExpirationListener: Subscribed to __keyevent@0__:expired")
-
-	// Buffer for batching expired keys
-	pendingKeys := make([]string, 0, 100)
-	batchTimer := time.NewTimer(50 * time.Millisecond)
-	batchTimer.Stop() // Stop initially, start when first key arrives
-
-	for {
-		select {
-		case msg := <-ch:
-			if msg == nil {
-				logger.WarnContext(ctx, "StartExpirationListener: Received nil message")
-				continue
-			}
-
-			// Add expired key to batch
-			pendingKeys = append(pendingKeys, msg.Payload)
-
-			logger.InfoContext(ctx, "StartExpirationListener: Key expired (buffering for batch)",
-				"key", msg.Payload,
-				"buffer_size", len(pendingKeys))
-
-			// Start/reset batch timer
-			// If this is the first key, start the timer
-			// If timer already running, reset it to wait for more keys
-			if !batchTimer.Stop() {
-				select {
-				case <-batchTimer.C:
-				default:
-				}
-			}
-			batchTimer.Reset(50 * time.Millisecond)
-
-		case <-batchTimer.C:
-			// Timer expired - process the batch
-			if len(pendingKeys) > 0 {
-				logger.InfoContext(ctx, "StartExpirationListener: Batch timer fired, processing batch",
-					"batch_size", len(pendingKeys))
+// SetSeatsReservedBatch marks multiple seats as RESERVED in a single batch operation
+// This is used when confirming a reservation with multiple seats
+func (r *ReservationImpl) SetSeatsReservedBatch(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error {
+	// the quantities taken from general admission counters stay taken, they are sold now
+	if err := r.redisClient.Del(ctx, generalAdmissionHoldKey(reservationID)).Err(); err != nil {
+		return err
+	}
 
-				r.handleExpiredKeysBatch(ctx, pendingKeys)
+	seats, _ = splitSeats(seats)
+	if len(seats) == 0 {
+		return nil
+	}
 
-				// Reset buffer
-				pendingKeys = pendingKeys[:0]
-			}
+	logger.InfoContext(ctx, "SetSeatsReservedBatch: Marking seats as RESERVED",
+		"eventID", eventID,
+		"reservationID", reservationID,
+		"seats_count", len(seats))
 
-		case <-ctx.Done():
-			logger.InfoContext(ctx, "StartExpirationListener: Stopped")
-			return
+	// 1. Update all seats in Redis (set TTL=0 for permanent)
+	for _, seat := range seats {
+		key := fmt.Sprintf("seat:%s:%d:%d:%d", eventID, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
+		if err := r.redisClient.Set(ctx, key, reservationID, 0).Err(); err != nil {
+			logger.ErrorContext(ctx, "Failed to set seat reserved in Redis",
+				"error", err,
+				"seat", seat)
+			return err
 		}
 	}
-}
 
-// handleExpiredKeysBatch processes multiple expired keys in a batch
-// this involves db related operations
-func (r *ReservationImpl) handleExpiredKeysBatch(ctx context.Context, keys []string) {
-	if len(keys) == 0 {
-		return
-	}
+	// 2. Publish batch update (single message for all seats)
+	r.publishSeatUpdatesBatch(ctx, eventID, seats, entities.SeatReserved)
 
-	logger.InfoContext(ctx, "handleExpiredKeysBatch: Processing batch of expired keys",
-		"count", len(keys))
+	logger.InfoContext(ctx, "SetSeatsReservedBatch: Successfully marked seats as RESERVED",
+		"seats_count", len(seats))
 
-	// Group seats by eventID
-	seatsByEvent := make(map[string][]SeatInfo)
+	return nil
+}
 
-	for _, key := range keys {
-		// Parse the key: "seat:eventID:zone:row:col"
-		parts := strings.Split(key, ":")
+// HoldSeats places a temporary hold on all seats in a single atomic operation.
+// Either every seat is claimed for the reservation or none of them is; in the
+// latter case the seats that are already held or sold are returned.
+// Unnumbered seats are skipped, they are held by HoldGeneralAdmission.
+func (r *ReservationImpl) HoldSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, ttl time.Duration) ([]SeatInfo, error) {
+	seats, _ = splitSeats(seats)
+	if len(seats) == 0 {
+		return nil, nil
+	}
 
-		// Expected format: ["reservation", "temp", "userID", "reservationID"]
-		if len(parts) == 4 && (parts[0] == "reservation" && parts[1] == "temp") {
-			_, err := r.UpdateReservationStatus(ctx, parts[3], string(entities.Cancelled))
-			if err != nil {
-				logger.ErrorContext(ctx, "handleExpiredKeysBatch: Failed to update reservation status", "error", err)
-			}
+	keys := make([]string, len(seats))
+	for i, seat := range seats {
+		keys[i] = seatKey(eventID, seat)
+	}
 
-			data := struct {
-				Type string `json:"type"`
-				Data any    `json:"data"`
-			}{
-				Type: "reservation.cancelled",
-				Data: entities.CancelledNotiReservation{
-					ID:     parts[3],
-					UserID: parts[2],
-				},
-			}
+	indexes, err := holdSeatsScript.Run(ctx, r.redisClient, keys, reservationID, ttl.Milliseconds()).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
 
-			jsonData, err := json.Marshal(data)
-			if err != nil {
-				logger.ErrorContext(ctx, "Failed to marshal event")
-			}
+	if len(indexes) > 0 {
+		conflicts := make([]SeatInfo, 0, len(indexes))
+		for _, idx := range indexes {
+			conflicts = append(conflicts, seats[idx-1])
+		}
 
-			if err := rabbitmq.RabbitMQClient.PublishToQueue("notifications", jsonData); err != nil {
-				logger.ErrorContext(ctx, "Failed to publish notification")
-			}
+		logger.InfoContext(ctx, "HoldSeats: Seats already taken, hold rejected",
+			"eventID", eventID,
+			"reservationID", reservationID,
+			"conflicts_count", len(conflicts))
+		return conflicts, nil
+	}
 
-			continue
-		}
+	r.publishSeatUpdatesBatch(ctx, eventID, seats, entities.SeatPending)
 
-		// Expected format: ["seat", "eventID", "zone", "row", "col"]
-		if len(parts) != 5 || parts[0] != "seat" {
-			logger.WarnContext(ctx, "handleExpiredKeysBatch: Invalid key format, skipping",
-				"key", key)
-			continue
-		}
+	logger.InfoContext(ctx, "HoldSeats: Successfully marked seats as PENDING",
+		"eventID", eventID,
+		"reservationID", reservationID,
+		"seats_count", len(seats),
+		"ttl", ttl)
 
-		eventID := parts[1]
+	return nil, nil
+}
 
-		// Parse seat coordinates
-		var zoneNumber, rowNumber, colNumber int32
-		fmt.Sscanf(parts[2], "%d", &zoneNumber)
-		fmt.Sscanf(parts[3], "%d", &rowNumber)
-		fmt.Sscanf(parts[4], "%d", &colNumber)
+// ReleaseSeats removes the seat keys that are still owned by the reservation,
+// leaving seats that have since been claimed by someone else untouched.
+// Unnumbered seats still held are given back to their zone counters.
+func (r *ReservationImpl) ReleaseSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error {
+	seats, quantities := splitSeats(seats)
+	if err := r.releaseGeneralAdmission(ctx, eventID, quantities, reservationID); err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return nil
+	}
 
-		seat := SeatInfo{
-			ZoneNumber: zoneNumber,
-			RowNumber:  rowNumber,
-			ColNumber:  colNumber,
-		}
+	keys := make([]string, len(seats))
+	for i, seat := range seats {
+		keys[i] = seatKey(eventID, seat)
+	}
 
-		seatsByEvent[eventID] = append(seatsByEvent[eventID], seat)
+	indexes, err := releaseSeatsScript.Run(ctx, r.redisClient, keys, reservationID).Int64Slice()
+	if err != nil {
+		return err
 	}
 
-	// Publish batch update for each event
-	for eventID, seats := range seatsByEvent {
-		r.publishSeatUpdatesBatch(ctx, eventID, seats, entities.SeatAvailable)
+	released := make([]SeatInfo, 0, len(indexes))
+	for _, idx := range indexes {
+		released = append(released, seats[idx-1])
 	}
+	r.publishSeatUpdatesBatch(ctx, eventID, released, entities.SeatAvailable)
+
+	return nil
 }
 
-// handleExpiredKey processes an expired seat reservation key (DEPRECATED - keeping for backwards compatibility)
-// This is now replaced by handleExpiredKeysBatch for better performance
-func (r *ReservationImpl) handleExpiredKey(ctx context.Context, key string) {
-	logger.InfoContext(ctx, "handleExpiredKey: Processing expired key", "key", key)
+// ReleaseSoldSeats frees the seats of tickets that were refunded and announces them as available.
+// Numbered seats still owned by the reservation are deleted, unnumbered ones go back to their counters.
+func (r *ReservationImpl) ReleaseSoldSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error {
+	seats, quantities := splitSeats(seats)
+	if err := r.releaseSoldGeneralAdmission(ctx, eventID, quantities); err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return nil
+	}
 
-	// Parse the key: "seat:eventID:zone:row:col"
-	parts := strings.Split(key, ":")
+	keys := make([]string, len(seats))
+	for i, seat := range seats {
+		keys[i] = seatKey(eventID, seat)
+	}
 
-	logger.InfoContext(ctx, "handleExpiredKey: Key parts", "parts", parts, "length", len(parts))
+	indexes, err := releaseSeatsScript.Run(ctx, r.redisClient, keys, reservationID).Int64Slice()
+	if err != nil {
+		return err
+	}
 
-	// Expected format: ["seat", "eventID", "zone", "row", "col"]
-	if len(parts) != 5 || parts[0] != "seat" {
-		logger.WarnContext(ctx, "handleExpiredKey: Invalid key format, ignoring",
-			"key", key,
-			"parts_count", len(parts),
-			"first_part", parts[0])
-		return // Not a seat key, ignore
+	released := make([]SeatInfo, 0, len(indexes))
+	for _, idx := range indexes {
+		released = append(released, seats[idx-1])
 	}
+	r.publishSeatUpdatesBatch(ctx, eventID, released, entities.SeatAvailable)
 
-	eventID := parts[1]
+	return nil
+}
 
-	// Parse seat coordinates
-	var zoneNumber, rowNumber, colNumber int32
-	fmt.Sscanf(parts[2], "%d", &zoneNumber)
-	fmt.Sscanf(parts[3], "%d", &rowNumber)
-	fmt.Sscanf(parts[4], "%d", &colNumber)
+// SeedReservedSeats writes a permanent seat key for every ticket of the event.
+// Keys that are missing, expiring or owned by another reservation are overwritten
+// and returned so the caller can report them. General admission counters below
+// their sold count are raised and reported as an unnumbered seat of the zone.
+func (r *ReservationImpl) SeedReservedSeats(ctx context.Context, eventID string, tickets []db.Ticket) ([]SeatInfo, error) {
+	seeded, err := r.seedGeneralAdmission(ctx, eventID, tickets)
+	if err != nil {
+		return seeded, err
+	}
 
-	seat := SeatInfo{
-		ZoneNumber: zoneNumber,
-		RowNumber:  rowNumber,
-		ColNumber:  colNumber,
+	numbered := make([]db.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		if ticket.RowNumber > 0 {
+			numbered = append(numbered, ticket)
+		}
 	}
+	tickets = numbered
+
+	for start := 0; start < len(tickets); start += seedBatchSize {
+		batch := tickets[start:min(start+seedBatchSize, len(tickets))]
+
+		seats := make([]SeatInfo, len(batch))
+		keys := make([]string, len(batch))
+		owners := make([]any, len(batch))
+		for i, ticket := range batch {
+			seats[i] = SeatInfo{
+				ZoneNumber: ticket.ZoneNumber,
+				RowNumber:  ticket.RowNumber,
+				ColNumber:  ticket.ColNumber,
+			}
+			keys[i] = seatKey(eventID, seats[i])
+			owners[i] = uuidToString(ticket.ReservationID)
+		}
 
-	logger.InfoContext(ctx, "handleExpiredKey: Publishing AVAILABLE status",
-		"eventID", eventID,
-		"zone", zoneNumber,
-		"row", rowNumber,
-		"column", colNumber)
+		indexes, err := seedSeatsScript.Run(ctx, r.redisClient, keys, owners...).Int64Slice()
+		if err != nil {
+			return seeded, err
+		}
 
-	// Publish that this seat is now available
-	r.publishSeatUpdate(ctx, eventID, seat, entities.SeatAvailable)
+		corrected := make([]SeatInfo, 0, len(indexes))
+		for _, idx := range indexes {
+			corrected = append(corrected, seats[idx-1])
+		}
+		r.publishSeatUpdatesBatch(ctx, eventID, corrected, entities.SeatReserved)
+		seeded = append(seeded, corrected...)
+	}
 
-	logger.InfoContext(ctx, "Seat expired and released",
-		"eventID", eventID,
-		"zone", zoneNumber,
-		"row", rowNumber,
-		"column", colNumber)
+	return seeded, nil
 }
 
-// SetSeatsReservedBatch marks multiple seats as RESERVED in a single batch operation
-// This is used when confirming a reservation with multiple seats
-func (r *ReservationImpl) SetSeatsReservedBatch(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error {
-	if len(seats) == 0 {
-		return nil
+// ListLiveReservationIDs returns the IDs of reservations that still have a reservation:temp key.
+func (r *ReservationImpl) ListLiveReservationIDs(ctx context.Context) (map[string]struct{}, error) {
+	live := make(map[string]struct{})
+
+	iter := r.redisClient.Scan(ctx, 0, "reservation:temp:*", 0).Iterator()
+	for iter.Next(ctx) {
+		// Expected format: ["reservation", "temp", "userID", "reservationID"]
+		parts := strings.Split(iter.Val(), ":")
+		if len(parts) != 4 {
+			continue
+		}
+		live[parts[3]] = struct{}{}
 	}
 
-	logger.InfoContext(ctx, "SetSeatsReservedBatch: Marking seats as RESERVED",
-		"eventID", eventID,
-		"reservationID", reservationID,
-		"seats_count", len(seats))
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return live, nil
+}
 
-	// 1. Update all seats in Redis (set TTL=0 for permanent)
-	for _, seat := range seats {
-		key := fmt.Sprintf("seat:%s:%d:%d:%d", eventID, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
-		if err := r.redisClient.Set(ctx, key, reservationID, 0).Err(); err != nil {
-			logger.ErrorContext(ctx, "Failed to set seat reserved in Redis",
-				"error", err,
-				"seat", seat)
-			return err
+// ListSeatHolds returns every seat key that still has a TTL, i.e. every pending hold.
+func (r *ReservationImpl) ListSeatHolds(ctx context.Context) ([]SeatHold, error) {
+	var holds []SeatHold
+	var keys []string
+
+	iter := r.redisClient.Scan(ctx, 0, "seat:*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == seedBatchSize {
+			batch, err := r.readSeatHolds(ctx, keys)
+			if err != nil {
+				return nil, err
+			}
+			holds = append(holds, batch...)
+			keys = keys[:0]
 		}
 	}
 
-	// 2. Publish batch update (single message for all seats)
-	r.publishSeatUpdatesBatch(ctx, eventID, seats, entities.SeatReserved)
-
-	logger.InfoContext(ctx, "SetSeatsReservedBatch: Successfully marked seats as RESERVED",
-		"seats_count", len(seats))
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
 
-	return nil
+	batch, err := r.readSeatHolds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	return append(holds, batch...), nil
 }
 
-// SetSeatsTempReservedBatch marks multiple seats as PENDING in a single batch operation
-// This is used when creating a reservation with multiple seats
-func (r *ReservationImpl) SetSeatsTempReservedBatch(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, ttl time.Duration) error {
-	if len(seats) == 0 {
-		return nil
+// readSeatHolds reads owner and TTL of the seat keys in one round trip and keeps the expiring ones.
+func (r *ReservationImpl) readSeatHolds(ctx context.Context, keys []string) ([]SeatHold, error) {
+	if len(keys) == 0 {
+		return nil, nil
 	}
 
-	logger.InfoContext(ctx, "SetSeatsTempReservedBatch: Marking seats as PENDING",
-		"eventID", eventID,
-		"reservationID", reservationID,
-		"seats_count", len(seats),
-		"ttl", ttl)
-
-	// 1. Update all seats in Redis (set TTL for temporary hold)
-	for _, seat := range seats {
-		key := fmt.Sprintf("seat:%s:%d:%d:%d", eventID, seat.ZoneNumber, seat.RowNumber, seat.ColNumber)
-		if err := r.redisClient.Set(ctx, key, reservationID, ttl).Err(); err != nil {
-			logger.ErrorContext(ctx, "Failed to set seat temp reserved in Redis",
-				"error", err,
-				"seat", seat)
-			return err
-		}
+	pipe := r.redisClient.Pipeline()
+	getCmds := make([]*redis.StringCmd, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		getCmds[i] = pipe.Get(ctx, key)
+		ttlCmds[i] = pipe.PTTL(ctx, key)
+	}
+	// redis.Nil only means a key expired between SCAN and GET
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
 	}
 
-	// 2. Publish batch update (single message for all seats)
-	r.publishSeatUpdatesBatch(ctx, eventID, seats, entities.SeatPending)
+	var holds []SeatHold
+	for i, key := range keys {
+		// Expected format: ["seat", "eventID", "zone", "row", "col"]
+		parts := strings.Split(key, ":")
+		if len(parts) != 5 || getCmds[i].Err() != nil || ttlCmds[i].Val() <= 0 {
+			continue
+		}
 
-	logger.InfoContext(ctx, "SetSeatsTempReservedBatch: Successfully marked seats as PENDING",
-		"seats_count", len(seats))
+		var seat SeatInfo
+		fmt.Sscanf(parts[2], "%d", &seat.ZoneNumber)
+		fmt.Sscanf(parts[3], "%d", &seat.RowNumber)
+		fmt.Sscanf(parts[4], "%d", &seat.ColNumber)
 
-	return nil
+		holds = append(holds, SeatHold{
+			EventID:       parts[1],
+			Seat:          seat,
+			ReservationID: getCmds[i].Val(),
+			TTL:           ttlCmds[i].Val(),
+		})
+	}
+	return holds, nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
 	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
 	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
@@ -15,6 +17,15 @@ type SeatInfo struct {
 	ZoneNumber int32
 	RowNumber  int32
 	ColNumber  int32
+	// the ticket type the seat is sold as, empty for the zone price
+	TicketTypeID string
+	// the price locked in when the seat was held, in the minor unit of the currency of the reservation
+	Price int64
+}
+
+// Unnumbered reports a general admission seat, it has a zone but no row or column.
+func (s SeatInfo) Unnumbered() bool {
+	return s.RowNumber == 0 && s.ColNumber == 0
 }
 
 type SeatStatusInfo struct {
@@ -24,6 +35,14 @@ type SeatStatusInfo struct {
 	Status     string // "PENDING" or "RESERVED"
 }
 
+// SeatHold is a pending seat key together with the reservation that owns it.
+type SeatHold struct {
+	EventID       string
+	Seat          SeatInfo
+	ReservationID string
+	TTL           time.Duration
+}
+
 type ReservationRepository interface {
 	// redis
 	CreateReservationTemp(ctx context.Context, userID, reservationID string, ttl time.Duration) error
@@ -33,31 +52,117 @@ type ReservationRepository interface {
 	SetSeatReserved(ctx context.Context, eventID string, seat SeatInfo, reservationID string, ttl time.Duration) error
 	SetSeatsReservedBatch(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error // NEW: Batch RESERVED
 	SetSeatTempReserved(ctx context.Context, eventID string, seat SeatInfo, reservationID string, ttl time.Duration) error
-	SetSeatsTempReservedBatch(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, ttl time.Duration) error // NEW: Batch PENDING
+	HoldSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, ttl time.Duration) ([]SeatInfo, error)
+	ReleaseSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error
+	ReleaseSoldSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error
 	DeleteSeatReservation(ctx context.Context, eventID string, seat SeatInfo) error
 	CacheReservationSeats(ctx context.Context, reservationID string, seats []SeatInfo, ttl time.Duration) error
 	GetReservationSeats(ctx context.Context, reservationID string) ([]SeatInfo, error)
 	DeleteReservationSeats(ctx context.Context, reservationID string) error
 	GetAllEventSeats(ctx context.Context, eventID string) ([]SeatStatusInfo, error)
+	SeedReservedSeats(ctx context.Context, eventID string, tickets []db.Ticket) ([]SeatInfo, error)
+	ListLiveReservationIDs(ctx context.Context) (map[string]struct{}, error)
+	ListSeatHolds(ctx context.Context) ([]SeatHold, error)
+	HoldGeneralAdmission(ctx context.Context, eventID string, seats []SeatInfo, reservationID string, capacities map[int32]int32) error
+	GetGeneralAdmissionTaken(ctx context.Context, eventID string, zones []int32) (map[int32]int32, error)
 
 	// pub/sub - redis
 	publishSeatUpdate(ctx context.Context, eventID string, seat SeatInfo, status entities.SeatStatus)
 	publishSeatUpdatesBatch(ctx context.Context, eventID string, seats []SeatInfo, status entities.SeatStatus) // NEW: Batch publisher
 
-	// redis event
-	StartExpirationListener(ctx context.Context)
+	// hold expiry - redis
+	ScheduleHoldExpiry(ctx context.Context, hold HoldExpiry, deadline time.Time) error
+	RescheduleHoldExpiry(ctx context.Context, reservationID string, deadline time.Time) error
+	ExtendHold(ctx context.Context, userID, reservationID, eventID string, seats []SeatInfo, extension time.Duration) (time.Duration, error)
+	ListDueHoldExpiries(ctx context.Context, now time.Time, limit int64) ([]string, error)
+	GetHoldExpiry(ctx context.Context, reservationID string) (*HoldExpiry, error)
+	RemoveHoldExpiry(ctx context.Context, reservationID string) error
+	ReleaseExpiredSeats(ctx context.Context, eventID string, seats []SeatInfo, reservationID string) error
+	RenewLease(ctx context.Context, name, owner string, lease time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, name, owner string) error
+
+	// outbox - db
+	PublishOutboxMessages(ctx context.Context, messages ...outbox.Message) error
+	ClaimOutboxMessages(ctx context.Context, limit int32, lockedUntil time.Time) ([]outbox.Message, error)
+	MarkOutboxMessagePublished(ctx context.Context, id string) error
+	MarkOutboxMessageFailed(ctx context.Context, id string, nextAttemptAt time.Time, cause error) error
+
+	// idempotency - db
+	ClaimIdempotencyKey(ctx context.Context, userID, key string, requestHash []byte) ([]byte, error)
+	CompleteIdempotencyKey(ctx context.Context, userID, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, userID, key string) error
+
+	// ticket limits - db + redis
+	CountUserTickets(ctx context.Context, userID, eventID string) (int, error)
+	CountHeldSeats(ctx context.Context, userID, eventID string) (int, error)
 
 	// db
 	GetReservation(ctx context.Context, id string) (*db.Reservation, error)
 	ListReservationsByUserID(ctx context.Context, userID string) ([]db.Reservation, error)
-	CreateReservation(ctx context.Context, reservationID, userID, eventID, status, stripeSessionID string, totalPrice float64) (*db.Reservation, error)
+	CreateReservation(ctx context.Context, reservationID, userID, eventID, status, stripeSessionID, currency string, breakdown fees.Breakdown, lineItems []LineItem, claims []TicketTypeClaim, redemptions ...PromotionRedemption) (*db.Reservation, error)
 	UpdateReservationStatus(ctx context.Context, id, status string) (*db.Reservation, error)
+	ReplaceStripeSession(ctx context.Context, reservationID, sessionID string) error
+	TransitionReservationStatus(ctx context.Context, id, from, to string, messages ...outbox.Message) (bool, error)
+	GetReservationStatus(ctx context.Context, id string) (string, error)
 	DeleteReservation(ctx context.Context, id string) error
 	CreateTicket(ctx context.Context, eventID, reservationID string, seat SeatInfo) (*db.Ticket, error)
 	CreateTickets(ctx context.Context, eventID, reservationID string, seats []SeatInfo) ([]db.Ticket, error)
 	CreateTicketsWithTransaction(ctx context.Context, eventID, reservationID string, seats []SeatInfo) ([]db.Ticket, error)
+	ConfirmReservationWithTickets(ctx context.Context, eventID, reservationID string, seats []SeatInfo, messages ...outbox.Message) ([]db.Ticket, error)
+	RefundReservationWithTickets(ctx context.Context, reservationID string, refundedAmount int64, messages ...outbox.Message) ([]db.Ticket, error)
+	CancelTickets(ctx context.Context, reservationID string, refunds map[string]int64, breakdown fees.Breakdown) ([]db.Ticket, error)
+	ListTicketHistory(ctx context.Context, reservationID string) ([]db.ListTicketHistoryByReservationIDRow, error)
+	ListReservationLineItems(ctx context.Context, reservationID string) ([]db.Reservationlineitem, error)
 	GetTicketsByReservation(ctx context.Context, reservationID string) ([]db.Ticket, error)
 	GetReservationBySessionId(ctx context.Context, sessionID string) (*db.Reservation, error)
+	ListTicketEventIDs(ctx context.Context) ([]string, error)
+	ListTicketsByEventID(ctx context.Context, eventID string) ([]db.Ticket, error)
+	ListStalePendingReservations(ctx context.Context, before time.Time) ([]db.Reservation, error)
+	CountSoldTicketsByZone(ctx context.Context, eventID string) (map[int32]int64, error)
+
+	// ticket transfers - db
+	GetTicket(ctx context.Context, id string) (*db.Ticket, error)
+	ListTicketsByOwner(ctx context.Context, userID string) ([]db.Ticket, error)
+	GetTicketTransfer(ctx context.Context, id string) (*db.Tickettransfer, error)
+	ListPendingTicketTransfers(ctx context.Context, userID string) ([]db.ListTicketTransfersByUserIDRow, error)
+	OfferTicketTransfer(ctx context.Context, transferID, ticketID, fromUserID, toUserID string, messages ...outbox.Message) (*db.Tickettransfer, error)
+	AcceptTicketTransfer(ctx context.Context, transfer db.Tickettransfer, messages ...outbox.Message) (*db.Ticket, error)
+
+	// check-in - db
+	CheckInTicket(ctx context.Context, ticketID, ownerID string, credentialVersion int32, gateID string) (*db.Ticket, error)
+	RecordOfflineCheckIn(ctx context.Context, scan OfflineScan) (*db.Ticket, *db.Ticket, error)
+	ListEventScanTickets(ctx context.Context, eventID string) ([]db.ListEventScanTicketsRow, error)
+
+	// waitlist - db + redis
+	JoinWaitlist(ctx context.Context, userID, eventID string, zoneNumber, quantity int32) (*db.Waitlistentry, error)
+	GetWaitlistEntry(ctx context.Context, id string) (*db.Waitlistentry, error)
+	ListWaitlistEntries(ctx context.Context, userID string) ([]db.Waitlistentry, error)
+	ListWaitingEntries(ctx context.Context, eventID string, zoneNumber, limit int32) ([]db.Waitlistentry, error)
+	LeaveWaitlist(ctx context.Context, id, userID string) (bool, error)
+	OfferWaitlistEntry(ctx context.Context, entry db.Waitlistentry, reservationID, stripeSessionID, currency string, breakdown fees.Breakdown, lineItems []LineItem, expiresAt time.Time, messages ...outbox.Message) error
+	CloseWaitlistOffer(ctx context.Context, reservationID string, status entities.WaitlistStatus) error
+	HandOverSeats(ctx context.Context, eventID string, seats []SeatInfo, fromReservationID, toReservationID string, sold bool, ttl time.Duration) ([]SeatInfo, error)
+
+	// ballot - db
+	ApplyBallot(ctx context.Context, userID, eventID string, zoneNumber, quantity int32) (*db.Ballotapplication, error)
+	GetBallotApplication(ctx context.Context, id string) (*db.Ballotapplication, error)
+	ListBallotApplications(ctx context.Context, userID string) ([]db.Ballotapplication, error)
+	WithdrawBallotApplication(ctx context.Context, id, userID string) (bool, error)
+	ListBallotEventIDs(ctx context.Context) ([]string, error)
+	CountAppliedBallotApplications(ctx context.Context, eventID string) (int, error)
+	ListAppliedBallotApplicationIDs(ctx context.Context, eventID string) ([]string, error)
+	CreateBallotDraw(ctx context.Context, eventID string, seed int64, applicationIDs []string) (*db.Ballotdraw, error)
+	GetBallotDraw(ctx context.Context, eventID string) (*db.Ballotdraw, error)
+	CompleteBallotDraw(ctx context.Context, eventID string) error
+	SettleBallotApplication(ctx context.Context, id string, status entities.BallotStatus, reservationID string, position int32, messages ...outbox.Message) error
+
+	// promotions - db
+	CreatePromotion(ctx context.Context, params db.CreatePromotionParams) (*db.Promotion, error)
+	ListPromotions(ctx context.Context) ([]db.Promotion, error)
+	ListPromotionsByCodes(ctx context.Context, codes []string) ([]db.Promotion, error)
+	DeactivatePromotion(ctx context.Context, id string) (bool, error)
+	CountUserPromotionRedemptions(ctx context.Context, promotionID, userID string) (int, error)
+	ListReservationPromotions(ctx context.Context, reservationID string) ([]db.ListReservationPromotionsRow, error)
 }
 
 type ReservationImpl struct {
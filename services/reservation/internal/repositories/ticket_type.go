@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrTicketTypeSoldOut is returned when the tickets of a type would go over its cap.
+var ErrTicketTypeSoldOut = errors.New("ticket type is sold out")
+
+// TicketTypeClaim is how many tickets of a ticket type a reservation takes.
+type TicketTypeClaim struct {
+	TicketTypeID         string
+	Quantity             int32
+	QuantityCap          int32
+	RequiresVerification bool
+}
+
+// claimTicketTypes counts the tickets of the reservation towards the caps of their types. The count of a
+// type is taken by a conditional upsert that locks it until the transaction ends, so concurrent
+// reservations cannot both take the last tickets.
+func claimTicketTypes(ctx context.Context, queries *db.Queries, reservationID pgtype.UUID, claims []TicketTypeClaim) error {
+	for _, claim := range claims {
+		ticketTypeID := stringToUUID(claim.TicketTypeID)
+
+		claimed, err := queries.ClaimTicketType(ctx, db.ClaimTicketTypeParams{
+			TicketTypeID: ticketTypeID,
+			Quantity:     claim.Quantity,
+			QuantityCap:  claim.QuantityCap,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to claim ticket type: %w", err)
+		}
+		if claimed == 0 {
+			return ErrTicketTypeSoldOut
+		}
+
+		if err := queries.CreateTicketTypeClaim(ctx, db.CreateTicketTypeClaimParams{
+			TicketTypeID:         ticketTypeID,
+			ReservationID:        reservationID,
+			Quantity:             claim.Quantity,
+			RequiresVerification: claim.RequiresVerification,
+		}); err != nil {
+			return fmt.Errorf("failed to create ticket type claim: %w", err)
+		}
+	}
+	return nil
+}
+
+// returnTicketTypes gives the ticket types of cancelled tickets back to their caps.
+func returnTicketTypes(ctx context.Context, queries *db.Queries, reservationID pgtype.UUID, tickets []db.Ticket) error {
+	returned := make(map[pgtype.UUID]int32)
+	for _, ticket := range tickets {
+		if ticket.TicketTypeID.Valid {
+			returned[ticket.TicketTypeID]++
+		}
+	}
+	for ticketTypeID, quantity := range returned {
+		if err := queries.ReturnTicketTypeClaim(ctx, db.ReturnTicketTypeClaimParams{
+			Quantity:      quantity,
+			ReservationID: reservationID,
+			TicketTypeID:  ticketTypeID,
+		}); err != nil {
+			return fmt.Errorf("failed to return ticket type claim: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const ticketTransferPendingIndex = "ticket_transfer_pending_idx"
+
+var (
+	// ErrTransferPending is returned when a concurrent offer for the same ticket was stored first.
+	ErrTransferPending = errors.New("ticket already has a pending transfer")
+	// ErrTransferNotPending is returned when a transfer was accepted or withdrawn before it could be accepted.
+	ErrTransferNotPending = errors.New("transfer is not pending")
+)
+
+func isTransferPendingViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == ticketTransferPendingIndex
+}
+
+// GetTicket returns a live ticket.
+func (r *ReservationImpl) GetTicket(ctx context.Context, id string) (*db.Ticket, error) {
+	ticket, err := r.db.GetTicket(ctx, stringToUUID(id))
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// ListTicketsByOwner returns the live tickets a user owns, bought or transferred to them.
+func (r *ReservationImpl) ListTicketsByOwner(ctx context.Context, userID string) ([]db.Ticket, error) {
+	return r.db.ListTicketsByOwnerID(ctx, stringToUUID(userID))
+}
+
+func (r *ReservationImpl) GetTicketTransfer(ctx context.Context, id string) (*db.Tickettransfer, error) {
+	transfer, err := r.db.GetTicketTransfer(ctx, stringToUUID(id))
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// ListPendingTicketTransfers returns the pending transfers offered by or to the user, newest first.
+func (r *ReservationImpl) ListPendingTicketTransfers(ctx context.Context, userID string) ([]db.ListTicketTransfersByUserIDRow, error) {
+	return r.db.ListTicketTransfersByUserID(ctx, db.ListTicketTransfersByUserIDParams{
+		UserID: stringToUUID(userID),
+		Status: string(entities.TransferPending),
+	})
+}
+
+// OfferTicketTransfer withdraws any pending offer of the ticket and stores the new one together
+// with the outbox messages in one transaction.
+// ErrTransferPending is returned if a concurrent offer for the ticket won.
+func (r *ReservationImpl) OfferTicketTransfer(ctx context.Context, transferID, ticketID, fromUserID, toUserID string, messages ...outbox.Message) (*db.Tickettransfer, error) {
+	var transfer db.Tickettransfer
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		if err := queries.TransitionTicketTransfersByTicketID(ctx, db.TransitionTicketTransfersByTicketIDParams{
+			NewStatus:     string(entities.TransferCancelled),
+			TicketID:      stringToUUID(ticketID),
+			CurrentStatus: string(entities.TransferPending),
+		}); err != nil {
+			return fmt.Errorf("failed to withdraw pending transfers: %w", err)
+		}
+
+		var err error
+		transfer, err = queries.CreateTicketTransfer(ctx, db.CreateTicketTransferParams{
+			ID:         stringToUUID(transferID),
+			TicketID:   stringToUUID(ticketID),
+			FromUserID: stringToUUID(fromUserID),
+			ToUserID:   stringToUUID(toUserID),
+		})
+		if isTransferPendingViolation(err) {
+			return ErrTransferPending
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create transfer: %w", err)
+		}
+
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// AcceptTicketTransfer moves the ticket to the recipient and bumps its credential version, marks the
+// transfer accepted, records the ticket history and stores the outbox messages in one transaction.
+// ErrTransferNotPending is returned if the transfer is no longer pending and ErrTicketNotFound if the
+// ticket was given back or changed owner since it was offered.
+func (r *ReservationImpl) AcceptTicketTransfer(ctx context.Context, transfer db.Tickettransfer, messages ...outbox.Message) (*db.Ticket, error) {
+	var ticket db.Ticket
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		affected, err := queries.TransitionTicketTransferStatus(ctx, db.TransitionTicketTransferStatusParams{
+			NewStatus:     string(entities.TransferAccepted),
+			ID:            transfer.ID,
+			CurrentStatus: string(entities.TransferPending),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to accept transfer: %w", err)
+		}
+		if affected == 0 {
+			return ErrTransferNotPending
+		}
+
+		ticket, err = queries.TransferTicketOwner(ctx, db.TransferTicketOwnerParams{
+			NewOwnerID: transfer.ToUserID,
+			ID:         transfer.TicketID,
+			OwnerID:    transfer.FromUserID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTicketNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to transfer ticket: %w", err)
+		}
+
+		if err := insertTicketHistory(ctx, queries, []db.Ticket{ticket}, entities.TicketTransferred, nil); err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticket, nil
+}
@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cp-rektmart/aconcert-microservice/pkg/outbox"
+	db "github.com/cp-rektmart/aconcert-microservice/reservation/db/codegen"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/entities"
+	"github.com/cp-rektmart/aconcert-microservice/reservation/internal/fees"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+const waitlistEntryActiveIndex = "waitlist_entry_active_idx"
+
+var (
+	// ErrAlreadyWaitlisted is returned when the user already waits for the zone of the event.
+	ErrAlreadyWaitlisted = errors.New("already on the waitlist")
+	// ErrWaitlistEntryNotWaiting is returned when an entry was offered seats or left the waitlist meanwhile.
+	ErrWaitlistEntryNotWaiting = errors.New("waitlist entry is not waiting")
+)
+
+// handOverSeatsScript moves the seat keys owned by ARGV[1], or free, to ARGV[2] with a TTL of ARGV[3]
+// milliseconds. It returns the 1-based indexes of the keys that were handed over.
+var handOverSeatsScript = redis.NewScript(`
+local moved = {}
+for i, key in ipairs(KEYS) do
+	local owner = redis.call('GET', key)
+	if owner == ARGV[1] or owner == false then
+		redis.call('SET', key, ARGV[2], 'PX', ARGV[3])
+		table.insert(moved, i)
+	end
+end
+return moved
+`)
+
+// handOverGeneralAdmissionScript moves general admission quantities from the hold in KEYS[1] to the hold
+// in KEYS[2], the zone counters stay as they are. ARGV[1] is 1 for sold quantities, which are counted
+// but not in a hold, followed by a zone number and quantity pair per zone. It returns the quantity
+// handed over per pair.
+var handOverGeneralAdmissionScript = redis.NewScript(`
+local moved = {}
+for i = 2, #ARGV, 2 do
+	local quantity = tonumber(ARGV[i + 1])
+	if ARGV[1] ~= '1' then
+		quantity = math.min(quantity, tonumber(redis.call('HGET', KEYS[1], ARGV[i]) or '0'))
+		if quantity > 0 then
+			redis.call('HINCRBY', KEYS[1], ARGV[i], -quantity)
+		end
+	end
+	if quantity > 0 then
+		redis.call('HINCRBY', KEYS[2], ARGV[i], quantity)
+	end
+	table.insert(moved, quantity)
+end
+return moved
+`)
+
+func isAlreadyWaitlistedViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == waitlistEntryActiveIndex
+}
+
+// JoinWaitlist puts the user at the end of the waitlist of an event zone, zone 0 waits for any zone.
+func (r *ReservationImpl) JoinWaitlist(ctx context.Context, userID, eventID string, zoneNumber, quantity int32) (*db.Waitlistentry, error) {
+	entry, err := r.db.CreateWaitlistEntry(ctx, db.CreateWaitlistEntryParams{
+		EventID:    stringToUUID(eventID),
+		ZoneNumber: zoneNumber,
+		UserID:     stringToUUID(userID),
+		Quantity:   quantity,
+	})
+	if err != nil {
+		if isAlreadyWaitlistedViolation(err) {
+			return nil, ErrAlreadyWaitlisted
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *ReservationImpl) GetWaitlistEntry(ctx context.Context, id string) (*db.Waitlistentry, error) {
+	entry, err := r.db.GetWaitlistEntry(ctx, stringToUUID(id))
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ListWaitlistEntries returns the entries of the user that still wait or hold an offer.
+func (r *ReservationImpl) ListWaitlistEntries(ctx context.Context, userID string) ([]db.Waitlistentry, error) {
+	return r.db.ListActiveWaitlistEntriesByUserID(ctx, stringToUUID(userID))
+}
+
+// ListWaitingEntries returns up to limit entries waiting for seats of the zone, first come first.
+func (r *ReservationImpl) ListWaitingEntries(ctx context.Context, eventID string, zoneNumber, limit int32) ([]db.Waitlistentry, error) {
+	return r.db.ListWaitingEntries(ctx, db.ListWaitingEntriesParams{
+		EventID:    stringToUUID(eventID),
+		ZoneNumber: zoneNumber,
+		MaxEntries: limit,
+	})
+}
+
+// LeaveWaitlist takes a waiting entry of the user off the waitlist. It reports false if the entry
+// is not the user's or does not wait anymore.
+func (r *ReservationImpl) LeaveWaitlist(ctx context.Context, id, userID string) (bool, error) {
+	affected, err := r.db.LeaveWaitlist(ctx, db.LeaveWaitlistParams{
+		ID:     stringToUUID(id),
+		UserID: stringToUUID(userID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// OfferWaitlistEntry creates the PENDING reservation holding the seats offered to a waiting entry with its
+// line items, moves the entry to OFFERED and stores the outbox messages in one transaction.
+// ErrWaitlistEntryNotWaiting is returned if the entry left the waitlist meanwhile.
+func (r *ReservationImpl) OfferWaitlistEntry(ctx context.Context, entry db.Waitlistentry, reservationID, stripeSessionID, currency string, breakdown fees.Breakdown, lineItems []LineItem, expiresAt time.Time, messages ...outbox.Message) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		queries := r.db.WithTx(tx)
+
+		params := newReservationParams(stringToUUID(reservationID), entry.UserID, entry.EventID, string(entities.Pending), stripeSessionID, currency, breakdown)
+		if _, err := queries.CreateReservation(ctx, params); err != nil {
+			return fmt.Errorf("failed to create reservation: %w", err)
+		}
+		if err := insertLineItems(ctx, queries, stringToUUID(reservationID), lineItems); err != nil {
+			return err
+		}
+
+		affected, err := queries.OfferWaitlistEntry(ctx, db.OfferWaitlistEntryParams{
+			ReservationID:  stringToUUID(reservationID),
+			OfferExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+			ID:             entry.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to offer waitlist entry: %w", err)
+		}
+		if affected == 0 {
+			return ErrWaitlistEntryNotWaiting
+		}
+
+		return insertOutboxMessages(ctx, queries, messages)
+	})
+}
+
+// CloseWaitlistOffer settles the offer a reservation was created for, if any, with the given status.
+func (r *ReservationImpl) CloseWaitlistOffer(ctx context.Context, reservationID string, status entities.WaitlistStatus) error {
+	return r.db.CloseWaitlistOffer(ctx, db.CloseWaitlistOfferParams{
+		NewStatus:     string(status),
+		ReservationID: stringToUUID(reservationID),
+	})
+}
+
+// HandOverSeats moves seats given back by one reservation to another as a hold expiring after ttl.
+// Numbered seats still owned by the previous reservation, or free, are handed over, unnumbered ones are
+// taken from its general admission hold. Sold seats are not in a hold, their quantities are counted and
+// move as they are. The seats handed over are returned.
+func (r *ReservationImpl) HandOverSeats(ctx context.Context, eventID string, seats []SeatInfo, fromReservationID, toReservationID string, sold bool, ttl time.Duration) ([]SeatInfo, error) {
+	numbered, quantities := splitSeats(seats)
+	moved := make([]SeatInfo, 0, len(seats))
+
+	if len(numbered) > 0 {
+		keys := make([]string, len(numbered))
+		for i, seat := range numbered {
+			keys[i] = seatKey(eventID, seat)
+		}
+
+		indexes, err := handOverSeatsScript.Run(ctx, r.redisClient, keys, fromReservationID, toReservationID, ttl.Milliseconds()).Int64Slice()
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indexes {
+			moved = append(moved, numbered[idx-1])
+		}
+		r.publishSeatUpdatesBatch(ctx, eventID, moved, entities.SeatPending)
+	}
+
+	if len(quantities) > 0 {
+		soldFlag := 0
+		if sold {
+			soldFlag = 1
+		}
+
+		zones := make([]int32, 0, len(quantities))
+		args := []any{soldFlag}
+		for zone, quantity := range quantities {
+			zones = append(zones, zone)
+			args = append(args, zone, quantity)
+		}
+
+		keys := []string{generalAdmissionHoldKey(fromReservationID), generalAdmissionHoldKey(toReservationID)}
+		counts, err := handOverGeneralAdmissionScript.Run(ctx, r.redisClient, keys, args...).Int64Slice()
+		if err != nil {
+			return moved, err
+		}
+		for i, count := range counts {
+			for range count {
+				moved = append(moved, SeatInfo{ZoneNumber: zones[i]})
+			}
+		}
+	}
+
+	return moved, nil
+}